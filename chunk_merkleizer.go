@@ -0,0 +1,158 @@
+package ssz
+
+import (
+	"fmt"
+	"io"
+	"math/bits"
+)
+
+// ChunkMerkleizer merkleizes a stream of bytes in chunks of 32 bytes without keeping
+// the stream in memory. Only the root of the last complete subtree of each level is
+// kept, so the memory is O(log n) for n chunks. The roots are the same as the ones
+// of the Hasher for the same bytes (PutBytes and MerkleizeWithMixin).
+type ChunkMerkleizer struct {
+	// limit is the maximum number of chunks or zero if the tree is
+	// as deep as the number of written chunks
+	limit uint64
+
+	// count is the number of complete chunks and levels[l] is the root of the
+	// pending left subtree of height l, which is set if the bit l of count is set
+	count  uint64
+	levels [65][32]byte
+
+	// chunk holds the bytes of the partial chunk
+	chunk [32]byte
+	rest  int
+}
+
+// NewChunkMerkleizer creates a merkleizer with a limit of chunks. A zero limit
+// merkleizes the written chunks without padding to a limit, like Merkleize.
+func NewChunkMerkleizer(limitChunks uint64) *ChunkMerkleizer {
+	return &ChunkMerkleizer{limit: limitChunks}
+}
+
+// Write adds the bytes to the stream. It fails without writing any byte if the
+// stream exceeds the limit of chunks.
+func (m *ChunkMerkleizer) Write(p []byte) (int, error) {
+	if m.limit != 0 {
+		total := m.Len() + uint64(len(p))
+		if total < m.Len() || total/32+(total%32+31)/32 > m.limit {
+			return 0, fmt.Errorf("stream of %d bytes exceeds the limit of %d chunks", total, m.limit)
+		}
+	}
+
+	n := len(p)
+	if m.rest != 0 {
+		copied := copy(m.chunk[m.rest:], p)
+		m.rest += copied
+		p = p[copied:]
+		if m.rest < 32 {
+			return n, nil
+		}
+		m.appendChunk(m.chunk)
+		m.rest = 0
+	}
+	for len(p) >= 32 {
+		m.appendChunk([32]byte(p[:32]))
+		p = p[32:]
+	}
+	if len(p) != 0 {
+		m.chunk = [32]byte{}
+		m.rest = copy(m.chunk[:], p)
+	}
+	return n, nil
+}
+
+// Len returns the number of written bytes
+func (m *ChunkMerkleizer) Len() uint64 {
+	return m.count*32 + uint64(m.rest)
+}
+
+// Reset clears the written bytes and keeps the limit
+func (m *ChunkMerkleizer) Reset() {
+	*m = ChunkMerkleizer{limit: m.limit}
+}
+
+// Root returns the root of the written bytes, right padded with zeros to a chunk.
+// More bytes can be written after it.
+func (m *ChunkMerkleizer) Root() [32]byte {
+	count, levels := m.count, m.levels
+	if m.rest != 0 {
+		// hash the partial chunk on a copy of the pending subtrees
+		node := m.chunk
+		for level := 0; count>>level&1 == 1; level++ {
+			node = hashPair(levels[level], node)
+		}
+		levels[bits.TrailingZeros64(^count)] = node
+		count++
+	}
+
+	limit := m.limit
+	if limit == 0 {
+		limit = count
+	}
+	depth := 0
+	if limit > 1 {
+		depth = bits.Len64(limit - 1)
+	}
+	if count == 0 {
+		return zeroHashes[depth]
+	}
+	if count == 1<<depth {
+		return levels[depth]
+	}
+
+	// node is the root of the subtree of the first empty chunk at each level
+	node := zeroHashes[0]
+	for level := 0; level < depth; level++ {
+		if count>>level&1 == 1 {
+			node = hashPair(levels[level], node)
+		} else {
+			node = hashPair(node, zeroHashes[level])
+		}
+	}
+	return node
+}
+
+// RootWithMixin returns the root of the written bytes mixed in with the given
+// length, like MerkleizeWithMixin
+func (m *ChunkMerkleizer) RootWithMixin(num uint64) [32]byte {
+	return mixinLength(m.Root(), num)
+}
+
+func (m *ChunkMerkleizer) appendChunk(node [32]byte) {
+	level := 0
+	for ; m.count>>level&1 == 1; level++ {
+		node = hashPair(m.levels[level], node)
+	}
+	m.levels[level] = node
+	m.count++
+}
+
+// MerkleizeReader returns the root of the bytes read from r until io.EOF with a
+// limit of chunks, the same as the root of PutBytes for a zero limit or of
+// MerkleizeWithMixin without the mixin.
+func MerkleizeReader(r io.Reader, limitChunks uint64) ([32]byte, error) {
+	m := NewChunkMerkleizer(limitChunks)
+	if _, err := io.Copy(m, r); err != nil {
+		return [32]byte{}, err
+	}
+	return m.Root(), nil
+}
+
+// MerkleizeReaderWithMixin returns the root of the list of elements of elemSize
+// bytes read from r until io.EOF, mixed in with the number of elements. Byte lists
+// have an elemSize of 1.
+func MerkleizeReaderWithMixin(r io.Reader, limitChunks, elemSize uint64) ([32]byte, error) {
+	if elemSize == 0 {
+		return [32]byte{}, fmt.Errorf("incorrect size %d for a list element", elemSize)
+	}
+	m := NewChunkMerkleizer(limitChunks)
+	if _, err := io.Copy(m, r); err != nil {
+		return [32]byte{}, err
+	}
+	if m.Len()%elemSize != 0 {
+		return [32]byte{}, fmt.Errorf("stream of %d bytes is not a list of elements of %d bytes", m.Len(), elemSize)
+	}
+	return m.RootWithMixin(m.Len() / elemSize), nil
+}
@@ -0,0 +1,134 @@
+package ssz
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkMerkleizer(t *testing.T) {
+	sizes := []int{0, 1, 31, 32, 33, 63, 64, 65, 96, 128, 200, 1024, 1056, 4097, 100000}
+
+	for _, size := range sizes {
+		buf := make([]byte, size)
+		_, err := rand.Read(buf)
+		require.NoError(t, err)
+
+		// without limit it matches PutBytes, which does not put any chunk
+		// for empty bytes
+		var expected [32]byte
+		if size != 0 {
+			hh := NewHasher()
+			hh.PutBytes(buf)
+			expected, err = hh.HashRoot()
+			require.NoError(t, err)
+		}
+
+		root, err := MerkleizeReader(bytes.NewReader(buf), 0)
+		require.NoError(t, err)
+		require.Equal(t, expected, root, "size %d", size)
+
+		// with a limit and the mixin it matches MerkleizeWithMixin
+		for _, max := range []uint64{uint64(size), uint64(size) + 1, 2*uint64(size) + 100, 1 << 30} {
+			limit := CalculateLimit(max, uint64(size), 1)
+
+			hh := NewHasher()
+			indx := hh.Index()
+			hh.Append(buf)
+			hh.MerkleizeWithMixin(indx, uint64(size), limit)
+			expected, err := hh.HashRoot()
+			require.NoError(t, err)
+
+			// the bytes are written in pieces that do not match the chunks
+			root, err := MerkleizeReaderWithMixin(iotest.OneByteReader(bytes.NewReader(buf)), limit, 1)
+			require.NoError(t, err)
+			require.Equal(t, expected, root, "size %d, max %d", size, max)
+
+			m := NewChunkMerkleizer(limit)
+			for i := 0; i < size; i += 7 {
+				_, err := m.Write(buf[i:min(i+7, size)])
+				require.NoError(t, err)
+			}
+			require.Equal(t, uint64(size), m.Len())
+			require.Equal(t, expected, m.RootWithMixin(uint64(size)))
+		}
+	}
+}
+
+func TestChunkMerkleizer_Uint64List(t *testing.T) {
+	list := []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}
+
+	hh := NewHasher()
+	hh.PutUint64Array(list, 100)
+	expected, err := hh.HashRoot()
+	require.NoError(t, err)
+
+	var buf []byte
+	for _, i := range list {
+		buf = MarshalUint64(buf, i)
+	}
+	root, err := MerkleizeReaderWithMixin(bytes.NewReader(buf), CalculateLimit(100, 0, 8), 8)
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+
+	// the stream is not a list of uint64 values
+	_, err = MerkleizeReaderWithMixin(bytes.NewReader(buf[1:]), CalculateLimit(100, 0, 8), 8)
+	require.Error(t, err)
+}
+
+func TestChunkMerkleizer_Root(t *testing.T) {
+	buf := make([]byte, 1000)
+	_, err := rand.Read(buf)
+	require.NoError(t, err)
+
+	// the root can be taken at any point of the stream
+	m := NewChunkMerkleizer(64)
+	for i := 0; i < len(buf); i += 50 {
+		_, err := m.Write(buf[i : i+50])
+		require.NoError(t, err)
+
+		hh := NewHasher()
+		indx := hh.Index()
+		hh.Append(buf[:i+50])
+		hh.MerkleizeWithMixin(indx, uint64(i+50), 64)
+		expected, err := hh.HashRoot()
+		require.NoError(t, err)
+		require.Equal(t, expected, m.RootWithMixin(uint64(i+50)))
+	}
+
+	// the stream exceeds the limit
+	_, err = m.Write(make([]byte, 64*32-1000+1))
+	require.Error(t, err)
+	require.Equal(t, uint64(1000), m.Len())
+	_, err = m.Write(make([]byte, 64*32-1000))
+	require.NoError(t, err)
+
+	_, err = MerkleizeReader(bytes.NewReader(buf), 31)
+	require.Error(t, err)
+
+	m.Reset()
+	require.Equal(t, uint64(0), m.Len())
+	require.Equal(t, zeroHashes[6], m.Root())
+
+	// errors of the reader are returned
+	_, err = MerkleizeReader(iotest.ErrReader(errors.New("read error")), 0)
+	require.Error(t, err)
+}
+
+func BenchmarkMerkleizeReader(b *testing.B) {
+	buf := make([]byte, 1<<20)
+	_, err := rand.Read(buf)
+	require.NoError(b, err)
+
+	b.SetBytes(int64(len(buf)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := MerkleizeReader(bytes.NewReader(buf), 1<<30); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package ssz
+
+import "fmt"
+
+// The fields of types that do not implement the ssz interfaces, like the types of
+// other modules, are encoded by sszgen with a codec (see the 'custom' option of the
+// ssz tag). A codec is a value that implements the interfaces below for the type T
+// of the field, the generated code calls it with the functions of this file.
+
+// CustomMarshaler encodes values of type T
+type CustomMarshaler[T any] interface {
+	// SizeSSZ returns the size of the encoding of v
+	SizeSSZ(v T) int
+	// MarshalSSZTo appends the encoding of v to dst
+	MarshalSSZTo(v T, dst []byte) ([]byte, error)
+}
+
+// CustomUnmarshaler decodes values of type T
+type CustomUnmarshaler[T any] interface {
+	// UnmarshalSSZ decodes buf into v
+	UnmarshalSSZ(v *T, buf []byte) error
+}
+
+// CustomHasher hashes values of type T
+type CustomHasher[T any] interface {
+	// HashTreeRootWith puts the root of v in the hash walker as a single node,
+	// like hh.PutBytes does for encodings of up to 32 bytes.
+	HashTreeRootWith(v T, hh HashWalker) error
+}
+
+// Codec encodes, decodes and hashes values of type T
+type Codec[T any] interface {
+	CustomMarshaler[T]
+	CustomUnmarshaler[T]
+	CustomHasher[T]
+}
+
+// CustomTreeDecoder is implemented by the codecs that decode values from their tree.
+// It is optional, FromTree fails for the fields whose codec does not implement it.
+type CustomTreeDecoder[T any] interface {
+	// FromTree decodes the node into v
+	FromTree(n *Node, v *T) error
+}
+
+// SizeCustom returns the size of the encoding of v with the codec
+func SizeCustom[T any](c CustomMarshaler[T], v T) int {
+	return c.SizeSSZ(v)
+}
+
+// MarshalCustom appends the encoding of v with the codec to dst. The size of the encoding
+// must be size, unless it is VariableSize, and it cannot be higher than max if it is not zero.
+func MarshalCustom[T any](c CustomMarshaler[T], v T, dst []byte, size, max int) ([]byte, error) {
+	num := c.SizeSSZ(v)
+	if err := checkCustomSize(c, num, size, max); err != nil {
+		return nil, err
+	}
+	res, err := c.MarshalSSZTo(v, dst)
+	if err != nil {
+		return nil, err
+	}
+	if found := len(res) - len(dst); found != num {
+		return nil, fmt.Errorf("codec %T encoded %d bytes but its size is %d", c, found, num)
+	}
+	return res, nil
+}
+
+// UnmarshalCustom decodes buf into v with the codec. The size of buf is validated
+// like in MarshalCustom.
+func UnmarshalCustom[T any](c CustomUnmarshaler[T], v *T, buf []byte, size, max int) error {
+	if err := checkCustomSize(c, len(buf), size, max); err != nil {
+		return err
+	}
+	return c.UnmarshalSSZ(v, buf)
+}
+
+func checkCustomSize(c interface{}, num, size, max int) error {
+	if size != VariableSize && num != size {
+		return ErrBytesLengthFn(fmt.Sprintf("codec %T", c), num, size)
+	}
+	if max != 0 && num > max {
+		return ErrBytesLengthFn(fmt.Sprintf("codec %T", c), num, max)
+	}
+	return nil
+}
+
+// HashCustom puts the root of v in the hash walker with the codec
+func HashCustom[T any](c CustomHasher[T], v T, hh HashWalker) error {
+	return c.HashTreeRootWith(v, hh)
+}
+
+// DecodeTreeCustom decodes the node into v if the codec implements CustomTreeDecoder
+func DecodeTreeCustom[T any](c interface{}, n *Node, v *T) error {
+	dec, ok := c.(CustomTreeDecoder[T])
+	if !ok {
+		return fmt.Errorf("codec %T cannot decode values of type %T from a tree", c, *v)
+	}
+	return dec.FromTree(n, v)
+}
+
+// CustomSchema returns the schema of the values of a codec. It is the schema of the
+// codec if it implements SchemaProvider or a schema of unknown kind with the fixed
+// size of the values otherwise (VariableSize if they have a variable size).
+func CustomSchema(c interface{}, size int) *Schema {
+	if p, ok := c.(SchemaProvider); ok {
+		return p.SSZSchema()
+	}
+	if size == VariableSize {
+		return &Schema{Kind: KindUnknown}
+	}
+	return &Schema{Kind: KindUnknown, Size: uint64(size)}
+}
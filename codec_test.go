@@ -0,0 +1,61 @@
+package ssz
+
+import (
+	"testing"
+)
+
+// bytesCodec encodes byte slices as they are, with a buggy size if the slice is empty
+type bytesCodec struct{}
+
+func (bytesCodec) SizeSSZ(v []byte) int {
+	if len(v) == 0 {
+		return 1
+	}
+	return len(v)
+}
+
+func (bytesCodec) MarshalSSZTo(v []byte, dst []byte) ([]byte, error) {
+	return append(dst, v...), nil
+}
+
+func (bytesCodec) UnmarshalSSZ(v *[]byte, buf []byte) error {
+	*v = append([]byte{}, buf...)
+	return nil
+}
+
+func TestCustomCodec(t *testing.T) {
+	c := bytesCodec{}
+
+	buf, err := MarshalCustom[[]byte](c, []byte{1, 2}, []byte{0}, 2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != string([]byte{0, 1, 2}) {
+		t.Fatalf("bad encoding %v", buf)
+	}
+	if _, err := MarshalCustom[[]byte](c, []byte{1, 2, 3}, nil, 2, 0); err == nil {
+		t.Fatal("expected an error for the fixed size")
+	}
+	if _, err := MarshalCustom[[]byte](c, []byte{1, 2, 3}, nil, VariableSize, 2); err == nil {
+		t.Fatal("expected an error for the max size")
+	}
+	if _, err := MarshalCustom[[]byte](c, nil, nil, VariableSize, 2); err == nil {
+		t.Fatal("expected an error for the size of the codec")
+	}
+
+	var v []byte
+	if err := UnmarshalCustom[[]byte](c, &v, []byte{1, 2, 3}, VariableSize, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := UnmarshalCustom[[]byte](c, &v, []byte{1, 2, 3}, VariableSize, 2); err == nil {
+		t.Fatal("expected an error for the max size")
+	}
+
+	// the codec does not decode trees
+	if err := DecodeTreeCustom(c, LeafFromBytes([]byte{1}), &v); err == nil {
+		t.Fatal("expected an error decoding the tree")
+	}
+	if schema := CustomSchema(c, 2); schema.Kind != KindUnknown || schema.Size != 2 {
+		t.Fatalf("bad schema %+v", schema)
+	}
+}
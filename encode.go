@@ -23,6 +23,8 @@ var (
 	ErrListTooBig            = fmt.Errorf("list length is higher than max value")
 	ErrEmptyBitlist          = fmt.Errorf("bitlist is empty")
 	ErrInvalidVariableOffset = fmt.Errorf("invalid ssz encoding. first variable element offset indexes into fixed value data")
+	ErrBitvectorPadding      = fmt.Errorf("bitvector has bits set after its length")
+	ErrInvalidBool           = fmt.Errorf("boolean is not 0 or 1")
 )
 
 func ErrBytesLengthFn(name string, found, expected int) error {
@@ -233,6 +235,26 @@ func ValidateBitlist(buf []byte, bitLimit uint64) error {
 	return nil
 }
 
+// ValidateBitvector validates that the bitvector of bitLen bits has the correct
+// number of bytes and that the bits of the last byte after the length are unset.
+func ValidateBitvector(buf []byte, bitLen uint64) error {
+	if uint64(len(buf)) != (bitLen+7)/8 {
+		return ErrBytesLength
+	}
+	if rem := bitLen % 8; rem != 0 && buf[len(buf)-1]>>rem != 0 {
+		return ErrBitvectorPadding
+	}
+	return nil
+}
+
+// ValidateBool validates that the encoded boolean is either 0 or 1
+func ValidateBool(src []byte) error {
+	if src[0] > 1 {
+		return ErrInvalidBool
+	}
+	return nil
+}
+
 // DecodeDynamicLength decodes the length from the dynamic input
 func DecodeDynamicLength(buf []byte, maxSize int) (int, error) {
 	if len(buf) == 0 {
@@ -106,3 +106,28 @@ func TestEncode_ExtendUint(t *testing.T) {
 		t.Fatal("uint8 cannot be nil")
 	}
 }
+
+func TestBitvector(t *testing.T) {
+	tests := []struct {
+		bitvector []byte
+		bitLen    uint64
+		valid     bool
+	}{
+		{[]byte{0x0f}, 4, true},
+		{[]byte{0x1f}, 4, false},
+		{[]byte{0xff}, 8, true},
+		{[]byte{0xff, 0x01}, 9, true},
+		{[]byte{0xff, 0x02}, 9, false},
+		{[]byte{0xff}, 9, false},
+		{[]byte{0xff, 0x00}, 8, false},
+	}
+	for _, tt := range tests {
+		err := ValidateBitvector(tt.bitvector, tt.bitLen)
+		if tt.valid && err != nil {
+			t.Errorf("%x: %v", tt.bitvector, err)
+		}
+		if !tt.valid && err == nil {
+			t.Errorf("%x: invalid bitvector did not fail validation", tt.bitvector)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -15,10 +16,32 @@ import (
 type Fuzzer struct {
 	r         *rand.Rand
 	failRatio float64
+	strategy  Strategy
 }
 
-func randomInt(min, max int) int {
-	return min + rand.Intn(max-min)
+// Strategy is the way the fuzzer picks the sizes and the values of the fields
+type Strategy int
+
+const (
+	// StrategyRandom picks the sizes of the ssz tags, with at most 1000 elements
+	// for the longer lists, and random values. The bitlists have their length bit
+	// and the bitvectors (including the go-bitfield types of the cast-type tag) do
+	// not set the bits after their size.
+	StrategyRandom Strategy = iota
+
+	// StrategyBoundary picks the sizes at the edges of the ssz tags (zero, one,
+	// the limit minus one and the limit) for each dimension, bitlists with the length
+	// bit at those edges and the extreme values of the integers. The sizes out of
+	// bounds are the limit plus one (or minus one for fixed sizes).
+	StrategyBoundary
+)
+
+// MaxBoundary is the highest limit used by StrategyBoundary, the lists with higher
+// limits (i.e. the validators of the beacon state) are at most of this size.
+var MaxBoundary = 4096
+
+func randomInt(r *rand.Rand, min, max int) int {
+	return min + r.Intn(max-min)
 }
 
 // New returns a new Fuzzer.
@@ -39,21 +62,78 @@ func (f *Fuzzer) SetFailureRatio(failRatio float64) {
 	f.failRatio = failRatio
 }
 
+// SetStrategy sets the strategy of the fuzzer, StrategyRandom by default
+func (f *Fuzzer) SetStrategy(strategy Strategy) {
+	f.strategy = strategy
+}
+
+// Report is the result of filling an object
+type Report struct {
+	// Seed fills the same object again with FuzzSeed
+	Seed int64
+	// OutOfBounds are the fields whose size does not respect the ssz tags
+	OutOfBounds []*OutOfBounds
+}
+
+// Failed returns true if the object does not respect the ssz tags
+func (r *Report) Failed() bool {
+	return len(r.OutOfBounds) != 0
+}
+
+// OutOfBounds is a field pushed out of the bounds of its ssz tags
+type OutOfBounds struct {
+	// Field is the path of the field (i.e. Body.Attestations[1].AggregationBits)
+	Field string
+	// Size is the number of elements of the field, bits for the bitlists
+	Size int
+	// Limit is the fixed size or the maximum size of the field
+	Limit int
+	// Max is true if the limit is a maximum size
+	Max bool
+}
+
+func (o *OutOfBounds) String() string {
+	if o.Max {
+		return fmt.Sprintf("%s: size %d higher than the max %d", o.Field, o.Size, o.Limit)
+	}
+	return fmt.Sprintf("%s: size %d instead of %d", o.Field, o.Size, o.Limit)
+}
+
 // Fuzz recursively fills all of obj's fields with something random
 func (f *Fuzzer) Fuzz(obj interface{}) bool {
+	return f.FuzzReport(obj).Failed()
+}
+
+// FuzzReport fills obj like Fuzz and returns the seed of the object
+// and the fields pushed out of bounds
+func (f *Fuzzer) FuzzReport(obj interface{}) *Report {
+	return f.FuzzSeed(obj, f.r.Int63())
+}
+
+// FuzzSeed fills obj with the values of the seed of a report. The
+// object is the same if the fuzzer has the same options.
+func (f *Fuzzer) FuzzSeed(obj interface{}, seed int64) *Report {
 	v := reflect.ValueOf(obj)
 	if v.Kind() != reflect.Ptr {
 		panic("needed ptr!")
 	}
 	v = v.Elem()
-	fc := &fuzzerContext{fuzzer: f}
-	fc.doFuzz(v, "")
-	return fc.failed
+	fc := &fuzzerContext{fuzzer: f, r: rand.New(rand.NewSource(seed))}
+	fc.doFuzz(v, "", "")
+	return &Report{Seed: seed, OutOfBounds: fc.outOfBounds}
 }
 
 type fuzzerContext struct {
-	fuzzer *Fuzzer
-	failed bool
+	fuzzer      *Fuzzer
+	r           *rand.Rand
+	failed      bool
+	outOfBounds []*OutOfBounds
+}
+
+// fail records that the field is out of bounds
+func (fc *fuzzerContext) fail(field string, size, limit int, isMax bool) {
+	fc.failed = true
+	fc.outOfBounds = append(fc.outOfBounds, &OutOfBounds{Field: field, Size: size, Limit: limit, Max: isMax})
 }
 
 func convertNum(str string) int {
@@ -64,44 +144,74 @@ func convertNum(str string) int {
 	return num
 }
 
-func (f *Fuzzer) getShoudlFail() bool {
-	return f.r.Float64() < f.failRatio
+func (fc *fuzzerContext) getShoudlFail() bool {
+	return !fc.failed && fc.r.Float64() < fc.fuzzer.failRatio
 }
 
-func (fc *fuzzerContext) getRandomNum(maxStr string, isMax bool) int {
+func (fc *fuzzerContext) getRandomNum(field, maxStr string, isMax bool) int {
 	max := convertNum(maxStr)
-	if max > 5000 {
-		// hard cap for long values in Beacon state
+	if fc.fuzzer.strategy == StrategyBoundary {
+		return fc.getBoundaryNum(field, max, isMax)
+	}
+	if isMax && max > 5000 {
+		// hard cap for long lists in Beacon state, the vectors keep their size
 		return 1000
 	}
-	if !fc.failed {
-		if fc.fuzzer.getShoudlFail() {
-			fc.failed = true
-			if isMax {
-				nn := randomInt(max+1, max+10)
-				return nn
-			}
+	if fc.getShoudlFail() {
+		var num int
+		if isMax {
+			num = randomInt(fc.r, max+1, max+10)
+		} else {
 			// fixed size, return lower and higher values and avoid the
 			// input value since we already set ourselves as failed
-			num := randomInt(max-10, max+10)
+			num = randomInt(fc.r, max-10, max+10)
 			if num == max {
-				return num + 1
+				num++
 			}
 			if num < 0 {
 				num = 1
 			}
-			return num
 		}
+		fc.fail(field, num, max, isMax)
+		return num
 	}
 	return max
 }
 
-func (fc *fuzzerContext) genElementCount(tag reflect.StructTag) (reflect.StructTag, int) {
+// getBoundaryNum returns a size at the edges of the limit
+func (fc *fuzzerContext) getBoundaryNum(field string, limit int, isMax bool) int {
+	if fc.getShoudlFail() {
+		num := limit + 1
+		if !isMax && limit > 0 && randBool(fc.r) {
+			num = limit - 1
+		}
+		fc.fail(field, num, limit, isMax)
+		return num
+	}
+	if !isMax {
+		return limit
+	}
+	if limit > MaxBoundary {
+		return []int{0, 1, randomInt(fc.r, 2, MaxBoundary)}[fc.r.Intn(3)]
+	}
+	nums := []int{0, 1, limit - 1, limit}
+	num := nums[fc.r.Intn(len(nums))]
+	if num < 0 {
+		num = 0
+	}
+	return num
+}
+
+func (fc *fuzzerContext) genElementCount(field string, tag reflect.StructTag) (reflect.StructTag, int) {
 	if size := tag.Get("ssz-size"); size != "" {
 		indx := strings.Index(size, ",")
 		if indx == -1 {
+			if size == "?" {
+				// the last dimension is a list
+				return "", fc.getRandomNum(field, tag.Get("ssz-max"), true)
+			}
 			// just one size
-			return "", fc.getRandomNum(size, false)
+			return "", fc.getRandomNum(field, size, false)
 		}
 
 		var num int
@@ -111,56 +221,124 @@ func (fc *fuzzerContext) genElementCount(tag reflect.StructTag) (reflect.StructT
 			if max == "" {
 				panic("BUG: Max tag expected after ?")
 			}
-			num = fc.getRandomNum(max, true)
+			// the first max is the limit of this dimension
+			max = strings.Split(max, ",")[0]
+			num = fc.getRandomNum(field, max, true)
 		} else {
 			// its a number
-			num = fc.getRandomNum(size[:indx], false)
+			num = fc.getRandomNum(field, size[:indx], false)
 		}
 
 		// a,b
-		return reflect.StructTag("ssz-size:\"" + size[indx+1:] + "\""), num
+		subTag := "ssz-size:\"" + size[indx+1:] + "\""
+		if max := tag.Get("ssz-max"); strings.Contains(max, ",") {
+			subTag += " ssz-max:\"" + max[strings.Index(max, ",")+1:] + "\""
+		}
+		return reflect.StructTag(subTag), num
 	}
 	if max := tag.Get("ssz-max"); max != "" {
-		return "", fc.getRandomNum(max, true)
+		indx := strings.Index(max, ",")
+		if indx == -1 {
+			return "", fc.getRandomNum(field, max, true)
+		}
+		// the inner dimensions have a max size too
+		return reflect.StructTag("ssz-max:\"" + max[indx+1:] + "\""), fc.getRandomNum(field, max[:indx], true)
 	}
 	if typ := tag.Get("ssz"); typ != "" {
 		if typ == "bitlist" {
-			return "", randomInt(1, 10)
+			return "", randomInt(fc.r, 1, 10)
 		}
 	}
 	panic("BUG: Tags not expected")
 }
 
-func (fc *fuzzerContext) doFuzz(v reflect.Value, tag reflect.StructTag) {
+// fuzzBitlist fills a bitlist with a number of bits picked by the strategy
+// like the size of a list, the length bit is set after the last bit
+func (fc *fuzzerContext) fuzzBitlist(v reflect.Value, field, max string) {
+	bits := fc.getRandomNum(field, max, true)
+	buf := make([]byte, bits/8+1)
+	fc.r.Read(buf)
+	// unset the bits after the length and set the length bit
+	buf[len(buf)-1] &= byte(1)<<(bits%8) - 1
+	buf[len(buf)-1] |= byte(1) << (bits % 8)
+	v.SetBytes(buf)
+}
+
+// bitvectorRegexp matches the go-bitfield bitvector types of the cast-type tag
+var bitvectorRegexp = regexp.MustCompile(`Bitvector(\d+)$`)
+
+// bitvectorSize returns the size in bits of a bitvector field, either the ssz-size
+// of the 'bitvector' option of the ssz tag or the size of the go-bitfield type of
+// the cast-type tag if it is not a multiple of 8
+func bitvectorSize(tag reflect.StructTag) (string, bool) {
+	if tag.Get("ssz") == "bitvector" && tag.Get("ssz-size") != "" {
+		return tag.Get("ssz-size"), true
+	}
+	match := bitvectorRegexp.FindStringSubmatch(tag.Get("cast-type"))
+	if match == nil || convertNum(match[1])%8 == 0 {
+		return "", false
+	}
+	return match[1], true
+}
+
+// fuzzBitvector fills a bitvector of the given size in bits, the bits
+// after the size in the last byte are not set
+func (fc *fuzzerContext) fuzzBitvector(v reflect.Value, field, size string) {
+	bits := fc.getRandomNum(field, size, false)
+	buf := make([]byte, (bits+7)/8)
+	fc.r.Read(buf)
+	if bits%8 != 0 {
+		buf[len(buf)-1] &= byte(1)<<(bits%8) - 1
+	}
+	v.SetBytes(buf)
+}
+
+func (fc *fuzzerContext) doFuzz(v reflect.Value, tag reflect.StructTag, field string) {
 	if !v.CanSet() {
 		return
 	}
 
 	switch v.Kind() {
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		fuzzUint(v, fc.fuzzer.r)
+		if fc.fuzzer.strategy == StrategyBoundary {
+			fuzzBoundaryUint(v, fc.r)
+		} else {
+			fuzzUint(v, fc.r)
+		}
 
 	case reflect.Bool:
-		v.SetBool(randBool(fc.fuzzer.r))
+		v.SetBool(randBool(fc.r))
 
 	case reflect.String:
-		v.SetString(randString(fc.fuzzer.r.Int(), letters))
+		v.SetString(randString(fc.r, fc.r.Int(), letters))
 
 	case reflect.Ptr:
 		v.Set(reflect.New(v.Type().Elem()))
-		fc.doFuzz(v.Elem(), "")
+		fc.doFuzz(v.Elem(), "", field)
 		return
 
 	case reflect.Slice:
-		subTag, n := fc.genElementCount(tag)
+		if tag.Get("ssz") == "bitlist" && tag.Get("ssz-max") != "" {
+			fc.fuzzBitlist(v, field, tag.Get("ssz-max"))
+			return
+		}
+		if size, ok := bitvectorSize(tag); ok {
+			fc.fuzzBitvector(v, field, size)
+			return
+		}
+		subTag, n := fc.genElementCount(field, tag)
 		v.Set(reflect.MakeSlice(v.Type(), n, n))
 		for i := 0; i < n; i++ {
-			fc.doFuzz(v.Index(i), subTag)
+			fc.doFuzz(v.Index(i), subTag, elemField(v, field, i))
 		}
 
 	case reflect.Struct:
 		typ := v.Type()
 		for i := 0; i < v.NumField(); i++ {
+			name := typ.Field(i).Name
+			if field != "" {
+				name = field + "." + name
+			}
 			// fuzz nil values if the field of the struct is
 			// another struct
 			if isPtrToStruct(v.Field(i)) {
@@ -168,13 +346,13 @@ func (fc *fuzzerContext) doFuzz(v reflect.Value, tag reflect.StructTag) {
 					continue
 				}
 			}
-			fc.doFuzz(v.Field(i), typ.Field(i).Tag)
+			fc.doFuzz(v.Field(i), typ.Field(i).Tag, name)
 		}
 
 	case reflect.Array:
 		n := v.Len()
 		for i := 0; i < n; i++ {
-			fc.doFuzz(v.Index(i), tag)
+			fc.doFuzz(v.Index(i), tag, elemField(v, field, i))
 		}
 
 	default:
@@ -182,13 +360,21 @@ func (fc *fuzzerContext) doFuzz(v reflect.Value, tag reflect.StructTag) {
 	}
 }
 
+// elemField returns the path of an element of the slice or array, the elements of
+// basic types are not out of bounds and their path is not built
+func elemField(v reflect.Value, field string, i int) string {
+	switch v.Type().Elem().Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Bool:
+		return ""
+	}
+	return indexField(field, i)
+}
+
 func (fc *fuzzerContext) addNil(v reflect.Value) bool {
-	if !fc.failed {
-		if fc.fuzzer.getShoudlFail() {
-			// set to nil, we dont fail because marshal fills empty values
-			v.Set(reflect.Zero(v.Type()))
-			return true
-		}
+	if fc.getShoudlFail() {
+		// set to nil, we dont fail because marshal fills empty values
+		v.Set(reflect.Zero(v.Type()))
+		return true
 	}
 	return false
 }
@@ -210,6 +396,13 @@ func fuzzUint(v reflect.Value, r *rand.Rand) {
 	v.SetUint(randUint64(r))
 }
 
+// fuzzBoundaryUint sets the extremes of the integer type or a random value
+func fuzzBoundaryUint(v reflect.Value, r *rand.Rand) {
+	max := uint64(1)<<(v.Type().Bits()-1)<<1 - 1
+	nums := []uint64{0, 1, max - 1, max, randUint64(r) & max}
+	v.SetUint(nums[r.Intn(len(nums))])
+}
+
 func randBool(r *rand.Rand) bool {
 	if r.Int()&1 == 1 {
 		return true
@@ -219,10 +412,10 @@ func randBool(r *rand.Rand) bool {
 
 const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 
-func randString(n int, dict string) string {
+func randString(r *rand.Rand, n int, dict string) string {
 	b := make([]byte, n)
 	for i := range b {
-		b[i] = dict[rand.Intn(len(dict))]
+		b[i] = dict[r.Intn(len(dict))]
 	}
 	return string(b)
 }
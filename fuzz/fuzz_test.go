@@ -0,0 +1,178 @@
+package fuzz
+
+import (
+	"math/bits"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type boundaryObj struct {
+	A uint16
+	B []uint64 `ssz-max:"4"`
+	C []byte   `ssz-size:"8"`
+	D [][]byte `ssz-size:"?,32" ssz-max:"3"`
+	E []byte   `ssz:"bitlist" ssz-max:"12"`
+	F *boundaryInner
+}
+
+type boundaryInner struct {
+	G [][]byte `ssz-max:"2,5"`
+}
+
+func TestFuzz_Boundary(t *testing.T) {
+	f := NewWithSeed(1)
+	f.SetStrategy(StrategyBoundary)
+
+	sizes := map[string]map[int]bool{}
+	add := func(name string, size int) {
+		if sizes[name] == nil {
+			sizes[name] = map[int]bool{}
+		}
+		sizes[name][size] = true
+	}
+	for i := 0; i < 200; i++ {
+		obj := new(boundaryObj)
+		if report := f.FuzzReport(obj); report.Failed() {
+			t.Fatalf("unexpected out of bounds: %v", report.OutOfBounds)
+		}
+		if len(obj.C) != 8 {
+			t.Fatalf("bad fixed size %d", len(obj.C))
+		}
+		for _, elem := range obj.D {
+			if len(elem) != 32 {
+				t.Fatalf("bad inner fixed size %d", len(elem))
+			}
+		}
+		last := obj.E[len(obj.E)-1]
+		if last == 0 {
+			t.Fatal("bitlist without length bit")
+		}
+		bitLen := (len(obj.E)-1)*8 + bits.Len8(last) - 1
+		add("A", int(obj.A))
+		add("B", len(obj.B))
+		add("D", len(obj.D))
+		add("E", bitLen)
+		if obj.F != nil {
+			add("G", len(obj.F.G))
+			for _, elem := range obj.F.G {
+				add("G[]", len(elem))
+			}
+		}
+	}
+
+	expected := map[string][]int{
+		"A":   {0, 1, 65534, 65535},
+		"B":   {0, 1, 3, 4},
+		"D":   {0, 1, 2, 3},
+		"E":   {0, 1, 11, 12},
+		"G":   {0, 1, 2},
+		"G[]": {0, 1, 4, 5},
+	}
+	for name, nums := range expected {
+		for _, num := range nums {
+			if !sizes[name][num] {
+				t.Fatalf("size %d of %s not generated: %v", num, name, sizes[name])
+			}
+		}
+	}
+	for _, name := range []string{"B", "D", "E", "G", "G[]"} {
+		for num := range sizes[name] {
+			if num > expected[name][len(expected[name])-1] {
+				t.Fatalf("size %d of %s is out of bounds", num, name)
+			}
+		}
+	}
+}
+
+func TestFuzz_Report(t *testing.T) {
+	f := NewWithSeed(2)
+	f.SetStrategy(StrategyBoundary)
+	f.SetFailureRatio(0.2)
+
+	failed := 0
+	for i := 0; i < 100; i++ {
+		obj := new(boundaryObj)
+		report := f.FuzzReport(obj)
+
+		// the seed fills the same object
+		obj2 := new(boundaryObj)
+		report2 := f.FuzzSeed(obj2, report.Seed)
+		if !reflect.DeepEqual(obj, obj2) || !reflect.DeepEqual(report, report2) {
+			t.Fatal("the seed does not fill the same object")
+		}
+
+		if !report.Failed() {
+			continue
+		}
+		failed++
+		if len(report.OutOfBounds) != 1 {
+			t.Fatalf("expected one field out of bounds: %v", report.OutOfBounds)
+		}
+		res := report.OutOfBounds[0]
+		var size int
+		switch {
+		case res.Field == "B":
+			size = len(obj.B)
+		case res.Field == "C":
+			size = len(obj.C)
+		case res.Field == "D":
+			size = len(obj.D)
+		case strings.HasPrefix(res.Field, "D["):
+			size = len(obj.D[res.Field[2]-'0'])
+		case res.Field == "E":
+			size = res.Size
+		case strings.HasPrefix(res.Field, "F.G"):
+			size = res.Size
+		default:
+			t.Fatalf("unexpected field %s", res.Field)
+		}
+		if size != res.Size || res.Size == res.Limit || (res.Max && res.Size != res.Limit+1) {
+			t.Fatalf("bad report %s", res)
+		}
+	}
+	if failed == 0 {
+		t.Fatal("no objects out of bounds")
+	}
+}
+
+type randomObj struct {
+	A []byte   `ssz:"bitlist" ssz-max:"2048"`
+	B []byte   `ssz:"bitvector" ssz-size:"5"`
+	C [][]byte `ssz-size:"8192,32"`
+	D []uint64 `ssz-max:"1099511627776"`
+	E []byte   `cast-type:"github.com/prysmaticlabs/go-bitfield.Bitvector4" ssz-size:"1"`
+}
+
+func TestFuzz_Random(t *testing.T) {
+	f := NewWithSeed(1)
+
+	for i := 0; i < 20; i++ {
+		obj := new(randomObj)
+		if report := f.FuzzReport(obj); report.Failed() {
+			t.Fatalf("unexpected out of bounds: %v", report.OutOfBounds)
+		}
+		// the bitlists have the length bit within the max size
+		last := obj.A[len(obj.A)-1]
+		if last == 0 {
+			t.Fatal("bitlist without length bit")
+		}
+		if bitLen := (len(obj.A)-1)*8 + bits.Len8(last) - 1; bitLen > 2048 {
+			t.Fatalf("bitlist of %d bits", bitLen)
+		}
+		// the bitvectors do not set the bits after their size
+		if len(obj.B) != 1 || obj.B[0]>>5 != 0 {
+			t.Fatalf("bad bitvector %x", obj.B)
+		}
+		if len(obj.E) != 1 || obj.E[0]>>4 != 0 {
+			t.Fatalf("bad go-bitfield bitvector %x", obj.E)
+		}
+		// the long vectors keep their size and the long lists are capped
+		if len(obj.C) != 8192 {
+			t.Fatalf("bad vector size %d", len(obj.C))
+		}
+		if len(obj.D) > 1000 {
+			t.Fatalf("bad list size %d", len(obj.D))
+		}
+	}
+}
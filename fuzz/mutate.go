@@ -0,0 +1,447 @@
+package fuzz
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"strconv"
+
+	ssz "github.com/NilFoundation/fastssz"
+)
+
+// MutationKind is the kind of a targeted mutation of an encoding
+type MutationKind int
+
+const (
+	// MutationOffsetPastEnd points an offset after the end of its container or list
+	MutationOffsetPastEnd MutationKind = iota
+	// MutationOffsetIntoFixed points an offset inside the fixed part of its container or list
+	MutationOffsetIntoFixed
+	// MutationOffsetDecrease points an offset before the previous offset
+	MutationOffsetDecrease
+	// MutationOffsetGap inserts a byte after the fixed part, so the first offset
+	// leaves a gap after the fixed part
+	MutationOffsetGap
+	// MutationListPartial removes a byte of a list of fixed size elements, so
+	// the list does not have a whole number of elements
+	MutationListPartial
+	// MutationListOverLimit replaces a list of fixed size elements with a list
+	// of one element more than its limit
+	MutationListOverLimit
+	// MutationBitlistNoDelimiter unsets the last byte of a bitlist, which has the length bit
+	MutationBitlistNoDelimiter
+	// MutationBitlistTrailingZero appends a zero byte to a bitlist
+	MutationBitlistTrailingZero
+	// MutationBitlistOverLimit replaces a bitlist with a bitlist of one bit more than its limit
+	MutationBitlistOverLimit
+	// MutationBoolInvalid sets a boolean to 2
+	MutationBoolInvalid
+	// MutationTruncate removes the last byte of the encoding
+	MutationTruncate
+	// MutationExtend appends a byte to the encoding
+	MutationExtend
+)
+
+func (k MutationKind) String() string {
+	switch k {
+	case MutationOffsetPastEnd:
+		return "offset past end"
+	case MutationOffsetIntoFixed:
+		return "offset into fixed part"
+	case MutationOffsetDecrease:
+		return "offset decrease"
+	case MutationOffsetGap:
+		return "offset gap"
+	case MutationListPartial:
+		return "list partial element"
+	case MutationListOverLimit:
+		return "list over limit"
+	case MutationBitlistNoDelimiter:
+		return "bitlist without length bit"
+	case MutationBitlistTrailingZero:
+		return "bitlist trailing zero"
+	case MutationBitlistOverLimit:
+		return "bitlist over limit"
+	case MutationBoolInvalid:
+		return "invalid bool"
+	case MutationTruncate:
+		return "truncate"
+	case MutationExtend:
+		return "extend"
+	default:
+		return "mutation(" + strconv.Itoa(int(k)) + ")"
+	}
+}
+
+// Mutation is a malformed encoding created from a valid one
+type Mutation struct {
+	Kind MutationKind
+	// Field is the path of the mutated value (i.e. Body.Attestations[1].AggregationBits),
+	// empty for the whole encoding
+	Field string
+	// Data is the malformed encoding
+	Data []byte
+}
+
+func (m *Mutation) String() string {
+	if m.Field == "" {
+		return m.Kind.String()
+	}
+	return m.Kind.String() + " of " + m.Field
+}
+
+// maxOverLimit is the highest size of the lists created over their limit
+const maxOverLimit = 1 << 16
+
+// Mutator applies targeted mutations to the offsets, the lists and the bitlists
+// of the encodings of a type described by its schema (see ssz.SchemaOf).
+type Mutator struct {
+	schema *ssz.Schema
+	r      *rand.Rand
+}
+
+// NewMutator returns a mutator of the encodings of the schema. The seed
+// selects the mutations if there are more than requested.
+func NewMutator(schema *ssz.Schema, seed int64) *Mutator {
+	return &Mutator{schema: schema, r: rand.New(rand.NewSource(seed))}
+}
+
+// Mutations returns up to max mutations of the valid encoding buf, all of them if
+// max is zero. It fails if the encoding does not follow the schema.
+func (m *Mutator) Mutations(buf []byte, max int) ([]*Mutation, error) {
+	l := &layout{buf: buf}
+	if err := l.parse(m.schema, 0, len(buf), ""); err != nil {
+		return nil, err
+	}
+	candidates := l.candidates()
+	if max > 0 && len(candidates) > max {
+		m.r.Shuffle(len(candidates), func(i, j int) {
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		})
+		candidates = candidates[:max]
+	}
+	mutations := make([]*Mutation, 0, len(candidates))
+	for _, c := range candidates {
+		mutations = append(mutations, &Mutation{Kind: c.kind, Field: c.field, Data: c.apply()})
+	}
+	return mutations, nil
+}
+
+// Mutate returns a random mutation of the valid encoding buf
+func (m *Mutator) Mutate(buf []byte) (*Mutation, error) {
+	mutations, err := m.Mutations(buf, 1)
+	if err != nil {
+		return nil, err
+	}
+	return mutations[0], nil
+}
+
+// offset is an offset of a container or a list of variable size elements
+type offset struct {
+	field string
+	// pos is the position of the offset in the encoding
+	pos int
+	// base is the position of the container or the list, the value is relative to it
+	base int
+	// index is the index of the offset in the container or list
+	index int
+	// fixed is the size of the fixed part of the container or list
+	fixed int
+	// size is the size of the container or list
+	size int
+	// value is the value of the offset
+	value int
+	// prev is the value of the previous offset
+	prev int
+}
+
+// region is a bitlist or a list of fixed size elements
+type region struct {
+	field      string
+	start, end int
+	schema     *ssz.Schema
+	elemSize   int
+}
+
+type layout struct {
+	buf      []byte
+	offsets  []*offset
+	lists    []*region
+	bitlists []*region
+	bools    []*region
+}
+
+func fixedSize(s *ssz.Schema) (int, bool) {
+	switch s.Kind {
+	case ssz.KindUint, ssz.KindBool:
+		return int(s.Size), true
+	case ssz.KindVector:
+		size, ok := fixedSize(s.Elem)
+		return size * int(s.Size), ok
+	case ssz.KindContainer:
+		total := 0
+		for _, f := range s.Fields {
+			size, ok := fixedSize(f.Schema)
+			if !ok {
+				return 0, false
+			}
+			total += size
+		}
+		return total, true
+	case ssz.KindUnknown:
+		return int(s.Size), s.Size != 0
+	default:
+		return 0, false
+	}
+}
+
+func (l *layout) parse(s *ssz.Schema, start, end int, field string) error {
+	if start > end || end > len(l.buf) {
+		return fmt.Errorf("%s: region %d:%d out of the encoding", fieldName(field), start, end)
+	}
+	switch s.Kind {
+	case ssz.KindBool:
+		l.bools = append(l.bools, &region{field: field, start: start, end: end, schema: s})
+
+	case ssz.KindBitlist:
+		l.bitlists = append(l.bitlists, &region{field: field, start: start, end: end, schema: s})
+
+	case ssz.KindVector, ssz.KindList:
+		if elemSize, ok := fixedSize(s.Elem); ok {
+			if elemSize == 0 || (end-start)%elemSize != 0 {
+				return fmt.Errorf("%s: size %d is not a multiple of %d", fieldName(field), end-start, elemSize)
+			}
+			if s.Kind == ssz.KindList {
+				l.lists = append(l.lists, &region{field: field, start: start, end: end, schema: s, elemSize: elemSize})
+			}
+			if s.Elem.IsBasic() && s.Elem.Kind != ssz.KindBool {
+				return nil
+			}
+			for i := 0; start+i*elemSize < end; i++ {
+				pos := start + i*elemSize
+				if err := l.parse(s.Elem, pos, pos+elemSize, indexField(field, i)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		count := int(s.Size)
+		if s.Kind == ssz.KindList {
+			if start == end {
+				return nil
+			}
+			if end-start < 4 {
+				return fmt.Errorf("%s: no offsets", fieldName(field))
+			}
+			count = int(binary.LittleEndian.Uint32(l.buf[start:])) / 4
+		}
+		elems := make([]*ssz.Schema, count)
+		fields := make([]string, count)
+		positions := make([]int, count)
+		for i := range elems {
+			elems[i] = s.Elem
+			fields[i] = indexField(field, i)
+			positions[i] = 4 * i
+		}
+		return l.parseVariable(start, end, 4*count, elems, fields, positions)
+
+	case ssz.KindContainer:
+		fixed := 0
+		elems := []*ssz.Schema{}
+		fields := []string{}
+		positions := []int{}
+		for _, f := range s.Fields {
+			name := f.Name
+			if field != "" {
+				name = field + "." + f.Name
+			}
+			size, ok := fixedSize(f.Schema)
+			if !ok {
+				elems = append(elems, f.Schema)
+				fields = append(fields, name)
+				positions = append(positions, fixed)
+				fixed += 4
+				continue
+			}
+			if err := l.parse(f.Schema, start+fixed, start+fixed+size, name); err != nil {
+				return err
+			}
+			fixed += size
+		}
+		return l.parseVariable(start, end, fixed, elems, fields, positions)
+
+	case ssz.KindUnknown:
+		if s.Size == 0 {
+			return fmt.Errorf("%s: unknown layout", fieldName(field))
+		}
+	}
+	return nil
+}
+
+// parseVariable parses the offsets at the positions of the fixed part of a container
+// or a list and the variable size values they point to
+func (l *layout) parseVariable(start, end, fixed int, elems []*ssz.Schema, fields []string, positions []int) error {
+	if start+fixed > end {
+		return fmt.Errorf("fixed part of %d bytes out of the encoding", fixed)
+	}
+	values := make([]int, len(elems))
+	for i := range elems {
+		pos := start + positions[i]
+		values[i] = int(binary.LittleEndian.Uint32(l.buf[pos:]))
+		prev := -1
+		if i != 0 {
+			prev = values[i-1]
+		}
+		l.offsets = append(l.offsets, &offset{
+			field: fields[i],
+			pos:   pos,
+			base:  start,
+			index: i,
+			fixed: fixed,
+			size:  end - start,
+			value: values[i],
+			prev:  prev,
+		})
+	}
+	for i, elem := range elems {
+		elemEnd := end - start
+		if i+1 < len(values) {
+			elemEnd = values[i+1]
+		}
+		if values[i] > elemEnd {
+			return fmt.Errorf("%s: offset %d after the next one", fieldName(fields[i]), values[i])
+		}
+		if err := l.parse(elem, start+values[i], start+elemEnd, fields[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func indexField(field string, i int) string {
+	return field + "[" + strconv.Itoa(i) + "]"
+}
+
+func fieldName(field string) string {
+	if field == "" {
+		return "object"
+	}
+	return field
+}
+
+type candidate struct {
+	kind  MutationKind
+	field string
+	apply func() []byte
+}
+
+func (l *layout) candidates() []*candidate {
+	res := []*candidate{}
+	add := func(kind MutationKind, field string, apply func() []byte) {
+		res = append(res, &candidate{kind: kind, field: field, apply: apply})
+	}
+
+	for _, o := range l.offsets {
+		o := o
+		add(MutationOffsetPastEnd, o.field, func() []byte {
+			return l.setOffset(o, o.size+1)
+		})
+		if o.fixed > 0 {
+			add(MutationOffsetIntoFixed, o.field, func() []byte {
+				return l.setOffset(o, o.fixed-1)
+			})
+		}
+		if o.index > 0 && o.prev > 0 {
+			add(MutationOffsetDecrease, o.field, func() []byte {
+				return l.setOffset(o, o.prev-1)
+			})
+		}
+		if o.index == 0 {
+			add(MutationOffsetGap, o.field, func() []byte {
+				pos := o.base + o.fixed
+				return l.splice("", pos, pos, []byte{0})
+			})
+		}
+	}
+	for _, r := range l.lists {
+		r := r
+		if r.end > r.start && r.elemSize > 1 {
+			add(MutationListPartial, r.field, func() []byte {
+				return l.splice(r.field, r.end-1, r.end, nil)
+			})
+		}
+		if size := (int(r.schema.Limit) + 1) * r.elemSize; size <= maxOverLimit {
+			add(MutationListOverLimit, r.field, func() []byte {
+				return l.splice(r.field, r.start, r.end, make([]byte, size))
+			})
+		}
+	}
+	for _, r := range l.bitlists {
+		r := r
+		if r.end > r.start {
+			add(MutationBitlistNoDelimiter, r.field, func() []byte {
+				buf := append([]byte{}, l.buf...)
+				buf[r.end-1] = 0
+				return buf
+			})
+		}
+		add(MutationBitlistTrailingZero, r.field, func() []byte {
+			return l.splice(r.field, r.end, r.end, []byte{0})
+		})
+		if limit := int(r.schema.Limit); limit/8+1 <= maxOverLimit {
+			add(MutationBitlistOverLimit, r.field, func() []byte {
+				bits := make([]byte, (limit+1)/8+1)
+				bits[len(bits)-1] = 1 << ((limit + 1) % 8)
+				return l.splice(r.field, r.start, r.end, bits)
+			})
+		}
+	}
+	for _, r := range l.bools {
+		r := r
+		add(MutationBoolInvalid, r.field, func() []byte {
+			buf := append([]byte{}, l.buf...)
+			buf[r.start] = 2
+			return buf
+		})
+	}
+	if len(l.buf) != 0 {
+		add(MutationTruncate, "", func() []byte {
+			return append([]byte{}, l.buf[:len(l.buf)-1]...)
+		})
+	}
+	add(MutationExtend, "", func() []byte {
+		return append(append([]byte{}, l.buf...), 0)
+	})
+	return res
+}
+
+// setOffset returns a copy of the encoding with a new value of the offset
+func (l *layout) setOffset(o *offset, value int) []byte {
+	buf := append([]byte{}, l.buf...)
+	binary.LittleEndian.PutUint32(buf[o.pos:], uint32(value))
+	return buf
+}
+
+// splice returns a copy of the encoding with the bytes between start and end of the field
+// replaced. The offsets that point after the replaced bytes are moved to keep the other
+// values, except the offset of the field itself.
+func (l *layout) splice(field string, start, end int, data []byte) []byte {
+	delta := len(data) - (end - start)
+
+	buf := make([]byte, 0, len(l.buf)+delta)
+	buf = append(buf, l.buf[:start]...)
+	buf = append(buf, data...)
+	buf = append(buf, l.buf[end:]...)
+
+	for _, o := range l.offsets {
+		if o.base > start || o.base+o.value < end || (o.field == field && field != "") {
+			continue
+		}
+		pos := o.pos
+		if pos >= end {
+			pos += delta
+		}
+		binary.LittleEndian.PutUint32(buf[pos:], uint32(o.value+delta))
+	}
+	return buf
+}
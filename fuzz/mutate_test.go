@@ -0,0 +1,101 @@
+package fuzz_test
+
+import (
+	"testing"
+
+	"github.com/NilFoundation/fastssz/fuzz"
+	"github.com/NilFoundation/fastssz/spectests/generic"
+	"github.com/NilFoundation/fastssz/ssztest"
+)
+
+func TestMutator(t *testing.T) {
+	obj := &generic.ComplexTestStruct{
+		A: 1,
+		B: []uint16{1, 2},
+		C: 3,
+		D: []byte{4, 5, 6},
+		E: &generic.VarTestStruct{A: 7, B: []uint16{8}, C: 9},
+		G: [2]*generic.VarTestStruct{
+			{A: 10, B: []uint16{11, 12}, C: 13},
+			{A: 14, B: []uint16{}, C: 15},
+		},
+	}
+	for i := range obj.F {
+		obj.F[i] = &generic.FixedTestStruct{A: uint8(i)}
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mutations, err := fuzz.NewMutator(obj.SSZSchema(), 1).Mutations(buf, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kinds := map[fuzz.MutationKind]int{}
+	for _, m := range mutations {
+		kinds[m.Kind]++
+		obj2 := new(generic.ComplexTestStruct)
+		if err := obj2.UnmarshalSSZ(m.Data); err == nil {
+			t.Fatalf("%s: malformed encoding %x decoded without errors", m, m.Data)
+		}
+	}
+	// the offsets are B, D, E, G, E.B, G[0], G[1], G[0].B and G[1].B (five of them first)
+	// and the lists are B, D, E.B, G[0].B and G[1].B (three of them not empty and not bytes)
+	expected := map[fuzz.MutationKind]int{
+		fuzz.MutationOffsetPastEnd:   9,
+		fuzz.MutationOffsetIntoFixed: 9,
+		fuzz.MutationOffsetDecrease:  4,
+		fuzz.MutationOffsetGap:       5,
+		fuzz.MutationListPartial:     3,
+		fuzz.MutationListOverLimit:   5,
+		fuzz.MutationTruncate:        1,
+		fuzz.MutationExtend:          1,
+	}
+	for kind, num := range expected {
+		if kinds[kind] != num {
+			t.Fatalf("expected %d mutations of kind %s but found %d", num, kind, kinds[kind])
+		}
+	}
+
+	// the seed selects the same mutations
+	a, _ := fuzz.NewMutator(obj.SSZSchema(), 2).Mutations(buf, 5)
+	b, _ := fuzz.NewMutator(obj.SSZSchema(), 2).Mutations(buf, 5)
+	if len(a) != 5 {
+		t.Fatalf("expected 5 mutations but found %d", len(a))
+	}
+	for i := range a {
+		if a[i].String() != b[i].String() {
+			t.Fatal("the same seed selects different mutations")
+		}
+	}
+}
+
+func TestMutator_Bitlist(t *testing.T) {
+	obj := &generic.BitsStruct{A: []byte{0x2b}, B: []byte{0x02}, C: []byte{0x01}, D: []byte{0x48}, E: []byte{0xf0}}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mutations, err := fuzz.NewMutator(obj.SSZSchema(), 1).Mutations(buf, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kinds := map[fuzz.MutationKind]int{}
+	for _, m := range mutations {
+		kinds[m.Kind]++
+		if err := new(generic.BitsStruct).UnmarshalSSZ(m.Data); err == nil {
+			t.Fatalf("%s: malformed encoding %x decoded without errors", m, m.Data)
+		}
+	}
+	for _, kind := range []fuzz.MutationKind{fuzz.MutationBitlistNoDelimiter, fuzz.MutationBitlistTrailingZero, fuzz.MutationBitlistOverLimit} {
+		if kinds[kind] != 2 {
+			t.Fatalf("expected 2 mutations of kind %s but found %d", kind, kinds[kind])
+		}
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	ssztest.Malformed(t, func() ssztest.Object { return new(generic.ComplexTestStruct) })
+	ssztest.Malformed(t, func() ssztest.Object { return new(generic.BitsStruct) })
+}
@@ -0,0 +1,426 @@
+package fuzz
+
+import (
+	"fmt"
+	"go/format"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxShrinkCalls is the maximum number of times Shrink calls the failing predicate
+var MaxShrinkCalls = 10000
+
+// Shrink minimizes obj in place while the failing predicate returns true for it, to
+// debug the objects filled by the fuzzer that break a test. It drops the pointers
+// of the fields, zeroes the values and shortens the lists and the bitlists, keeping
+// the fixed sizes and the limits of the ssz tags. The predicate is called with obj
+// and the panics count as a different failure. It fails if the predicate returns
+// false for the original object. See GoLiteral to print the minimized object.
+func Shrink(obj interface{}, failing func(obj interface{}) bool) error {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr {
+		panic("needed ptr!")
+	}
+	s := &shrinker{obj: obj, failing: failing}
+	if !s.check() {
+		return fmt.Errorf("the predicate does not fail for the object")
+	}
+	// the values are shrunk until a pass does not change the object
+	for s.calls < MaxShrinkCalls {
+		s.changed = false
+		s.shrinkValue(v.Elem(), "", false)
+		if !s.changed {
+			break
+		}
+	}
+	return nil
+}
+
+type shrinker struct {
+	obj     interface{}
+	failing func(obj interface{}) bool
+	calls   int
+	changed bool
+}
+
+func (s *shrinker) check() (failing bool) {
+	s.calls++
+	defer func() {
+		if recover() != nil {
+			failing = false
+		}
+	}()
+	return s.failing(s.obj)
+}
+
+// try sets v to value and keeps it if the object still fails
+func (s *shrinker) try(v, value reflect.Value) bool {
+	if s.calls >= MaxShrinkCalls || reflect.DeepEqual(v.Interface(), value.Interface()) {
+		return false
+	}
+	old := reflect.New(v.Type()).Elem()
+	old.Set(v)
+	v.Set(value)
+	if s.check() {
+		s.changed = true
+		return true
+	}
+	v.Set(old)
+	return false
+}
+
+// shrinkValue shrinks the value v with the ssz tags of its field. The
+// elements of vectors and lists are never nil like the fields.
+func (s *shrinker) shrinkValue(v reflect.Value, tag reflect.StructTag, elem bool) {
+	if !v.CanSet() {
+		return
+	}
+	if s.try(v, zeroOf(v.Type(), tag, elem)) {
+		return
+	}
+	if v.Kind() == reflect.Ptr && !elem && !v.IsNil() {
+		// the field cannot be dropped, try with the zero value
+		if s.try(v, zeroOf(v.Type(), tag, true)) {
+			return
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			s.shrinkValue(v.Elem(), tag, false)
+		}
+
+	case reflect.Struct:
+		typ := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			s.shrinkValue(v.Field(i), typ.Field(i).Tag, false)
+		}
+
+	case reflect.Slice:
+		if tag.Get("ssz") == "bitlist" {
+			s.shrinkBitlist(v)
+			return
+		}
+		subTag, fixed, ok := sliceDim(tag)
+		if !ok {
+			return
+		}
+		if !fixed {
+			s.shortenList(v)
+		}
+		s.shrinkElems(v, subTag)
+
+	case reflect.Array:
+		s.shrinkElems(v, tag)
+	}
+}
+
+// shortenList removes windows of elements of a list, from halves to single elements
+func (s *shrinker) shortenList(v reflect.Value) {
+	for size := v.Len() / 2; size >= 1; size /= 2 {
+		for start := 0; start+size <= v.Len(); {
+			shorter := reflect.MakeSlice(v.Type(), 0, v.Len()-size)
+			shorter = reflect.AppendSlice(shorter, v.Slice(0, start))
+			shorter = reflect.AppendSlice(shorter, v.Slice(start+size, v.Len()))
+			if !s.try(v, shorter) {
+				start += size
+			}
+		}
+	}
+}
+
+// shrinkElems zeroes windows of elements of a vector or a list and shrinks the elements left
+func (s *shrinker) shrinkElems(v reflect.Value, tag reflect.StructTag) {
+	for size := v.Len() / 2; size >= 1; size /= 2 {
+		for start := 0; start+size <= v.Len(); start += size {
+			zeroed := reflect.New(v.Type()).Elem()
+			if v.Kind() == reflect.Slice {
+				zeroed.Set(reflect.MakeSlice(v.Type(), v.Len(), v.Len()))
+			}
+			reflect.Copy(zeroed, v)
+			for i := start; i < start+size; i++ {
+				zeroed.Index(i).Set(zeroOf(v.Type().Elem(), tag, true))
+			}
+			s.try(v, zeroed)
+		}
+	}
+	if isBasic(v.Type().Elem().Kind()) {
+		return
+	}
+	for i := 0; i < v.Len(); i++ {
+		s.shrinkValue(v.Index(i), tag, true)
+	}
+}
+
+// shrinkBitlist shortens a bitlist by halves and then unsets its bits one by one
+func (s *shrinker) shrinkBitlist(v reflect.Value) {
+	buf := v.Bytes()
+	if len(buf) == 0 || buf[len(buf)-1] == 0 {
+		// without the length bit the bitlist is not shortened
+		return
+	}
+	num := 8*(len(buf)-1) + bitLen(buf[len(buf)-1]) - 1
+	for n := num / 2; n < num && s.calls < MaxShrinkCalls; {
+		if !s.try(v, bitlistOf(v.Type(), buf, n)) {
+			n = (n + num + 1) / 2
+			continue
+		}
+		buf, num = v.Bytes(), n
+		n = num / 2
+	}
+	for i := 0; i < num; i++ {
+		if buf[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+		unset := append([]byte{}, buf...)
+		unset[i/8] &^= 1 << (i % 8)
+		if s.try(v, reflect.ValueOf(unset).Convert(v.Type())) {
+			buf = v.Bytes()
+		}
+	}
+}
+
+// bitlistOf returns the first num bits of the bitlist with the length bit
+func bitlistOf(typ reflect.Type, buf []byte, num int) reflect.Value {
+	out := make([]byte, num/8+1)
+	copy(out, buf)
+	out[len(out)-1] &= byte(1)<<(num%8) - 1
+	out[len(out)-1] |= byte(1) << (num % 8)
+	return reflect.ValueOf(out).Convert(typ)
+}
+
+func bitLen(b byte) int {
+	n := 0
+	for ; b != 0; b >>= 1 {
+		n++
+	}
+	return n
+}
+
+func isBasic(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Bool:
+		return true
+	}
+	return false
+}
+
+// sliceDim returns the tag of the elements of a slice and whether the slice has
+// a fixed size. It returns false if the slice does not have ssz tags.
+func sliceDim(tag reflect.StructTag) (reflect.StructTag, bool, bool) {
+	size, max := tag.Get("ssz-size"), tag.Get("ssz-max")
+	if size == "" && max == "" {
+		return "", false, false
+	}
+	var subTag []string
+	fixed := false
+	if size != "" {
+		first := strings.Split(size, ",")[0]
+		fixed = first != "?"
+		if indx := strings.Index(size, ","); indx != -1 {
+			subTag = append(subTag, "ssz-size:\""+size[indx+1:]+"\"")
+		}
+	}
+	if indx := strings.Index(max, ","); indx != -1 {
+		subTag = append(subTag, "ssz-max:\""+max[indx+1:]+"\"")
+	}
+	return reflect.StructTag(strings.Join(subTag, " ")), fixed, true
+}
+
+// zeroOf returns the smallest value of the type that respects the ssz tags: the
+// slices with a fixed size have zero elements, the lists are empty, the bitlists
+// only have the length bit and the pointers of the fields are nil.
+func zeroOf(typ reflect.Type, tag reflect.StructTag, elem bool) reflect.Value {
+	v := reflect.New(typ).Elem()
+
+	switch typ.Kind() {
+	case reflect.Ptr:
+		if elem && typ.Elem().Kind() == reflect.Struct {
+			ptr := reflect.New(typ.Elem())
+			ptr.Elem().Set(zeroOf(typ.Elem(), "", false))
+			v.Set(ptr)
+		}
+
+	case reflect.Struct:
+		if typ == reflect.TypeOf(time.Time{}) {
+			break
+		}
+		for i := 0; i < typ.NumField(); i++ {
+			if field := typ.Field(i); field.IsExported() {
+				v.Field(i).Set(zeroOf(field.Type, field.Tag, false))
+			}
+		}
+
+	case reflect.Array:
+		if isBasic(typ.Elem().Kind()) {
+			break
+		}
+		for i := 0; i < v.Len(); i++ {
+			v.Index(i).Set(zeroOf(typ.Elem(), tag, true))
+		}
+
+	case reflect.Slice:
+		switch tag.Get("ssz") {
+		case "bitlist":
+			v.Set(reflect.ValueOf([]byte{1}).Convert(typ))
+			return v
+		case "bitvector":
+			if size, err := strconv.Atoi(tag.Get("ssz-size")); err == nil {
+				v.Set(reflect.MakeSlice(typ, (size+7)/8, (size+7)/8))
+			}
+			return v
+		}
+		subTag, fixed, _ := sliceDim(tag)
+		if !fixed {
+			break
+		}
+		size, err := strconv.Atoi(strings.Split(tag.Get("ssz-size"), ",")[0])
+		if err != nil {
+			break
+		}
+		v.Set(reflect.MakeSlice(typ, size, size))
+		if !isBasic(typ.Elem().Kind()) {
+			for i := 0; i < size; i++ {
+				v.Index(i).Set(zeroOf(typ.Elem(), subTag, true))
+			}
+		}
+	}
+	return v
+}
+
+// GoLiteral returns the Go source of a composite literal of obj, like '&pkg.Obj{A: 1}'
+// for obj of type *pkg.Obj, to paste the objects minimized by Shrink in regression
+// tests. The zero fields are omitted and the types are qualified by the name of their
+// package.
+func GoLiteral(obj interface{}) (string, error) {
+	var b strings.Builder
+	if err := writeLiteral(&b, reflect.ValueOf(obj)); err != nil {
+		return "", err
+	}
+	// the literal is formatted as the value of a variable
+	src, err := format.Source([]byte("package p\n\nvar v = " + b.String()))
+	if err != nil {
+		return "", fmt.Errorf("failed to format the literal: %v", err)
+	}
+	out := string(src)
+	return strings.TrimSuffix(out[strings.Index(out, "=")+2:], "\n"), nil
+}
+
+func writeLiteral(b *strings.Builder, v reflect.Value) error {
+	typ := v.Type()
+
+	switch v.Kind() {
+	case reflect.Bool:
+		b.WriteString(strconv.FormatBool(v.Bool()))
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		b.WriteString(strconv.FormatUint(v.Uint(), 10))
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		b.WriteString(strconv.FormatInt(v.Int(), 10))
+
+	case reflect.String:
+		b.WriteString(strconv.Quote(v.String()))
+
+	case reflect.Ptr:
+		if v.IsNil() {
+			b.WriteString("nil")
+			return nil
+		}
+		switch typ.Elem().Kind() {
+		case reflect.Struct, reflect.Array, reflect.Slice:
+		default:
+			return fmt.Errorf("pointer of type %s is not supported", typ)
+		}
+		b.WriteString("&")
+		return writeLiteral(b, v.Elem())
+
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			b.WriteString("nil")
+			return nil
+		}
+		if v.Kind() == reflect.Slice && v.Len() != 0 && isZeroSlice(v) {
+			fmt.Fprintf(b, "make(%s, %d)", typeName(typ), v.Len())
+			return nil
+		}
+		b.WriteString(typeName(typ))
+		if isBasic(typ.Elem().Kind()) {
+			// the basic elements are in a single line, the bytes in hex
+			b.WriteString("{")
+			for i := 0; i < v.Len(); i++ {
+				if i != 0 {
+					b.WriteString(", ")
+				}
+				if typ.Elem().Kind() == reflect.Uint8 {
+					fmt.Fprintf(b, "0x%02x", v.Index(i).Uint())
+				} else if err := writeLiteral(b, v.Index(i)); err != nil {
+					return err
+				}
+			}
+			b.WriteString("}")
+			return nil
+		}
+		b.WriteString("{\n")
+		for i := 0; i < v.Len(); i++ {
+			if err := writeLiteral(b, v.Index(i)); err != nil {
+				return err
+			}
+			b.WriteString(",\n")
+		}
+		b.WriteString("}")
+
+	case reflect.Struct:
+		if typ == reflect.TypeOf(time.Time{}) {
+			t := v.Interface().(time.Time)
+			fmt.Fprintf(b, "time.Unix(%d, %d)", t.Unix(), t.Nanosecond())
+			return nil
+		}
+		b.WriteString(typeName(typ) + "{\n")
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if !field.IsExported() || v.Field(i).IsZero() {
+				continue
+			}
+			b.WriteString(field.Name + ": ")
+			if err := writeLiteral(b, v.Field(i)); err != nil {
+				return fmt.Errorf("%s: %v", field.Name, err)
+			}
+			b.WriteString(",\n")
+		}
+		b.WriteString("}")
+
+	default:
+		return fmt.Errorf("type %s is not supported", typ)
+	}
+	return nil
+}
+
+// typeName returns the name of the type qualified by its package, the byte
+// slices and arrays are written with byte instead of uint8
+func typeName(typ reflect.Type) string {
+	isSeq := typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array
+	if isSeq && typ.Name() == "" && typ.Elem() == reflect.TypeOf(byte(0)) {
+		if typ.Kind() == reflect.Array {
+			return fmt.Sprintf("[%d]byte", typ.Len())
+		}
+		return "[]byte"
+	}
+	return typ.String()
+}
+
+// isZeroSlice returns true if the elements of the slice are basic zero values
+func isZeroSlice(v reflect.Value) bool {
+	if !isBasic(v.Type().Elem().Kind()) {
+		return false
+	}
+	for i := 0; i < v.Len(); i++ {
+		if !v.Index(i).IsZero() {
+			return false
+		}
+	}
+	return true
+}
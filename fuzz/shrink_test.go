@@ -0,0 +1,108 @@
+package fuzz_test
+
+import (
+	"testing"
+
+	"github.com/NilFoundation/fastssz/fuzz"
+	"github.com/NilFoundation/fastssz/spectests/generic"
+)
+
+func TestShrink(t *testing.T) {
+	f := fuzz.NewWithSeed(1)
+
+	shrunk := 0
+	for i := 0; i < 10; i++ {
+		obj := new(generic.ComplexTestStruct)
+		f.Fuzz(obj)
+		if obj.E == nil || len(obj.E.B) < 2 {
+			continue
+		}
+		failing := func(obj interface{}) bool {
+			e := obj.(*generic.ComplexTestStruct).E
+			return e != nil && len(e.B) >= 2
+		}
+		if err := fuzz.Shrink(obj, failing); err != nil {
+			t.Fatal(err)
+		}
+		if obj.A != 0 || obj.C != 0 || len(obj.B) != 0 || len(obj.D) != 0 {
+			t.Fatal("fields not zeroed")
+		}
+		if obj.E.A != 0 || obj.E.C != 0 || len(obj.E.B) != 2 || obj.E.B[0] != 0 || obj.E.B[1] != 0 {
+			t.Fatalf("bad shrink of E: %+v", obj.E)
+		}
+		// the elements of the vectors are not dropped
+		for _, elem := range obj.F {
+			if elem == nil || *elem != (generic.FixedTestStruct{}) {
+				t.Fatalf("bad shrink of F: %v", elem)
+			}
+		}
+		for _, elem := range obj.G {
+			if elem == nil || elem.A != 0 || len(elem.B) != 0 {
+				t.Fatalf("bad shrink of G: %v", elem)
+			}
+		}
+		shrunk++
+	}
+	if shrunk == 0 {
+		t.Fatal("no objects shrunk")
+	}
+
+	if err := fuzz.Shrink(new(generic.ComplexTestStruct), func(interface{}) bool { return false }); err == nil {
+		t.Fatal("expected an error if the object does not fail")
+	}
+}
+
+func TestShrink_Bitlist(t *testing.T) {
+	obj := &generic.BitsStruct{
+		A: []byte{0x2f},
+		B: []byte{0x03},
+		C: []byte{0x01},
+		D: []byte{0x7f},
+		E: []byte{0xff},
+	}
+	failing := func(obj interface{}) bool {
+		return obj.(*generic.BitsStruct).A[0]&0x04 != 0
+	}
+	if err := fuzz.Shrink(obj, failing); err != nil {
+		t.Fatal(err)
+	}
+	// the bitlist is shortened to 2 bits with the length bit as the third
+	// bit and the bitvectors keep their size
+	if obj.A[0] != 0x04 || obj.D[0] != 0x01 {
+		t.Fatalf("bad bitlists %x %x", obj.A, obj.D)
+	}
+	if len(obj.B) != 1 || obj.B[0] != 0 || len(obj.E) != 1 || obj.E[0] != 0 {
+		t.Fatalf("bad bitvectors %x %x", obj.B, obj.E)
+	}
+}
+
+func TestGoLiteral(t *testing.T) {
+	obj := &generic.ComplexTestStruct{
+		A: 1,
+		B: []uint16{2, 3},
+		D: []byte{0xab},
+		E: &generic.VarTestStruct{C: 4},
+		G: [2]*generic.VarTestStruct{{A: 5}},
+	}
+	src, err := fuzz.GoLiteral(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `&generic.ComplexTestStruct{
+	A: 1,
+	B: []uint16{2, 3},
+	D: []byte{0xab},
+	E: &generic.VarTestStruct{
+		C: 4,
+	},
+	G: [2]*generic.VarTestStruct{
+		&generic.VarTestStruct{
+			A: 5,
+		},
+		nil,
+	},
+}`
+	if src != expected {
+		t.Fatalf("bad literal:\n%s", src)
+	}
+}
@@ -0,0 +1,131 @@
+package ssz
+
+import "reflect"
+
+// VariableSize is the size of the variable size fields of a container
+// in FixedPartSize and SplitContainer.
+const VariableSize = -1
+
+// The functions below are used by the code generated by sszgen for generic containers,
+// whose fields of a type parameter may have a fixed or a variable size depending on the
+// type argument. The layout of the type arguments is resolved from their schema.
+
+// IsFixed returns true if v has a fixed ssz size. The size is fixed if the schema of v
+// (see SchemaOf) is fixed, values without a schema are considered of variable size unless
+// they implement FixedSizer.
+func IsFixed(v interface{}) bool {
+	return SchemaOf(v).IsFixed()
+}
+
+// FieldSize returns the size of v if it has a fixed size or VariableSize otherwise.
+// A nil pointer has the size of the zero value of its type, like in ParamSize.
+func FieldSize(v Marshaler) int {
+	v = OrZero(v)
+	if !IsFixed(v) {
+		return VariableSize
+	}
+	return v.SizeSSZ()
+}
+
+// ParamSize returns the size of v as a field of a container, which includes the offset
+// if v has a variable size. A nil pointer has the size of the zero value of its type,
+// v is not allocated.
+func ParamSize(v Marshaler) int {
+	v = OrZero(v)
+	size := v.SizeSSZ()
+	if !IsFixed(v) {
+		size += bytesPerLengthOffset
+	}
+	return size
+}
+
+// FixedPartSize returns the size of the fixed part of a container with fields of the given
+// sizes. Each variable size field takes the size of an offset.
+func FixedPartSize(sizes ...int) int {
+	fixed := 0
+	for _, size := range sizes {
+		if size == VariableSize {
+			fixed += bytesPerLengthOffset
+		} else {
+			fixed += size
+		}
+	}
+	return fixed
+}
+
+// SplitContainer splits the ssz encoding of a container into the encoding of each
+// of its fields given their sizes, with VariableSize for the variable size fields.
+func SplitContainer(buf []byte, sizes ...int) ([][]byte, error) {
+	size := uint64(len(buf))
+	fixed := uint64(FixedPartSize(sizes...))
+
+	variable := []int{}
+	for indx, s := range sizes {
+		if s == VariableSize {
+			variable = append(variable, indx)
+		}
+	}
+	if len(variable) == 0 && size != fixed {
+		return nil, ErrSize
+	}
+	if size < fixed {
+		return nil, ErrSize
+	}
+
+	res := make([][]byte, len(sizes))
+	offsets := make([]uint64, 0, len(variable)+1)
+
+	pos := uint64(0)
+	for indx, s := range sizes {
+		if s != VariableSize {
+			res[indx] = buf[pos : pos+uint64(s)]
+			pos += uint64(s)
+			continue
+		}
+		offset := ReadOffset(buf[pos : pos+bytesPerLengthOffset])
+		if len(offsets) == 0 && offset != fixed {
+			return nil, ErrInvalidVariableOffset
+		}
+		if offset > size || (len(offsets) != 0 && offsets[len(offsets)-1] > offset) {
+			return nil, ErrOffset
+		}
+		offsets = append(offsets, offset)
+		pos += bytesPerLengthOffset
+	}
+	offsets = append(offsets, size)
+
+	for i, indx := range variable {
+		res[indx] = buf[offsets[i]:offsets[i+1]]
+	}
+	return res, nil
+}
+
+// OrZero returns v or, if v is a nil pointer, a pointer to a new zero value of its
+// type. A field of a type parameter instantiated with a pointer type is encoded and
+// hashed as the zero value if it is nil, without allocating the field.
+func OrZero[T any](v T) T {
+	if val := reflect.ValueOf(v); val.Kind() == reflect.Ptr && val.IsNil() {
+		return reflect.New(val.Type().Elem()).Interface().(T)
+	}
+	return v
+}
+
+// Alloc allocates the value pointed by v if it is a nil pointer, so that a type
+// parameter instantiated with a pointer type can be decoded.
+func Alloc[T any](v *T) {
+	val := reflect.ValueOf(v).Elem()
+	if val.Kind() == reflect.Ptr && val.IsNil() {
+		val.Set(reflect.New(val.Type().Elem()))
+	}
+}
+
+// SchemaOfType returns the schema of the values of type T
+func SchemaOfType[T any]() *Schema {
+	var v T
+	Alloc(&v)
+	switch interface{}(v).(type) {
+	case SchemaProvider, FixedSizer:
+		return SchemaOf(v)
+	}
+	return SchemaOf(&v)
+}
@@ -0,0 +1,107 @@
+package ssz
+
+import (
+	"testing"
+)
+
+func TestSplitContainer(t *testing.T) {
+	sizes := []int{2, VariableSize, 1, VariableSize}
+	if size := FixedPartSize(sizes...); size != 11 {
+		t.Fatalf("bad fixed size %d", size)
+	}
+
+	buf := []byte{1, 2, 11, 0, 0, 0, 3, 13, 0, 0, 0, 4, 5, 6}
+	bufs, err := SplitContainer(buf, sizes...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]byte{{1, 2}, {4, 5}, {3}, {6}}
+	for i := range expected {
+		if string(bufs[i]) != string(expected[i]) {
+			t.Fatalf("bad field %d: %v", i, bufs[i])
+		}
+	}
+
+	cases := []struct {
+		buf []byte
+		err error
+	}{
+		// the first offset does not point to the end of the fixed part
+		{[]byte{1, 2, 12, 0, 0, 0, 3, 13, 0, 0, 0, 4, 5, 6}, ErrInvalidVariableOffset},
+		// offsets out of order
+		{[]byte{1, 2, 11, 0, 0, 0, 3, 10, 0, 0, 0, 4, 5, 6}, ErrOffset},
+		// offset out of bounds
+		{[]byte{1, 2, 11, 0, 0, 0, 3, 15, 0, 0, 0, 4, 5, 6}, ErrOffset},
+		// short buffer
+		{[]byte{1, 2, 11, 0}, ErrSize},
+	}
+	for _, c := range cases {
+		if _, err := SplitContainer(c.buf, sizes...); err != c.err {
+			t.Fatalf("expected %v but found %v", c.err, err)
+		}
+	}
+
+	// containers with fixed fields only must have the exact size
+	if _, err := SplitContainer([]byte{1, 2, 3}, 2); err != ErrSize {
+		t.Fatalf("expected size error but found %v", err)
+	}
+}
+
+func TestSchemaIsFixed(t *testing.T) {
+	cases := []struct {
+		schema *Schema
+		fixed  bool
+	}{
+		{UintSchema(8), true},
+		{BytesSchema(32), true},
+		{ByteListSchema(32), false},
+		{BitlistSchema(8), false},
+		{VectorSchema(ByteListSchema(32), 2), false},
+		{&Schema{Kind: KindContainer, Fields: []*Field{{Schema: UintSchema(8)}, {Schema: BoolSchema()}}}, true},
+		{&Schema{Kind: KindContainer, Fields: []*Field{{Schema: UintSchema(8)}, {Schema: ByteListSchema(1)}}}, false},
+		{&Schema{Kind: KindUnknown}, false},
+		{&Schema{Kind: KindUnknown, Size: 32}, true},
+	}
+	for _, c := range cases {
+		if c.schema.IsFixed() != c.fixed {
+			t.Fatalf("expected fixed %v for %s", c.fixed, c.schema.Kind)
+		}
+	}
+}
+
+type fixedSizer struct{}
+
+func (f *fixedSizer) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, 0, 0), nil
+}
+
+func (f *fixedSizer) MarshalSSZ() ([]byte, error) {
+	return f.MarshalSSZTo(nil)
+}
+
+func (f *fixedSizer) SizeSSZ() int {
+	return 2
+}
+
+func (f *fixedSizer) FixedSizeSSZ() int {
+	return 2
+}
+
+func TestParamSize(t *testing.T) {
+	// the values without a schema have a fixed size only if they implement FixedSizer
+	if !IsFixed(&fixedSizer{}) || FieldSize(&fixedSizer{}) != 2 {
+		t.Fatal("expected a fixed size")
+	}
+	if IsFixed(struct{}{}) {
+		t.Fatal("expected a variable size")
+	}
+	if schema := SchemaOfSize(struct{}{}, 8); !schema.IsFixed() || schema.Size != 8 {
+		t.Fatal("expected the size of the tag")
+	}
+
+	// the size of a nil pointer is the size of its zero value
+	var f *fixedSizer
+	if size := ParamSize(f); size != 2 || f != nil {
+		t.Fatalf("bad size %d", size)
+	}
+}
@@ -0,0 +1,204 @@
+package ssz
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+// GIndex is a generalized index of a node in a merkle tree as described in
+// the consensus specs (https://github.com/ethereum/consensus-specs/blob/dev/ssz/merkle-proofs.md).
+// The root has index 1 and the children of the node i are 2*i and 2*i+1.
+// It is backed by a big integer so that it can address nodes of deep trees
+// (i.e. nested lists) that overflow an int. GIndex values are immutable.
+type GIndex struct {
+	v *big.Int
+}
+
+var bigOne = big.NewInt(1)
+
+// RootGIndex is the generalized index of the root of a tree
+var RootGIndex = GIndex{v: bigOne}
+
+// NewGIndex returns the generalized index for the given int value
+func NewGIndex(i uint64) GIndex {
+	return GIndex{v: new(big.Int).SetUint64(i)}
+}
+
+// NewGIndexFromBig returns the generalized index for the given big integer value
+func NewGIndexFromBig(i *big.Int) GIndex {
+	return GIndex{v: new(big.Int).Set(i)}
+}
+
+// ParseGIndex parses a generalized index in base 10 or in base 16 with the 0x prefix
+func ParseGIndex(s string) (GIndex, error) {
+	v, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		return GIndex{}, fmt.Errorf("incorrect generalized index '%s'", s)
+	}
+	g := GIndex{v: v}
+	if !g.IsValid() {
+		return GIndex{}, fmt.Errorf("incorrect generalized index '%s': must be greater than zero", s)
+	}
+	return g, nil
+}
+
+func (g GIndex) big() *big.Int {
+	if g.v == nil {
+		return new(big.Int)
+	}
+	return g.v
+}
+
+// IsValid returns true if the generalized index is greater than zero
+func (g GIndex) IsValid() bool {
+	return g.big().Sign() > 0
+}
+
+// IsRoot returns true if the generalized index is the root of the tree
+func (g GIndex) IsRoot() bool {
+	return g.big().Cmp(bigOne) == 0
+}
+
+// Big returns a copy of the generalized index as a big integer
+func (g GIndex) Big() *big.Int {
+	return new(big.Int).Set(g.big())
+}
+
+// Int returns the generalized index as an int and whether it fits in one
+func (g GIndex) Int() (int, bool) {
+	v := g.big()
+	if !v.IsInt64() || v.Int64() != int64(int(v.Int64())) {
+		return 0, false
+	}
+	return int(v.Int64()), true
+}
+
+// Uint64 returns the generalized index as an uint64 and whether it fits in one
+func (g GIndex) Uint64() (uint64, bool) {
+	v := g.big()
+	if !v.IsUint64() {
+		return 0, false
+	}
+	return v.Uint64(), true
+}
+
+// Cmp compares two generalized indices and returns -1, 0 or +1
+func (g GIndex) Cmp(o GIndex) int {
+	return g.big().Cmp(o.big())
+}
+
+// Equal returns true if both generalized indices are the same
+func (g GIndex) Equal(o GIndex) bool {
+	return g.Cmp(o) == 0
+}
+
+func (g GIndex) String() string {
+	return g.big().String()
+}
+
+// Length returns the length of the path from the root to the node
+// (get_generalized_index_length)
+func (g GIndex) Length() int {
+	return g.big().BitLen() - 1
+}
+
+// Bit returns the position of the node at the given level of the path (i.e. false for
+// left, true for right). Level 0 is the node itself, level 1 is its parent, etc.
+// (get_generalized_index_bit)
+func (g GIndex) Bit(level int) bool {
+	return g.big().Bit(level) == 1
+}
+
+// Sibling returns the generalized index of the sibling of the node
+// (generalized_index_sibling)
+func (g GIndex) Sibling() GIndex {
+	v := new(big.Int).Set(g.big())
+	return GIndex{v: v.SetBit(v, 0, v.Bit(0)^1)}
+}
+
+// Child returns the generalized index of the left or the right child of the node
+// (generalized_index_child)
+func (g GIndex) Child(right bool) GIndex {
+	v := new(big.Int).Lsh(g.big(), 1)
+	if right {
+		v.SetBit(v, 0, 1)
+	}
+	return GIndex{v: v}
+}
+
+// Parent returns the generalized index of the parent of the node
+// (generalized_index_parent)
+func (g GIndex) Parent() GIndex {
+	return GIndex{v: new(big.Int).Rsh(g.big(), 1)}
+}
+
+// Concat returns the generalized index of the node at the index o relative to the
+// subtree rooted at g.
+func (g GIndex) Concat(o GIndex) GIndex {
+	return ConcatGeneralizedIndices(g, o)
+}
+
+// ConcatGeneralizedIndices returns the generalized index of the node obtained by
+// following the path of each of the indices, where every index is relative to the
+// node resolved by the previous ones (concat_generalized_indices).
+func ConcatGeneralizedIndices(indices ...GIndex) GIndex {
+	o := big.NewInt(1)
+	for _, i := range indices {
+		length := uint(i.Length())
+
+		// remove the leading bit of i and append its path to o
+		path := new(big.Int).Set(i.big())
+		path.SetBit(path, int(length), 0)
+		o.Lsh(o, length).Or(o, path)
+	}
+	return GIndex{v: o}
+}
+
+// BranchIndices returns the generalized indices of the sibling nodes required
+// to prove the node, from the bottom to the top of the tree (get_branch_indices).
+func (g GIndex) BranchIndices() []GIndex {
+	res := make([]GIndex, 0, g.Length())
+	for cur := g; cur.Length() > 0; cur = cur.Parent() {
+		res = append(res, cur.Sibling())
+	}
+	return res
+}
+
+// PathIndices returns the generalized indices of the nodes in the path from the node
+// to the root (excluded), from the bottom to the top of the tree (get_path_indices).
+func (g GIndex) PathIndices() []GIndex {
+	res := make([]GIndex, 0, g.Length())
+	for cur := g; cur.Length() > 0; cur = cur.Parent() {
+		res = append(res, cur)
+	}
+	return res
+}
+
+// GetHelperIndices returns the generalized indices of all the nodes required to
+// prove the given indices, excluding the nodes that can be computed from them.
+// The returned indices are in a decreasing order (get_helper_indices).
+func GetHelperIndices(indices []GIndex) []GIndex {
+	helpers := map[string]GIndex{}
+	paths := map[string]struct{}{}
+
+	for _, indx := range indices {
+		for _, b := range indx.BranchIndices() {
+			helpers[b.String()] = b
+		}
+		for _, p := range indx.PathIndices() {
+			paths[p.String()] = struct{}{}
+		}
+	}
+
+	res := make([]GIndex, 0, len(helpers))
+	for k, h := range helpers {
+		if _, ok := paths[k]; !ok {
+			res = append(res, h)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Cmp(res[j]) > 0
+	})
+	return res
+}
@@ -0,0 +1,99 @@
+package ssz
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func gindices(t *testing.T, indices ...uint64) []GIndex {
+	t.Helper()
+
+	res := make([]GIndex, len(indices))
+	for i, indx := range indices {
+		res[i] = NewGIndex(indx)
+	}
+	return res
+}
+
+func TestGIndexOperations(t *testing.T) {
+	g := NewGIndex(13) // 0b1101
+
+	require.Equal(t, 3, g.Length())
+	require.True(t, g.Bit(0))
+	require.False(t, g.Bit(1))
+	require.True(t, g.Bit(2))
+	require.Equal(t, "12", g.Sibling().String())
+	require.Equal(t, "6", g.Parent().String())
+	require.Equal(t, "26", g.Child(false).String())
+	require.Equal(t, "27", g.Child(true).String())
+	require.True(t, RootGIndex.IsRoot())
+	require.Equal(t, 0, RootGIndex.Length())
+
+	require.Equal(t, gindices(t, 12, 7, 2), g.BranchIndices())
+	require.Equal(t, gindices(t, 13, 6, 3), g.PathIndices())
+	require.Empty(t, RootGIndex.BranchIndices())
+}
+
+func TestGIndexConcat(t *testing.T) {
+	// 0b101 (left, right) followed by 0b11 (right)
+	require.Equal(t, "11", ConcatGeneralizedIndices(gindices(t, 5, 3)...).String())
+	require.Equal(t, "11", NewGIndex(5).Concat(NewGIndex(3)).String())
+	require.Equal(t, "5", ConcatGeneralizedIndices(gindices(t, 1, 5, 1)...).String())
+	require.Equal(t, "1", ConcatGeneralizedIndices().String())
+
+	// concatenation of deep indices does not overflow
+	deep := NewGIndex(1 << 62)
+	g := ConcatGeneralizedIndices(deep, deep, NewGIndex(3))
+	require.Equal(t, 125, g.Length())
+	require.True(t, g.Bit(0))
+	require.False(t, g.Bit(1))
+
+	_, ok := g.Int()
+	require.False(t, ok)
+	_, ok = g.Uint64()
+	require.False(t, ok)
+
+	parsed, err := ParseGIndex(g.String())
+	require.NoError(t, err)
+	require.True(t, parsed.Equal(g))
+}
+
+func TestGIndexParse(t *testing.T) {
+	g, err := ParseGIndex("0x10")
+	require.NoError(t, err)
+
+	i, ok := g.Int()
+	require.True(t, ok)
+	require.Equal(t, 16, i)
+
+	_, err = ParseGIndex("0")
+	require.Error(t, err)
+
+	_, err = ParseGIndex("abc")
+	require.Error(t, err)
+}
+
+func TestGIndexHelperIndices(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 100; i++ {
+		num := 1 + r.Intn(5)
+		indices := make([]int, num)
+		for j := range indices {
+			indices[j] = 2 + r.Intn(1<<10)
+		}
+		// GetHelperIndices and getRequiredIndices must agree
+		expected := []GIndex{}
+		for _, indx := range getRequiredIndices(indices) {
+			expected = append(expected, NewGIndex(uint64(indx)))
+		}
+
+		gindices := []GIndex{}
+		for _, indx := range indices {
+			gindices = append(gindices, NewGIndex(uint64(indx)))
+		}
+		require.Equal(t, expected, GetHelperIndices(gindices))
+	}
+}
@@ -0,0 +1,475 @@
+package ssz
+
+import (
+	"bytes"
+	"fmt"
+	"math/bits"
+
+	"github.com/minio/sha256-simd"
+)
+
+var (
+	// ErrIncrementalTreeFull means that all the leaves of the tree are set
+	ErrIncrementalTreeFull = fmt.Errorf("incremental tree is full")
+
+	// ErrFinalizedLeaf means that the leaf was pruned when it was finalized
+	ErrFinalizedLeaf = fmt.Errorf("leaf is finalized")
+)
+
+// MaxIncrementalTreeDepth is the maximum depth of an incremental tree so that the
+// generalized indices of its proofs, which include the length mixin, fit in an int
+const MaxIncrementalTreeDepth = 61
+
+// IncrementalTree is a merkle tree of a fixed depth that is built by appending leaves,
+// like the tree of the deposit contract. The root is the root of a list of the
+// appended leaves with a limit of 2^depth chunks (MerkleizeWithMixin).
+//
+// The tree keeps the roots of the complete subtrees of the leaves, so appending a
+// leaf and computing the root take O(depth) hashes. The leaves before a finalized
+// count are pruned and only the roots of the subtrees that cover them are kept,
+// which is the finalized tree snapshot of EIP-4881.
+type IncrementalTree struct {
+	depth int
+	count uint64
+
+	// finalized is the number of finalized leaves and finalizedNodes the roots
+	// of the subtrees that cover them, from the leftmost (largest) to the smallest
+	finalized      uint64
+	finalizedNodes []incrementalNode
+
+	// layers[l] are the roots of the complete subtrees of height l that are not
+	// finalized, starting at the subtree offsets[l]
+	layers  [][][32]byte
+	offsets []uint64
+}
+
+type incrementalNode struct {
+	level int
+	index uint64
+	hash  [32]byte
+}
+
+// NewIncrementalTree creates an empty incremental tree of the given depth
+func NewIncrementalTree(depth int) (*IncrementalTree, error) {
+	if depth < 1 || depth > MaxIncrementalTreeDepth {
+		return nil, fmt.Errorf("incorrect incremental tree depth %d", depth)
+	}
+	return &IncrementalTree{
+		depth:   depth,
+		layers:  make([][][32]byte, depth+1),
+		offsets: make([]uint64, depth+1),
+	}, nil
+}
+
+// Depth returns the depth of the tree without the length mixin
+func (t *IncrementalTree) Depth() int {
+	return t.depth
+}
+
+// Len returns the number of appended leaves
+func (t *IncrementalTree) Len() uint64 {
+	return t.count
+}
+
+// Finalized returns the number of finalized leaves
+func (t *IncrementalTree) Finalized() uint64 {
+	return t.finalized
+}
+
+// Append adds a leaf of 32 bytes at the end of the tree
+func (t *IncrementalTree) Append(leaf []byte) error {
+	if size := len(leaf); size != 32 {
+		return ErrBytesLengthFn("IncrementalTree.Leaf", size, 32)
+	}
+	if t.count == 1<<t.depth {
+		return ErrIncrementalTreeFull
+	}
+
+	var node [32]byte
+	copy(node[:], leaf)
+
+	index := t.count
+	t.layers[0] = append(t.layers[0], node)
+	t.count++
+
+	// every right child completes the subtree of its parent
+	for level := 0; level < t.depth && index&1 == 1; level++ {
+		sibling, err := t.node(level, index-1)
+		if err != nil {
+			return err
+		}
+		node = hashPair(sibling, node)
+		index >>= 1
+		t.layers[level+1] = append(t.layers[level+1], node)
+	}
+	return nil
+}
+
+// Root returns the root of the tree mixed in with the number of leaves
+func (t *IncrementalTree) Root() [32]byte {
+	// the nodes on the path of the root are either complete subtrees of the
+	// appended leaves or of the finalized ones, so the root is always known.
+	root, _ := t.node(t.depth, 0)
+	return mixinLength(root, t.count)
+}
+
+// Prove returns the proof of the leaf at the given index against the root of the
+// tree. The last hash of the proof is the length mixin.
+func (t *IncrementalTree) Prove(index uint64) (*Proof, error) {
+	if index >= t.count {
+		return nil, fmt.Errorf("index %d out of bounds of tree of length %d", index, t.count)
+	}
+	if index < t.finalized {
+		return nil, ErrFinalizedLeaf
+	}
+
+	leaf := t.layers[0][index-t.offsets[0]]
+	proof := &Proof{
+		Index:  1<<(t.depth+1) | int(index),
+		Leaf:   append([]byte{}, leaf[:]...),
+		Hashes: make([][]byte, 0, t.depth+1),
+	}
+	for level := 0; level < t.depth; level++ {
+		sibling, err := t.node(level, (index>>level)^1)
+		if err != nil {
+			return nil, err
+		}
+		proof.Hashes = append(proof.Hashes, append([]byte{}, sibling[:]...))
+	}
+
+	length := make([]byte, 32)
+	MarshalUint64(length[:0], t.count)
+	proof.Hashes = append(proof.Hashes, length)
+	return proof, nil
+}
+
+// Finalize marks the first count leaves as finalized and prunes them from the tree.
+// The finalized leaves cannot be proved anymore.
+func (t *IncrementalTree) Finalize(count uint64) error {
+	if count > t.count {
+		return fmt.Errorf("finalized count %d greater than the tree length %d", count, t.count)
+	}
+	if count < t.finalized {
+		return fmt.Errorf("finalized count %d lower than the current one %d", count, t.finalized)
+	}
+
+	// the finalized leaves are covered by a subtree for each bit set in the count
+	nodes := []incrementalNode{}
+	start := uint64(0)
+	for level := t.depth; level >= 0; level-- {
+		if count&(1<<level) == 0 {
+			continue
+		}
+		index := start >> level
+		hash, err := t.node(level, index)
+		if err != nil {
+			return err
+		}
+		nodes = append(nodes, incrementalNode{level: level, index: index, hash: hash})
+		start += 1 << level
+	}
+	t.finalized = count
+	t.finalizedNodes = nodes
+
+	for level := range t.layers {
+		offset := count >> level
+		t.layers[level] = append([][32]byte{}, t.layers[level][offset-t.offsets[level]:]...)
+		t.offsets[level] = offset
+	}
+	return nil
+}
+
+// Snapshot returns the finalized tree snapshot of EIP-4881 with the finalized
+// leaves of the tree. The execution block of the snapshot is not known by the
+// tree and it is set by the caller.
+func (t *IncrementalTree) Snapshot() *IncrementalTreeSnapshot {
+	snapshot := &IncrementalTreeSnapshot{
+		Finalized:          make([][]byte, 0, len(t.finalizedNodes)),
+		DepositCount:       t.finalized,
+		ExecutionBlockHash: make([]byte, 32),
+	}
+	for _, n := range t.finalizedNodes {
+		snapshot.Finalized = append(snapshot.Finalized, append([]byte{}, n.hash[:]...))
+	}
+	root, _ := snapshot.CalculateRoot(t.depth)
+	snapshot.DepositRoot = root[:]
+	return snapshot
+}
+
+// NewIncrementalTreeFromSnapshot restores a tree of the given depth with the
+// finalized leaves of the snapshot. The root of the snapshot is checked against
+// the finalized hashes.
+func NewIncrementalTreeFromSnapshot(depth int, snapshot *IncrementalTreeSnapshot) (*IncrementalTree, error) {
+	t, err := NewIncrementalTree(depth)
+	if err != nil {
+		return nil, err
+	}
+	root, err := snapshot.CalculateRoot(depth)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(root[:], snapshot.DepositRoot) {
+		return nil, fmt.Errorf("snapshot root %x does not match the finalized hashes %x", snapshot.DepositRoot, root)
+	}
+
+	count := snapshot.DepositCount
+	start, i := uint64(0), 0
+	for level := depth; level >= 0; level-- {
+		if count&(1<<level) == 0 {
+			continue
+		}
+		n := incrementalNode{level: level, index: start >> level}
+		copy(n.hash[:], snapshot.Finalized[i])
+		t.finalizedNodes = append(t.finalizedNodes, n)
+		start += 1 << level
+		i++
+	}
+	t.count = count
+	t.finalized = count
+	for level := range t.offsets {
+		t.offsets[level] = count >> level
+	}
+	return t, nil
+}
+
+// node returns the root of the subtree of the given height and index
+func (t *IncrementalTree) node(level int, index uint64) ([32]byte, error) {
+	start := index << level
+	if start >= t.count {
+		return zeroHashes[level], nil
+	}
+	if start+1<<level <= t.count {
+		// complete subtree
+		if index >= t.offsets[level] {
+			return t.layers[level][index-t.offsets[level]], nil
+		}
+		for _, n := range t.finalizedNodes {
+			if n.level == level && n.index == index {
+				return n.hash, nil
+			}
+		}
+		return [32]byte{}, ErrFinalizedLeaf
+	}
+
+	left, err := t.node(level-1, 2*index)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	right, err := t.node(level-1, 2*index+1)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return hashPair(left, right), nil
+}
+
+// IncrementalTreeSnapshot is the finalized tree snapshot of EIP-4881:
+//
+//	class DepositTreeSnapshot(Container):
+//	    finalized: List[Hash32, DEPOSIT_CONTRACT_DEPTH]
+//	    deposit_root: Hash32
+//	    deposit_count: uint64
+//	    execution_block_hash: Hash32
+//	    execution_block_height: uint64
+//
+// Finalized are the roots of the subtrees that cover the finalized leaves, from the
+// leftmost to the rightmost, and DepositRoot is the root of the tree with only the
+// finalized leaves.
+type IncrementalTreeSnapshot struct {
+	Finalized            [][]byte
+	DepositRoot          []byte
+	DepositCount         uint64
+	ExecutionBlockHash   []byte
+	ExecutionBlockHeight uint64
+}
+
+// MaxSnapshotFinalized is the maximum number of finalized hashes of a snapshot,
+// the depth of the deposit contract tree
+const MaxSnapshotFinalized = 32
+
+// CalculateRoot returns the root of the tree of the given depth with the finalized
+// leaves of the snapshot (calculate_root)
+func (s *IncrementalTreeSnapshot) CalculateRoot(depth int) ([32]byte, error) {
+	if depth < 1 || depth > MaxIncrementalTreeDepth {
+		return [32]byte{}, fmt.Errorf("incorrect incremental tree depth %d", depth)
+	}
+	if s.DepositCount > 1<<depth {
+		return [32]byte{}, fmt.Errorf("deposit count %d greater than the tree capacity", s.DepositCount)
+	}
+	if num := bits.OnesCount64(s.DepositCount); num != len(s.Finalized) {
+		return [32]byte{}, fmt.Errorf("expected %d finalized hashes for %d deposits but found %d", num, s.DepositCount, len(s.Finalized))
+	}
+	for _, h := range s.Finalized {
+		if size := len(h); size != 32 {
+			return [32]byte{}, ErrBytesLengthFn("IncrementalTreeSnapshot.Finalized", size, 32)
+		}
+	}
+
+	if s.DepositCount == 1<<depth {
+		var root [32]byte
+		copy(root[:], s.Finalized[0])
+		return mixinLength(root, s.DepositCount), nil
+	}
+
+	size := s.DepositCount
+	index := len(s.Finalized)
+	root := zeroHashes[0]
+	for level := 0; level < depth; level++ {
+		if size&1 == 1 {
+			index--
+			var node [32]byte
+			copy(node[:], s.Finalized[index])
+			root = hashPair(node, root)
+		} else {
+			root = hashPair(root, zeroHashes[level])
+		}
+		size >>= 1
+	}
+	return mixinLength(root, s.DepositCount), nil
+}
+
+// MarshalSSZ ssz marshals the IncrementalTreeSnapshot object
+func (s *IncrementalTreeSnapshot) MarshalSSZ() ([]byte, error) {
+	return MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the IncrementalTreeSnapshot object to a target array
+func (s *IncrementalTreeSnapshot) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Offset (0) 'Finalized'
+	dst = WriteOffset(dst, 84)
+
+	// Field (1) 'DepositRoot'
+	if size := len(s.DepositRoot); size != 32 {
+		err = ErrBytesLengthFn("IncrementalTreeSnapshot.DepositRoot", size, 32)
+		return
+	}
+	dst = append(dst, s.DepositRoot...)
+
+	// Field (2) 'DepositCount'
+	dst = MarshalUint64(dst, s.DepositCount)
+
+	// Field (3) 'ExecutionBlockHash'
+	if size := len(s.ExecutionBlockHash); size != 32 {
+		err = ErrBytesLengthFn("IncrementalTreeSnapshot.ExecutionBlockHash", size, 32)
+		return
+	}
+	dst = append(dst, s.ExecutionBlockHash...)
+
+	// Field (4) 'ExecutionBlockHeight'
+	dst = MarshalUint64(dst, s.ExecutionBlockHeight)
+
+	// Field (0) 'Finalized'
+	if dst, err = marshalHashes(dst, "IncrementalTreeSnapshot.Finalized", s.Finalized, MaxSnapshotFinalized); err != nil {
+		return
+	}
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the IncrementalTreeSnapshot object
+func (s *IncrementalTreeSnapshot) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 84 {
+		return ErrSize
+	}
+
+	// Offset (0) 'Finalized'
+	if o0 := ReadOffset(buf[0:4]); o0 != 84 {
+		return ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'DepositRoot'
+	s.DepositRoot = append([]byte{}, buf[4:36]...)
+
+	// Field (2) 'DepositCount'
+	s.DepositCount = UnmarshallUint64(buf[36:44])
+
+	// Field (3) 'ExecutionBlockHash'
+	s.ExecutionBlockHash = append([]byte{}, buf[44:76]...)
+
+	// Field (4) 'ExecutionBlockHeight'
+	s.ExecutionBlockHeight = UnmarshallUint64(buf[76:84])
+
+	// Field (0) 'Finalized'
+	s.Finalized, err = unmarshalHashes(buf[84:], MaxSnapshotFinalized)
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the IncrementalTreeSnapshot object
+func (s *IncrementalTreeSnapshot) SizeSSZ() (size int) {
+	size = 84
+
+	// Field (0) 'Finalized'
+	size += len(s.Finalized) * 32
+
+	return
+}
+
+// HashTreeRoot ssz hashes the IncrementalTreeSnapshot object
+func (s *IncrementalTreeSnapshot) HashTreeRoot() ([32]byte, error) {
+	return HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the IncrementalTreeSnapshot object with a hasher
+func (s *IncrementalTreeSnapshot) HashTreeRootWith(hh HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Finalized'
+	{
+		if size := len(s.Finalized); size > MaxSnapshotFinalized {
+			err = ErrListTooBigFn("IncrementalTreeSnapshot.Finalized", size, MaxSnapshotFinalized)
+			return
+		}
+		subIndx := hh.Index()
+		for _, h := range s.Finalized {
+			if size := len(h); size != 32 {
+				err = ErrBytesLengthFn("IncrementalTreeSnapshot.Finalized", size, 32)
+				return
+			}
+			hh.Append(h)
+		}
+		hh.MerkleizeWithMixin(subIndx, uint64(len(s.Finalized)), MaxSnapshotFinalized)
+	}
+
+	// Field (1) 'DepositRoot'
+	if size := len(s.DepositRoot); size != 32 {
+		err = ErrBytesLengthFn("IncrementalTreeSnapshot.DepositRoot", size, 32)
+		return
+	}
+	hh.PutBytes(s.DepositRoot)
+
+	// Field (2) 'DepositCount'
+	hh.PutUint64(s.DepositCount)
+
+	// Field (3) 'ExecutionBlockHash'
+	if size := len(s.ExecutionBlockHash); size != 32 {
+		err = ErrBytesLengthFn("IncrementalTreeSnapshot.ExecutionBlockHash", size, 32)
+		return
+	}
+	hh.PutBytes(s.ExecutionBlockHash)
+
+	// Field (4) 'ExecutionBlockHeight'
+	hh.PutUint64(s.ExecutionBlockHeight)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the IncrementalTreeSnapshot object
+func (s *IncrementalTreeSnapshot) GetTree() (*Node, error) {
+	return ProofTree(s)
+}
+
+func hashPair(left, right [32]byte) [32]byte {
+	var tmp [64]byte
+	copy(tmp[:32], left[:])
+	copy(tmp[32:], right[:])
+	return sha256.Sum256(tmp[:])
+}
+
+func mixinLength(root [32]byte, length uint64) [32]byte {
+	var tmp [32]byte
+	MarshalUint64(tmp[:0], length)
+	return hashPair(root, tmp)
+}
@@ -0,0 +1,201 @@
+package ssz
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func incrementalLeaves(t *testing.T, num int) [][]byte {
+	leaves := make([][]byte, num)
+	for i := range leaves {
+		leaves[i] = make([]byte, 32)
+		_, err := rand.Read(leaves[i])
+		require.NoError(t, err)
+	}
+	return leaves
+}
+
+func incrementalRoot(leaves [][]byte, depth int) [32]byte {
+	hh := NewHasher()
+	indx := hh.Index()
+	for _, leaf := range leaves {
+		hh.Append(leaf)
+	}
+	hh.MerkleizeWithMixin(indx, uint64(len(leaves)), 1<<depth)
+	root, _ := hh.HashRoot()
+	return root
+}
+
+func TestIncrementalTree(t *testing.T) {
+	for _, depth := range []int{1, 2, 4, 32} {
+		tree, err := NewIncrementalTree(depth)
+		require.NoError(t, err)
+		require.Equal(t, incrementalRoot(nil, depth), tree.Root())
+
+		num := 1 << depth
+		if num > 37 {
+			num = 37
+		}
+		leaves := incrementalLeaves(t, num)
+		for i, leaf := range leaves {
+			require.NoError(t, tree.Append(leaf))
+			root := tree.Root()
+			require.Equal(t, incrementalRoot(leaves[:i+1], depth), root)
+
+			for j := 0; j <= i; j++ {
+				proof, err := tree.Prove(uint64(j))
+				require.NoError(t, err)
+				require.Equal(t, leaves[j], proof.Leaf)
+
+				ok, err := VerifyProof(root[:], proof)
+				require.NoError(t, err)
+				require.True(t, ok)
+
+				branch, err := proof.MerkleBranch()
+				require.NoError(t, err)
+				require.Equal(t, uint64(depth+1), branch.Depth)
+				require.True(t, branch.Verify(root[:]))
+			}
+		}
+
+		_, err = tree.Prove(uint64(num))
+		require.Error(t, err)
+		if num == 1<<depth {
+			require.ErrorIs(t, tree.Append(leaves[0]), ErrIncrementalTreeFull)
+		}
+	}
+
+	_, err := NewIncrementalTree(0)
+	require.Error(t, err)
+	_, err = NewIncrementalTree(MaxIncrementalTreeDepth + 1)
+	require.Error(t, err)
+
+	tree, err := NewIncrementalTree(4)
+	require.NoError(t, err)
+	require.Error(t, tree.Append(make([]byte, 31)))
+}
+
+func TestIncrementalTree_Finalize(t *testing.T) {
+	depth := 5
+	leaves := incrementalLeaves(t, 1<<depth)
+
+	for _, finalized := range []uint64{0, 1, 5, 6, 16, 23, 31, 32} {
+		tree, err := NewIncrementalTree(depth)
+		require.NoError(t, err)
+		for _, leaf := range leaves[:finalized] {
+			require.NoError(t, tree.Append(leaf))
+		}
+		require.NoError(t, tree.Finalize(finalized/2))
+		require.NoError(t, tree.Finalize(finalized))
+		require.Equal(t, finalized, tree.Finalized())
+		require.Equal(t, incrementalRoot(leaves[:finalized], depth), tree.Root())
+
+		// the finalized leaves are pruned
+		for level := range tree.layers {
+			require.Len(t, tree.layers[level], 0)
+		}
+		if finalized > 0 {
+			_, err = tree.Prove(finalized - 1)
+			require.ErrorIs(t, err, ErrFinalizedLeaf)
+		}
+		require.Error(t, tree.Finalize(finalized+1))
+		require.Error(t, tree.Finalize(finalized/2-1))
+
+		// the snapshot restores the same tree
+		snapshot := tree.Snapshot()
+		require.Equal(t, finalized, snapshot.DepositCount)
+		root := tree.Root()
+		require.Equal(t, root[:], snapshot.DepositRoot)
+
+		buf, err := snapshot.MarshalSSZ()
+		require.NoError(t, err)
+		require.Len(t, buf, snapshot.SizeSSZ())
+
+		decoded := new(IncrementalTreeSnapshot)
+		require.NoError(t, decoded.UnmarshalSSZ(buf))
+		require.Equal(t, snapshot, decoded)
+
+		restored, err := NewIncrementalTreeFromSnapshot(depth, decoded)
+		require.NoError(t, err)
+		require.Equal(t, tree.Root(), restored.Root())
+
+		// both trees keep appending and proving the leaves after the snapshot
+		for i := finalized; i < uint64(len(leaves)); i++ {
+			require.NoError(t, tree.Append(leaves[i]))
+			require.NoError(t, restored.Append(leaves[i]))
+
+			root := tree.Root()
+			require.Equal(t, incrementalRoot(leaves[:i+1], depth), root)
+			require.Equal(t, root, restored.Root())
+
+			for j := finalized; j <= i; j++ {
+				proof, err := restored.Prove(j)
+				require.NoError(t, err)
+				ok, err := VerifyProof(root[:], proof)
+				require.NoError(t, err)
+				require.True(t, ok)
+			}
+		}
+
+		// finalize the leaves after the snapshot
+		if finalized >= 1<<depth-3 {
+			continue
+		}
+		require.NoError(t, restored.Finalize(1<<depth-3))
+		require.Equal(t, incrementalRoot(leaves, depth), restored.Root())
+		proof, err := restored.Prove(1<<depth - 1)
+		require.NoError(t, err)
+		root = restored.Root()
+		ok, err := VerifyProof(root[:], proof)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+}
+
+func TestIncrementalTreeSnapshot(t *testing.T) {
+	tree, err := NewIncrementalTree(32)
+	require.NoError(t, err)
+	for _, leaf := range incrementalLeaves(t, 11) {
+		require.NoError(t, tree.Append(leaf))
+	}
+	require.NoError(t, tree.Finalize(11))
+
+	snapshot := tree.Snapshot()
+	require.Len(t, snapshot.Finalized, 3)
+	snapshot.ExecutionBlockHeight = 100
+
+	// the root of the snapshot is the root of its ssz container
+	root, err := snapshot.HashTreeRoot()
+	require.NoError(t, err)
+	node, err := snapshot.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, root[:], node.Hash())
+
+	// the deposit root must match the finalized hashes
+	forged := *snapshot
+	forged.DepositRoot = make([]byte, 32)
+	_, err = NewIncrementalTreeFromSnapshot(32, &forged)
+	require.Error(t, err)
+
+	forged = *snapshot
+	forged.Finalized = forged.Finalized[1:]
+	_, err = NewIncrementalTreeFromSnapshot(32, &forged)
+	require.Error(t, err)
+
+	forged = *snapshot
+	forged.DepositCount = 12
+	_, err = NewIncrementalTreeFromSnapshot(32, &forged)
+	require.Error(t, err)
+
+	// the snapshot does not fit a smaller tree
+	_, err = NewIncrementalTreeFromSnapshot(3, snapshot)
+	require.Error(t, err)
+
+	// incorrect encodings
+	buf, err := snapshot.MarshalSSZ()
+	require.NoError(t, err)
+	require.Error(t, new(IncrementalTreeSnapshot).UnmarshalSSZ(buf[:83]))
+	require.Error(t, new(IncrementalTreeSnapshot).UnmarshalSSZ(buf[:len(buf)-1]))
+}
@@ -0,0 +1,176 @@
+package ssz
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// ListElementProof is a proof of an element of a list together with the length of
+// the list. The length mixin is the sibling of the root of the data tree of the list,
+// so it is one of the hashes of the proof of the element.
+type ListElementProof struct {
+	// Proof is the proof of the element, or of the chunk that packs the element
+	// if the elements of the list are basic values
+	Proof *Proof
+
+	// Index is the position of the element in the list
+	Index uint64
+
+	// Limit is the maximum number of elements of the list
+	Limit uint64
+
+	// ElemSize is the size in bytes of the basic elements packed in the
+	// chunks of the list. It is zero for lists of composite elements.
+	ElemSize uint64
+}
+
+// ProveListElement returns the proof of the element i of the list of composite
+// values with the given limit at the generalized index listGIndex of the tree.
+func ProveListElement(tree *Node, listGIndex int, i, limit uint64) (*ListElementProof, error) {
+	return proveListElement(tree, listGIndex, i, limit, 0)
+}
+
+// ProvePackedListElement returns the proof of the element i of a list of basic values
+// of elemSize bytes. The leaf of the proof is the chunk that packs the element.
+func ProvePackedListElement(tree *Node, listGIndex int, i, limit, elemSize uint64) (*ListElementProof, error) {
+	if elemSize == 0 || 32%elemSize != 0 {
+		return nil, fmt.Errorf("incorrect size %d for a basic element", elemSize)
+	}
+	return proveListElement(tree, listGIndex, i, limit, elemSize)
+}
+
+func proveListElement(tree *Node, listGIndex int, i, limit, elemSize uint64) (*ListElementProof, error) {
+	p := &ListElementProof{Index: i, Limit: limit, ElemSize: elemSize}
+	gindex, _, err := p.gindex(listGIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	lengthNode, err := tree.Get(2*listGIndex + 1)
+	if err != nil {
+		return nil, err
+	}
+	length, err := decodeListLength(lengthNode.Hash())
+	if err != nil {
+		return nil, err
+	}
+	if i >= length {
+		return nil, fmt.Errorf("index %d out of bounds of list of length %d", i, length)
+	}
+
+	if p.Proof, err = tree.Prove(gindex); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// chunkLimit returns the number of leaves of the data tree of the list
+func (p *ListElementProof) chunkLimit() uint64 {
+	if p.ElemSize == 0 {
+		return p.Limit
+	}
+	return CalculateLimit(p.Limit, 0, p.ElemSize)
+}
+
+// gindex returns the generalized index of the element (or its chunk) and the
+// depth of the data tree of the list.
+func (p *ListElementProof) gindex(listGIndex int) (int, int, error) {
+	if listGIndex < 1 {
+		return 0, 0, fmt.Errorf("incorrect generalized index %d", listGIndex)
+	}
+	if p.Index >= p.Limit {
+		return 0, 0, fmt.Errorf("index %d out of bounds of list with limit %d", p.Index, p.Limit)
+	}
+
+	depth := int(getDepth(p.chunkLimit()))
+	if bits.Len(uint(listGIndex))+1+depth > 63 {
+		return 0, 0, fmt.Errorf("generalized index of element %d overflows", p.Index)
+	}
+
+	pos := p.Index
+	if p.ElemSize != 0 {
+		pos = p.Index * p.ElemSize / 32
+	}
+	return (2*listGIndex)<<depth | int(pos), depth, nil
+}
+
+// ListGIndex returns the generalized index of the root of the list
+func (p *ListElementProof) ListGIndex() (int, error) {
+	if p.Proof == nil {
+		return 0, errors.New("empty proof")
+	}
+	depth := int(getDepth(p.chunkLimit()))
+	listGIndex := p.Proof.Index >> (depth + 1)
+	if gindex, _, err := p.gindex(listGIndex); err != nil {
+		return 0, err
+	} else if gindex != p.Proof.Index {
+		return 0, fmt.Errorf("proof index %d is not the index of element %d", p.Proof.Index, p.Index)
+	}
+	return listGIndex, nil
+}
+
+// Length returns the length of the list committed in the proof
+func (p *ListElementProof) Length() (uint64, error) {
+	if p.Proof == nil {
+		return 0, errors.New("empty proof")
+	}
+	depth := int(getDepth(p.chunkLimit()))
+	if len(p.Proof.Hashes) <= depth {
+		return 0, errors.New("proof does not include the length of the list")
+	}
+	return decodeListLength(p.Proof.Hashes[depth])
+}
+
+// Element returns the element if it is a basic value or its root otherwise
+func (p *ListElementProof) Element() ([]byte, error) {
+	if p.Proof == nil {
+		return nil, errors.New("empty proof")
+	}
+	if len(p.Proof.Leaf) != 32 {
+		return nil, errors.New("invalid leaf length")
+	}
+	if p.ElemSize == 0 {
+		return p.Proof.Leaf, nil
+	}
+	if 32%p.ElemSize != 0 {
+		return nil, fmt.Errorf("incorrect size %d for a basic element", p.ElemSize)
+	}
+	offset := p.Index % (32 / p.ElemSize) * p.ElemSize
+	return p.Proof.Leaf[offset : offset+p.ElemSize], nil
+}
+
+// VerifyListElementProof verifies the proof of the element of the list against the root
+// and checks that the committed length of the list is the expected one. The caller has to
+// check that the list index, the limit and the size of the elements match the schema.
+func VerifyListElementProof(root []byte, proof *ListElementProof, expectedLength uint64) (bool, error) {
+	if _, err := proof.ListGIndex(); err != nil {
+		return false, err
+	}
+	length, err := proof.Length()
+	if err != nil {
+		return false, err
+	}
+	if length != expectedLength {
+		return false, fmt.Errorf("list length %d does not match the expected length %d", length, expectedLength)
+	}
+	if length > proof.Limit {
+		return false, ErrListTooBig
+	}
+	if proof.Index >= length {
+		return false, fmt.Errorf("index %d out of bounds of list of length %d", proof.Index, length)
+	}
+	if len(proof.Proof.Leaf) != 32 {
+		return false, errors.New("invalid leaf length")
+	}
+	return VerifyProof(root, proof.Proof)
+}
+
+func decodeListLength(leaf []byte) (uint64, error) {
+	if len(leaf) != 32 || !bytes.Equal(leaf[8:], zeroBytes[:24]) {
+		return 0, errors.New("incorrect list length leaf")
+	}
+	return binary.LittleEndian.Uint64(leaf[:8]), nil
+}
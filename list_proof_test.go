@@ -0,0 +1,98 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListElementProof(t *testing.T) {
+	tree := storeTestTree(t, 5, 1)
+	root := tree.Hash()
+
+	for i := uint64(0); i < 5; i++ {
+		proof, err := ProveListElement(tree, 5, i, 16)
+		require.NoError(t, err)
+		elem, err := proof.Element()
+		require.NoError(t, err)
+		require.Equal(t, mustGet(t, tree, 5<<5|int(i)).Hash(), elem)
+
+		listGIndex, err := proof.ListGIndex()
+		require.NoError(t, err)
+		require.Equal(t, 5, listGIndex)
+
+		ok, err := VerifyListElementProof(root, proof, 5)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		// wrong expected length
+		_, err = VerifyListElementProof(root, proof, 6)
+		require.Error(t, err)
+	}
+
+	// element out of the bounds of the list
+	_, err := ProveListElement(tree, 5, 5, 16)
+	require.Error(t, err)
+	_, err = ProveListElement(tree, 5, 16, 16)
+	require.Error(t, err)
+
+	// forged length
+	proof, err := ProveListElement(tree, 5, 2, 16)
+	require.NoError(t, err)
+	proof.Proof.Hashes[4] = LeafFromUint64(8).Hash()
+	ok, err := VerifyListElementProof(root, proof, 8)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestPackedListElementProof(t *testing.T) {
+	items := []uint64{}
+	for i := 0; i < 10; i++ {
+		items = append(items, uint64(100+i))
+	}
+	list, err := TreeFromNodesWithMixin(LeavesFromUint64(items), len(items), 16)
+	require.NoError(t, err)
+	tree, err := TreeFromNodes([]*Node{LeafFromUint8(1), list}, 2)
+	require.NoError(t, err)
+	root := tree.Hash()
+
+	for i, item := range items {
+		proof, err := ProvePackedListElement(tree, 3, uint64(i), 64, 8)
+		require.NoError(t, err)
+		elem, err := proof.Element()
+		require.NoError(t, err)
+		require.Equal(t, item, binary.LittleEndian.Uint64(elem))
+
+		// four elements share the same chunk
+		require.Equal(t, 3<<5|i/4, proof.Proof.Index)
+
+		ok, err := VerifyListElementProof(root, proof, uint64(len(items)))
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+
+	// the chunk of the element is proven but the element is out of bounds
+	proof, err := ProvePackedListElement(tree, 3, 9, 64, 8)
+	require.NoError(t, err)
+	proof.Index = 10
+	_, err = VerifyListElementProof(root, proof, uint64(len(items)))
+	require.Error(t, err)
+
+	_, err = ProvePackedListElement(tree, 3, 0, 64, 3)
+	require.Error(t, err)
+
+	// the element of a malformed proof
+	for _, forge := range []func(p *ListElementProof){
+		func(p *ListElementProof) { p.Proof = nil },
+		func(p *ListElementProof) { p.Proof.Leaf = p.Proof.Leaf[:8] },
+		func(p *ListElementProof) { p.ElemSize = 3 },
+		func(p *ListElementProof) { p.ElemSize = 64 },
+	} {
+		proof, err := ProvePackedListElement(tree, 3, 9, 64, 8)
+		require.NoError(t, err)
+		forge(proof)
+		_, err = proof.Element()
+		require.Error(t, err)
+	}
+}
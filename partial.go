@@ -0,0 +1,126 @@
+package ssz
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// ErrPruned is returned when a node is accessed below a pruned node of a partial tree
+var ErrPruned = errors.New("node is pruned")
+
+// newPrunedNode returns an opaque node of a partial tree. Only its hash is known.
+func newPrunedNode(hash []byte) *Node {
+	if level, ok := zeroHashLevels[string(hash)]; ok && level > 0 {
+		// the content of a zero subtree is known
+		return NewEmptyNode(zeroHashes[level][:])
+	}
+	return &Node{value: append([]byte{}, hash...), isPruned: true}
+}
+
+// NewPartialTree builds the partial tree proven by the multiproof. The leaves and
+// the helper hashes of the proof are opaque nodes, the rest of the nodes of the
+// paths to the root are computed from them. Get returns the nodes of the proof and
+// fails with ErrPruned for the nodes below them.
+func NewPartialTree(root []byte, proof *Multiproof) (*Node, error) {
+	ok, err := VerifyMultiproof(root, proof.Hashes, proof.Leaves, proof.Indices)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("multiproof is not valid")
+	}
+
+	known := map[int][]byte{}
+	for i, indx := range proof.Indices {
+		known[indx] = proof.Leaves[i]
+	}
+	for i, indx := range getRequiredIndices(proof.Indices) {
+		known[indx] = proof.Hashes[i]
+	}
+
+	n, err := partialNode(1, known)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(n.Hash(), root) {
+		return nil, errors.New("partial tree root mismatch")
+	}
+	return n, nil
+}
+
+func partialNode(gindex int, known map[int][]byte) (*Node, error) {
+	if hash, ok := known[gindex]; ok {
+		return newPrunedNode(hash), nil
+	}
+	if getPathLength(gindex) >= 63 {
+		return nil, fmt.Errorf("node %d not found in proof", gindex)
+	}
+	left, err := partialNode(2*gindex, known)
+	if err != nil {
+		return nil, err
+	}
+	right, err := partialNode(2*gindex+1, known)
+	if err != nil {
+		return nil, err
+	}
+	return NewNodeWithLR(left, right), nil
+}
+
+// Prune returns a partial tree with the subtrees at the given generalized indices
+// and the nodes required to prove them. The rest of the subtrees are replaced by
+// opaque nodes with their hash. The kept subtrees are shared with the original tree.
+func (n *Node) Prune(keep []int) (*Node, error) {
+	items := make([]setItem, 0, len(keep))
+	for _, index := range keep {
+		if index < 1 {
+			return nil, fmt.Errorf("incorrect general index %d", index)
+		}
+		items = append(items, setItem{index: index, relative: index})
+	}
+	return pruneNode(n, items)
+}
+
+func pruneNode(n *Node, items []setItem) (*Node, error) {
+	if len(items) == 0 {
+		return newPrunedNode(hashNode(n)), nil
+	}
+
+	left, right := []setItem{}, []setItem{}
+	for _, item := range items {
+		if item.relative == 1 {
+			// keep the full subtree
+			return n, nil
+		}
+
+		// move the item to the subtree of the child in its path
+		pathLen := getPathLength(item.relative)
+		isRight := getPosAtLevel(item.relative, pathLen-1)
+		item.relative = item.relative&(1<<(pathLen-1)-1) | 1<<(pathLen-1)
+		if isRight {
+			right = append(right, item)
+		} else {
+			left = append(left, item)
+		}
+	}
+
+	nLeft, nRight, err := n.children()
+	if err != nil {
+		return nil, err
+	}
+	if nLeft == nil && nRight == nil {
+		if n.isEmpty {
+			// the zero subtree is known
+			return n, nil
+		}
+		return nil, fmt.Errorf("general index %d is below a leaf node", items[0].index)
+	}
+
+	if nLeft, err = pruneNode(nLeft, left); err != nil {
+		return nil, err
+	}
+	if nRight, err = pruneNode(nRight, right); err != nil {
+		return nil, err
+	}
+	return NewNodeWithLR(nLeft, nRight), nil
+}
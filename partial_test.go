@@ -0,0 +1,95 @@
+package ssz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPartialTree(t *testing.T) {
+	tree := storeTestTree(t, 5, 1)
+	root := tree.Hash()
+
+	// first field and the third element of the list
+	indices := []int{4, 5<<5 | 2}
+	proof, err := tree.ProveMulti(indices)
+	require.NoError(t, err)
+
+	partial, err := NewPartialTree(root, proof)
+	require.NoError(t, err)
+	require.Equal(t, root, partial.Hash())
+
+	// the proven nodes and the helper nodes are known
+	for _, indx := range append(indices, getRequiredIndices(indices)...) {
+		node, err := partial.Get(indx)
+		require.NoError(t, err)
+		require.Equal(t, mustGet(t, tree, indx).Hash(), node.Hash())
+	}
+
+	// the nodes below the helper nodes are pruned
+	_, err = partial.Get(3)
+	require.NoError(t, err)
+	_, err = partial.Get(6)
+	require.ErrorIs(t, err, ErrPruned)
+	_, err = partial.Prove(6)
+	require.ErrorIs(t, err, ErrPruned)
+
+	// the partial tree can serve the proof again
+	proof2, err := partial.ProveMulti(indices)
+	require.NoError(t, err)
+	require.Equal(t, proof, proof2)
+
+	// invalid proof
+	proof.Leaves[0] = LeafFromUint8(9).Hash()
+	_, err = NewPartialTree(root, proof)
+	require.Error(t, err)
+}
+
+func TestPrune(t *testing.T) {
+	tree := storeTestTree(t, 5, 1)
+	root := tree.Hash()
+
+	// keep the list
+	partial, err := tree.Prune([]int{5})
+	require.NoError(t, err)
+	require.Equal(t, root, partial.Hash())
+
+	for i := 5 << 5; i < 5<<5|5; i++ {
+		require.Equal(t, mustGet(t, tree, i).Hash(), mustGet(t, partial, i).Hash())
+	}
+	elems, err := TreeList(mustGet(t, partial, 5), 16)
+	require.NoError(t, err)
+	require.Len(t, elems, 5)
+
+	_, err = partial.Get(4)
+	require.NoError(t, err)
+	_, err = partial.Get(12)
+	require.ErrorIs(t, err, ErrPruned)
+
+	// prune a partial tree again, keep the first four elements of the list
+	partial2, err := partial.Prune([]int{40})
+	require.NoError(t, err)
+	require.Equal(t, root, partial2.Hash())
+	_, err = partial2.Get(5<<5 | 1)
+	require.NoError(t, err)
+	_, err = partial2.Get(41)
+	require.NoError(t, err)
+	_, err = partial2.Get(82)
+	require.ErrorIs(t, err, ErrPruned)
+	_, err = partial2.Prune([]int{12})
+	require.ErrorIs(t, err, ErrPruned)
+
+	// the partial tree can be persisted
+	store := NewMemoryStore()
+	require.NoError(t, partial2.Persist(store))
+	lazy, err := LoadNode(store, root)
+	require.NoError(t, err)
+	_, err = lazy.Get(5<<5 | 1)
+	require.NoError(t, err)
+	_, err = lazy.Get(82)
+	require.ErrorIs(t, err, ErrPruned)
+
+	// below a leaf
+	_, err = tree.Prune([]int{8})
+	require.Error(t, err)
+}
@@ -2,9 +2,10 @@ package ssz
 
 import (
 	"bytes"
+	"container/heap"
 	"errors"
 	"fmt"
-	"math"
+	"math/bits"
 	"sort"
 
 	"github.com/minio/sha256-simd"
@@ -34,46 +35,152 @@ func VerifyProof(root []byte, proof *Proof) (bool, error) {
 	return bytes.Equal(root, node), nil
 }
 
+// MerkleBranch is the single leaf proof format of the consensus specs. Index is the
+// position of the leaf at the given depth (i.e. the generalized index without the
+// leading bit) and Branch are the sibling hashes from the leaf to the root.
+type MerkleBranch struct {
+	Leaf   []byte
+	Branch [][]byte
+	Depth  uint64
+	Index  uint64
+}
+
+// MerkleBranch returns the proof in the single leaf format of the consensus specs
+func (p *Proof) MerkleBranch() (*MerkleBranch, error) {
+	if p.Index <= 0 {
+		return nil, fmt.Errorf("incorrect proof index %d", p.Index)
+	}
+	depth := getPathLength(p.Index)
+	if len(p.Hashes) != depth {
+		return nil, fmt.Errorf("proof length %d and depth %d mismatch", len(p.Hashes), depth)
+	}
+	return &MerkleBranch{
+		Leaf:   p.Leaf,
+		Branch: p.Hashes,
+		Depth:  uint64(depth),
+		Index:  uint64(p.Index) ^ (1 << depth),
+	}, nil
+}
+
+// Proof returns the merkle branch as a proof against a generalized index
+func (b *MerkleBranch) Proof() (*Proof, error) {
+	if b.Depth >= 63 || b.Index >= 1<<b.Depth {
+		return nil, fmt.Errorf("incorrect index %d for depth %d", b.Index, b.Depth)
+	}
+	if uint64(len(b.Branch)) != b.Depth {
+		return nil, fmt.Errorf("branch length %d and depth %d mismatch", len(b.Branch), b.Depth)
+	}
+	return &Proof{
+		Index:  int(1<<b.Depth | b.Index),
+		Leaf:   b.Leaf,
+		Hashes: b.Branch,
+	}, nil
+}
+
+// Verify checks the merkle branch against the given root
+func (b *MerkleBranch) Verify(root []byte) bool {
+	return IsValidMerkleBranch(b.Leaf, b.Branch, b.Depth, b.Index, root)
+}
+
+// IsValidMerkleBranch checks that the leaf at the given index and depth is part of the
+// tree with the given root (is_valid_merkle_branch).
+func IsValidMerkleBranch(leaf []byte, branch [][]byte, depth uint64, index uint64, root []byte) bool {
+	if uint64(len(branch)) < depth {
+		return false
+	}
+
+	value := leaf
+	tmp := make([]byte, 64)
+	for i := uint64(0); i < depth; i++ {
+		if i < 64 && (index>>i)&1 == 1 {
+			copy(tmp[:32], branch[i])
+			copy(tmp[32:], value)
+		} else {
+			copy(tmp[:32], value)
+			copy(tmp[32:], branch[i])
+		}
+		value = hashFn(tmp)
+	}
+	return bytes.Equal(value, root)
+}
+
 // VerifyMultiproof verifies a proof for multiple leaves against the given root.
 func VerifyMultiproof(root []byte, proof [][]byte, leaves [][]byte, indices []int) (bool, error) {
+	return verifyMultiproof(root, leaves, indices, len(proof), func(i int) ([]byte, error) {
+		return proof[i], nil
+	})
+}
+
+// VerifyCompressedMultiproof verifies a compressed proof for multiple leaves against
+// the given root. The omitted zero hashes are filled in during the verification
+// without decompressing the proof.
+func VerifyCompressedMultiproof(root []byte, proof *CompressedMultiproof) (bool, error) {
+	zc := 0
+	ok, err := verifyMultiproof(root, proof.Leaves, proof.Indices, len(proof.Hashes), func(i int) ([]byte, error) {
+		if h := proof.Hashes[i]; h != nil {
+			return h, nil
+		}
+		// hashes are requested in order, the zero level of the
+		// omitted hash is the next one in the list
+		if zc >= len(proof.ZeroLevels) {
+			return nil, fmt.Errorf("proof is missing zero levels for omitted hashes")
+		}
+		level := proof.ZeroLevels[zc]
+		if level < 0 || level >= len(zeroHashes) {
+			return nil, fmt.Errorf("incorrect zero hash level %d", level)
+		}
+		zc++
+		return zeroHashes[level][:], nil
+	})
+	if err != nil {
+		return false, err
+	}
+	if zc != len(proof.ZeroLevels) {
+		return false, fmt.Errorf("number of omitted hashes %d and zero levels %d mismatch", zc, len(proof.ZeroLevels))
+	}
+	return ok, nil
+}
+
+func verifyMultiproof(root []byte, leaves [][]byte, indices []int, numHashes int, getHash func(i int) ([]byte, error)) (bool, error) {
 	if len(leaves) != len(indices) {
 		return false, errors.New("number of leaves and indices mismatch")
 	}
 
 	reqIndices := getRequiredIndices(indices)
-	if len(reqIndices) != len(proof) {
-		return false, fmt.Errorf("number of proof hashes %d and required indices %d mismatch", len(proof), len(reqIndices))
+	if len(reqIndices) != numHashes {
+		return false, fmt.Errorf("number of proof hashes %d and required indices %d mismatch", numHashes, len(reqIndices))
 	}
 
-	keys := make([]int, len(indices)+len(reqIndices))
-	nk := 0
+	// pending indices in decreasing order so that the siblings of a
+	// parent are computed before the parent is reached
+	keys := make(indexHeap, 0, len(indices)+len(reqIndices))
 	// Create database of index -> value (hash)
 	// from inputs
 	db := make(map[int][]byte)
 	for i, leaf := range leaves {
 		db[indices[i]] = leaf
-		keys[nk] = indices[i]
-		nk++
+		keys = append(keys, indices[i])
 	}
-	for i, h := range proof {
+	for i := 0; i < numHashes; i++ {
+		h, err := getHash(i)
+		if err != nil {
+			return false, err
+		}
 		db[reqIndices[i]] = h
-		keys[nk] = reqIndices[i]
-		nk++
+		keys = append(keys, reqIndices[i])
 	}
-	sort.Sort(sort.Reverse(sort.IntSlice(keys)))
+	heap.Init(&keys)
 
-	pos := 0
 	tmp := make([]byte, 64)
-	for pos < len(keys) {
-		k := keys[pos]
+	for keys.Len() > 0 {
+		k := heap.Pop(&keys).(int)
 		// Root has been reached
 		if k == 1 {
 			break
 		}
 
-		_, hasParent := db[getParent(k)]
-		if hasParent {
-			pos++
+		parent := getParent(k)
+		if _, hasParent := db[parent]; hasParent {
 			continue
 		}
 
@@ -85,10 +192,8 @@ func VerifyMultiproof(root []byte, proof [][]byte, leaves [][]byte, indices []in
 
 		copy(tmp[:32], left[:])
 		copy(tmp[32:], right[:])
-		db[getParent(k)] = hashFn(tmp)
-		keys = append(keys, getParent(k))
-
-		pos++
+		db[parent] = hashFn(tmp)
+		heap.Push(&keys, parent)
 	}
 
 	res, ok := db[1]
@@ -109,7 +214,7 @@ func getPosAtLevel(index int, level int) bool {
 
 // Returns the length of the path to a node represented by its generalized index.
 func getPathLength(index int) int {
-	return int(math.Log2(float64(index)))
+	return bits.Len(uint(index)) - 1
 }
 
 // Returns the generalized index for a node's sibling.
@@ -164,3 +269,22 @@ func hashFn(data []byte) []byte {
 	res := sha256.Sum256(data)
 	return res[:]
 }
+
+// indexHeap is a max-heap of generalized indices
+type indexHeap []int
+
+func (h indexHeap) Len() int           { return len(h) }
+func (h indexHeap) Less(i, j int) bool { return h[i] > h[j] }
+func (h indexHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *indexHeap) Push(x interface{}) {
+	*h = append(*h, x.(int))
+}
+
+func (h *indexHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
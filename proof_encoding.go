@@ -0,0 +1,660 @@
+package ssz
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// The proofs are encoded as the following ssz containers:
+//
+//	class Proof(Container):
+//	    index: uint64
+//	    leaf: Bytes32
+//	    hashes: List[Bytes32, 64]
+//
+//	class Multiproof(Container):
+//	    indices: List[uint64, MAX_PROOF_ITEMS]
+//	    leaves: List[Bytes32, MAX_PROOF_ITEMS]
+//	    hashes: List[Bytes32, MAX_PROOF_ITEMS]
+//
+//	class CompressedMultiproof(Container):
+//	    indices: List[uint64, MAX_PROOF_ITEMS]
+//	    leaves: List[Bytes32, MAX_PROOF_ITEMS]
+//	    hashes: List[Bytes32, MAX_PROOF_ITEMS]  # only the hashes that are not omitted
+//	    omitted: Bitlist[MAX_PROOF_ITEMS]       # whether each hash of the proof is omitted
+//	    zero_levels: List[uint8, MAX_PROOF_ITEMS]
+const (
+	// MaxProofHashes is the maximum number of hashes of a single proof
+	MaxProofHashes = 64
+
+	// MaxProofItems is the maximum number of leaves and hashes of a multiproof
+	MaxProofItems = 1 << 16
+)
+
+// MarshalSSZ ssz marshals the Proof object
+func (p *Proof) MarshalSSZ() ([]byte, error) {
+	return MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the Proof object to a target array
+func (p *Proof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(44)
+
+	// Field (0) 'Index'
+	if p.Index < 0 {
+		err = fmt.Errorf("incorrect proof index %d", p.Index)
+		return
+	}
+	dst = MarshalUint64(dst, uint64(p.Index))
+
+	// Field (1) 'Leaf'
+	if size := len(p.Leaf); size != 32 {
+		err = ErrBytesLengthFn("Proof.Leaf", size, 32)
+		return
+	}
+	dst = append(dst, p.Leaf...)
+
+	// Offset (2) 'Hashes'
+	dst = WriteOffset(dst, offset)
+
+	// Field (2) 'Hashes'
+	if dst, err = marshalHashes(dst, "Proof.Hashes", p.Hashes, MaxProofHashes); err != nil {
+		return
+	}
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Proof object
+func (p *Proof) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 44 {
+		return ErrSize
+	}
+
+	// Field (0) 'Index'
+	if p.Index, err = unmarshalIndex(buf[0:8]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Leaf'
+	p.Leaf = append([]byte{}, buf[8:40]...)
+
+	// Offset (2) 'Hashes'
+	if o2 := ReadOffset(buf[40:44]); o2 != 44 {
+		return ErrInvalidVariableOffset
+	}
+
+	// Field (2) 'Hashes'
+	p.Hashes, err = unmarshalHashes(buf[44:], MaxProofHashes)
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Proof object
+func (p *Proof) SizeSSZ() (size int) {
+	size = 44
+
+	// Field (2) 'Hashes'
+	size += len(p.Hashes) * 32
+
+	return
+}
+
+// MarshalSSZ ssz marshals the Multiproof object
+func (p *Multiproof) MarshalSSZ() ([]byte, error) {
+	return MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the Multiproof object to a target array
+func (p *Multiproof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(12)
+
+	// Offset (0) 'Indices'
+	dst = WriteOffset(dst, offset)
+	offset += len(p.Indices) * 8
+
+	// Offset (1) 'Leaves'
+	dst = WriteOffset(dst, offset)
+	offset += len(p.Leaves) * 32
+
+	// Offset (2) 'Hashes'
+	dst = WriteOffset(dst, offset)
+
+	// Field (0) 'Indices'
+	if dst, err = marshalIndices(dst, "Multiproof.Indices", p.Indices); err != nil {
+		return
+	}
+
+	// Field (1) 'Leaves'
+	if dst, err = marshalHashes(dst, "Multiproof.Leaves", p.Leaves, MaxProofItems); err != nil {
+		return
+	}
+
+	// Field (2) 'Hashes'
+	if dst, err = marshalHashes(dst, "Multiproof.Hashes", p.Hashes, MaxProofItems); err != nil {
+		return
+	}
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Multiproof object
+func (p *Multiproof) UnmarshalSSZ(buf []byte) error {
+	tail, err := readOffsets(buf, 3)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Indices'
+	if p.Indices, err = unmarshalIndices(tail[0]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Leaves'
+	if p.Leaves, err = unmarshalHashes(tail[1], MaxProofItems); err != nil {
+		return err
+	}
+
+	// Field (2) 'Hashes'
+	if p.Hashes, err = unmarshalHashes(tail[2], MaxProofItems); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Multiproof object
+func (p *Multiproof) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (0) 'Indices'
+	size += len(p.Indices) * 8
+
+	// Field (1) 'Leaves'
+	size += len(p.Leaves) * 32
+
+	// Field (2) 'Hashes'
+	size += len(p.Hashes) * 32
+
+	return
+}
+
+// MarshalSSZ ssz marshals the CompressedMultiproof object
+func (c *CompressedMultiproof) MarshalSSZ() ([]byte, error) {
+	return MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CompressedMultiproof object to a target array
+func (c *CompressedMultiproof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(20)
+
+	hashes, omitted := c.splitHashes()
+	if len(c.Hashes) > MaxProofItems {
+		err = ErrListTooBigFn("CompressedMultiproof.Hashes", len(c.Hashes), MaxProofItems)
+		return
+	}
+	if num := len(c.Hashes) - len(hashes); num != len(c.ZeroLevels) {
+		err = fmt.Errorf("number of omitted hashes %d and zero levels %d mismatch", num, len(c.ZeroLevels))
+		return
+	}
+
+	// Offset (0) 'Indices'
+	dst = WriteOffset(dst, offset)
+	offset += len(c.Indices) * 8
+
+	// Offset (1) 'Leaves'
+	dst = WriteOffset(dst, offset)
+	offset += len(c.Leaves) * 32
+
+	// Offset (2) 'Hashes'
+	dst = WriteOffset(dst, offset)
+	offset += len(hashes) * 32
+
+	// Offset (3) 'Omitted'
+	dst = WriteOffset(dst, offset)
+	offset += len(omitted)
+
+	// Offset (4) 'ZeroLevels'
+	dst = WriteOffset(dst, offset)
+
+	// Field (0) 'Indices'
+	if dst, err = marshalIndices(dst, "CompressedMultiproof.Indices", c.Indices); err != nil {
+		return
+	}
+
+	// Field (1) 'Leaves'
+	if dst, err = marshalHashes(dst, "CompressedMultiproof.Leaves", c.Leaves, MaxProofItems); err != nil {
+		return
+	}
+
+	// Field (2) 'Hashes'
+	if dst, err = marshalHashes(dst, "CompressedMultiproof.Hashes", hashes, MaxProofItems); err != nil {
+		return
+	}
+
+	// Field (3) 'Omitted'
+	dst = append(dst, omitted...)
+
+	// Field (4) 'ZeroLevels'
+	for _, l := range c.ZeroLevels {
+		if l < 0 || l >= len(zeroHashes) {
+			err = fmt.Errorf("incorrect zero hash level %d", l)
+			return
+		}
+		dst = MarshalUint8(dst, uint8(l))
+	}
+	return
+}
+
+// splitHashes returns the hashes that are not omitted and the bitlist
+// with the positions of the omitted hashes.
+func (c *CompressedMultiproof) splitHashes() ([][]byte, []byte) {
+	hashes := make([][]byte, 0, len(c.Hashes))
+	omitted := make([]byte, len(c.Hashes)/8+1)
+	for i, h := range c.Hashes {
+		if h == nil {
+			omitted[i/8] |= 1 << (i % 8)
+		} else {
+			hashes = append(hashes, h)
+		}
+	}
+	// length bit of the bitlist
+	omitted[len(c.Hashes)/8] |= 1 << (len(c.Hashes) % 8)
+	return hashes, omitted
+}
+
+// UnmarshalSSZ ssz unmarshals the CompressedMultiproof object
+func (c *CompressedMultiproof) UnmarshalSSZ(buf []byte) error {
+	tail, err := readOffsets(buf, 5)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Indices'
+	if c.Indices, err = unmarshalIndices(tail[0]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Leaves'
+	if c.Leaves, err = unmarshalHashes(tail[1], MaxProofItems); err != nil {
+		return err
+	}
+
+	// Field (2) 'Hashes'
+	hashes, err := unmarshalHashes(tail[2], MaxProofItems)
+	if err != nil {
+		return err
+	}
+
+	// Field (3) 'Omitted'
+	omitted := tail[3]
+	if err = ValidateBitlist(omitted, MaxProofItems); err != nil {
+		return err
+	}
+	num := 8*(len(omitted)-1) + bits.Len8(omitted[len(omitted)-1]) - 1
+
+	// Field (4) 'ZeroLevels'
+	if len(tail[4]) > MaxProofItems {
+		return ErrListTooBig
+	}
+	c.ZeroLevels = make([]int, len(tail[4]))
+	for i, l := range tail[4] {
+		if int(l) >= len(zeroHashes) {
+			return fmt.Errorf("incorrect zero hash level %d", l)
+		}
+		c.ZeroLevels[i] = int(l)
+	}
+
+	c.Hashes = make([][]byte, num)
+	for i := 0; i < num; i++ {
+		if omitted[i/8]&(1<<(i%8)) != 0 {
+			continue
+		}
+		if len(hashes) == 0 {
+			return fmt.Errorf("number of hashes and omitted hashes mismatch")
+		}
+		c.Hashes[i], hashes = hashes[0], hashes[1:]
+	}
+	if len(hashes) != 0 || countNil(c.Hashes) != len(c.ZeroLevels) {
+		return fmt.Errorf("number of hashes and omitted hashes mismatch")
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CompressedMultiproof object
+func (c *CompressedMultiproof) SizeSSZ() (size int) {
+	size = 20
+
+	// Field (0) 'Indices'
+	size += len(c.Indices) * 8
+
+	// Field (1) 'Leaves'
+	size += len(c.Leaves) * 32
+
+	// Field (2) 'Hashes'
+	size += (len(c.Hashes) - countNil(c.Hashes)) * 32
+
+	// Field (3) 'Omitted'
+	size += len(c.Hashes)/8 + 1
+
+	// Field (4) 'ZeroLevels'
+	size += len(c.ZeroLevels)
+
+	return
+}
+
+func countNil(hashes [][]byte) (num int) {
+	for _, h := range hashes {
+		if h == nil {
+			num++
+		}
+	}
+	return
+}
+
+// readOffsets reads the offsets of a container with num variable
+// fields and returns the content of each of the fields.
+func readOffsets(buf []byte, num int) ([][]byte, error) {
+	size := uint64(len(buf))
+	fixed := uint64(num * bytesPerLengthOffset)
+	if size < fixed {
+		return nil, ErrSize
+	}
+
+	offsets := make([]uint64, num+1)
+	for i := 0; i < num; i++ {
+		offsets[i] = ReadOffset(buf[i*bytesPerLengthOffset : (i+1)*bytesPerLengthOffset])
+	}
+	offsets[num] = size
+
+	if offsets[0] != fixed {
+		return nil, ErrInvalidVariableOffset
+	}
+	res := make([][]byte, num)
+	for i := 0; i < num; i++ {
+		if offsets[i] > offsets[i+1] || offsets[i+1] > size {
+			return nil, ErrOffset
+		}
+		res[i] = buf[offsets[i]:offsets[i+1]]
+	}
+	return res, nil
+}
+
+func marshalIndices(dst []byte, name string, indices []int) ([]byte, error) {
+	if size := len(indices); size > MaxProofItems {
+		return nil, ErrListTooBigFn(name, size, MaxProofItems)
+	}
+	for _, indx := range indices {
+		if indx < 0 {
+			return nil, fmt.Errorf("incorrect proof index %d", indx)
+		}
+		dst = MarshalUint64(dst, uint64(indx))
+	}
+	return dst, nil
+}
+
+func unmarshalIndices(buf []byte) ([]int, error) {
+	num, err := DivideInt2(len(buf), 8, MaxProofItems)
+	if err != nil {
+		return nil, err
+	}
+	indices := make([]int, num)
+	for i := 0; i < num; i++ {
+		if indices[i], err = unmarshalIndex(buf[i*8 : (i+1)*8]); err != nil {
+			return nil, err
+		}
+	}
+	return indices, nil
+}
+
+func unmarshalIndex(buf []byte) (int, error) {
+	indx := UnmarshallUint64(buf)
+	if indx > math.MaxInt {
+		return 0, fmt.Errorf("proof index %d overflows", indx)
+	}
+	return int(indx), nil
+}
+
+func marshalHashes(dst []byte, name string, hashes [][]byte, max int) ([]byte, error) {
+	if size := len(hashes); size > max {
+		return nil, ErrListTooBigFn(name, size, max)
+	}
+	for _, h := range hashes {
+		if size := len(h); size != 32 {
+			return nil, ErrBytesLengthFn(name, size, 32)
+		}
+		dst = append(dst, h...)
+	}
+	return dst, nil
+}
+
+func unmarshalHashes(buf []byte, max int) ([][]byte, error) {
+	num, err := DivideInt2(len(buf), 32, max)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([][]byte, num)
+	for i := 0; i < num; i++ {
+		hashes[i] = append([]byte{}, buf[i*32:(i+1)*32]...)
+	}
+	return hashes, nil
+}
+
+// ---- json encoding ----
+
+// The proofs are encoded in json following the conventions of the beacon
+// node apis: uint64 values are decimal strings and bytes are 0x prefixed
+// hex strings. Omitted hashes of compressed multiproofs are null.
+
+type jsonUint64 uint64
+
+func (u jsonUint64) MarshalText() ([]byte, error) {
+	return []byte(strconv.FormatUint(uint64(u), 10)), nil
+}
+
+func (u *jsonUint64) UnmarshalText(text []byte) error {
+	v, err := strconv.ParseUint(string(text), 10, 64)
+	if err != nil {
+		return fmt.Errorf("incorrect uint64 '%s'", string(text))
+	}
+	*u = jsonUint64(v)
+	return nil
+}
+
+func (u jsonUint64) index() (int, error) {
+	if uint64(u) > math.MaxInt {
+		return 0, fmt.Errorf("proof index %d overflows", u)
+	}
+	return int(u), nil
+}
+
+type jsonBytes []byte
+
+func (b jsonBytes) MarshalText() ([]byte, error) {
+	return []byte("0x" + hex.EncodeToString(b)), nil
+}
+
+func (b *jsonBytes) UnmarshalText(text []byte) error {
+	str := string(text)
+	if !strings.HasPrefix(str, "0x") {
+		return fmt.Errorf("hex string '%s' does not have 0x prefix", str)
+	}
+	buf, err := hex.DecodeString(str[2:])
+	if err != nil {
+		return err
+	}
+	*b = buf
+	return nil
+}
+
+func toJSONIndices(indices []int) []jsonUint64 {
+	res := make([]jsonUint64, len(indices))
+	for i, indx := range indices {
+		res[i] = jsonUint64(indx)
+	}
+	return res
+}
+
+func fromJSONIndices(indices []jsonUint64) ([]int, error) {
+	res := make([]int, len(indices))
+	for i, indx := range indices {
+		v, err := indx.index()
+		if err != nil {
+			return nil, err
+		}
+		res[i] = v
+	}
+	return res, nil
+}
+
+// toJSONHashes converts the hashes to json values, nil hashes are encoded as null
+func toJSONHashes(hashes [][]byte) []*jsonBytes {
+	res := make([]*jsonBytes, len(hashes))
+	for i, h := range hashes {
+		if h != nil {
+			b := jsonBytes(h)
+			res[i] = &b
+		}
+	}
+	return res
+}
+
+func fromJSONHashes(hashes []*jsonBytes) [][]byte {
+	res := make([][]byte, len(hashes))
+	for i, h := range hashes {
+		if h != nil {
+			res[i] = *h
+		}
+	}
+	return res
+}
+
+type proofJSON struct {
+	Index  jsonUint64   `json:"index"`
+	Leaf   jsonBytes    `json:"leaf"`
+	Hashes []*jsonBytes `json:"hashes"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (p *Proof) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&proofJSON{
+		Index:  jsonUint64(p.Index),
+		Leaf:   p.Leaf,
+		Hashes: toJSONHashes(p.Hashes),
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (p *Proof) UnmarshalJSON(data []byte) error {
+	var obj proofJSON
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	indx, err := obj.Index.index()
+	if err != nil {
+		return err
+	}
+	p.Index = indx
+	p.Leaf = obj.Leaf
+	p.Hashes = fromJSONHashes(obj.Hashes)
+	return nil
+}
+
+type multiproofJSON struct {
+	Indices    []jsonUint64 `json:"indices"`
+	Leaves     []*jsonBytes `json:"leaves"`
+	Hashes     []*jsonBytes `json:"hashes"`
+	ZeroLevels []jsonUint64 `json:"zero_levels,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (p *Multiproof) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&multiproofJSON{
+		Indices: toJSONIndices(p.Indices),
+		Leaves:  toJSONHashes(p.Leaves),
+		Hashes:  toJSONHashes(p.Hashes),
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (p *Multiproof) UnmarshalJSON(data []byte) error {
+	var obj multiproofJSON
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	indices, err := fromJSONIndices(obj.Indices)
+	if err != nil {
+		return err
+	}
+	p.Indices = indices
+	p.Leaves = fromJSONHashes(obj.Leaves)
+	p.Hashes = fromJSONHashes(obj.Hashes)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (c *CompressedMultiproof) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&multiproofJSON{
+		Indices:    toJSONIndices(c.Indices),
+		Leaves:     toJSONHashes(c.Leaves),
+		Hashes:     toJSONHashes(c.Hashes),
+		ZeroLevels: toJSONIndices(c.ZeroLevels),
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (c *CompressedMultiproof) UnmarshalJSON(data []byte) error {
+	var obj multiproofJSON
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	indices, err := fromJSONIndices(obj.Indices)
+	if err != nil {
+		return err
+	}
+	zeroLevels, err := fromJSONIndices(obj.ZeroLevels)
+	if err != nil {
+		return err
+	}
+	c.Indices = indices
+	c.Leaves = fromJSONHashes(obj.Leaves)
+	c.Hashes = fromJSONHashes(obj.Hashes)
+	c.ZeroLevels = zeroLevels
+	return nil
+}
+
+type merkleBranchJSON struct {
+	Leaf   jsonBytes    `json:"leaf"`
+	Branch []*jsonBytes `json:"branch"`
+	Depth  jsonUint64   `json:"depth"`
+	Index  jsonUint64   `json:"index"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *MerkleBranch) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&merkleBranchJSON{
+		Leaf:   b.Leaf,
+		Branch: toJSONHashes(b.Branch),
+		Depth:  jsonUint64(b.Depth),
+		Index:  jsonUint64(b.Index),
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *MerkleBranch) UnmarshalJSON(data []byte) error {
+	var obj merkleBranchJSON
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	b.Leaf = obj.Leaf
+	b.Branch = fromJSONHashes(obj.Branch)
+	b.Depth = uint64(obj.Depth)
+	b.Index = uint64(obj.Index)
+	return nil
+}
@@ -0,0 +1,190 @@
+package ssz
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testProofTree(t *testing.T) *Node {
+	t.Helper()
+
+	nodes := []*Node{}
+	for i := 1; i < 4; i++ {
+		nodes = append(nodes, LeafFromUint64(uint64(i)))
+	}
+	r, err := TreeFromNodesWithMixin(nodes, len(nodes), 16)
+	require.NoError(t, err)
+	return r
+}
+
+func TestProofEncoding(t *testing.T) {
+	r := testProofTree(t)
+
+	p, err := r.Prove(33)
+	require.NoError(t, err)
+
+	buf, err := p.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, buf, p.SizeSSZ())
+
+	p2 := new(Proof)
+	require.NoError(t, p2.UnmarshalSSZ(buf))
+	require.Equal(t, p, p2)
+
+	data, err := json.Marshal(p)
+	require.NoError(t, err)
+
+	p3 := new(Proof)
+	require.NoError(t, json.Unmarshal(data, p3))
+	require.Equal(t, p, p3)
+
+	// incorrect offset
+	buf[40] = 45
+	require.Error(t, p2.UnmarshalSSZ(buf))
+}
+
+func TestProofJSONFormat(t *testing.T) {
+	p := &Proof{
+		Index:  5,
+		Leaf:   make([]byte, 32),
+		Hashes: [][]byte{make([]byte, 32)},
+	}
+	p.Leaf[0] = 1
+
+	data, err := json.Marshal(p)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"index": "5",
+		"leaf": "0x0100000000000000000000000000000000000000000000000000000000000000",
+		"hashes": ["0x0000000000000000000000000000000000000000000000000000000000000000"]
+	}`, string(data))
+
+	require.Error(t, json.Unmarshal([]byte(`{"index": "a"}`), new(Proof)))
+	require.Error(t, json.Unmarshal([]byte(`{"leaf": "01"}`), new(Proof)))
+}
+
+func TestMultiproofEncoding(t *testing.T) {
+	r := testProofTree(t)
+
+	p, err := r.ProveMulti([]int{32, 34, 3})
+	require.NoError(t, err)
+
+	buf, err := p.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, buf, p.SizeSSZ())
+
+	p2 := new(Multiproof)
+	require.NoError(t, p2.UnmarshalSSZ(buf))
+	require.Equal(t, p, p2)
+
+	data, err := json.Marshal(p)
+	require.NoError(t, err)
+
+	p3 := new(Multiproof)
+	require.NoError(t, json.Unmarshal(data, p3))
+	require.Equal(t, p, p3)
+
+	// truncated input
+	require.Error(t, p2.UnmarshalSSZ(buf[:len(buf)-1]))
+}
+
+func TestCompressedMultiproofEncoding(t *testing.T) {
+	r := testProofTree(t)
+
+	p, err := r.ProveMulti([]int{32, 34, 3})
+	require.NoError(t, err)
+
+	c := p.Compress()
+	require.NotEmpty(t, c.ZeroLevels)
+
+	buf, err := c.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, buf, c.SizeSSZ())
+
+	c2 := new(CompressedMultiproof)
+	require.NoError(t, c2.UnmarshalSSZ(buf))
+	require.Equal(t, c, c2)
+
+	data, err := json.Marshal(c)
+	require.NoError(t, err)
+
+	c3 := new(CompressedMultiproof)
+	require.NoError(t, json.Unmarshal(data, c3))
+	require.Equal(t, c, c3)
+
+	// zero levels do not match the omitted hashes
+	c3.ZeroLevels = c3.ZeroLevels[1:]
+	_, err = c3.MarshalSSZ()
+	require.Error(t, err)
+}
+
+func TestVerifyCompressedMultiproof(t *testing.T) {
+	r := testProofTree(t)
+
+	p, err := r.ProveMulti([]int{32, 34, 3})
+	require.NoError(t, err)
+
+	ok, err := VerifyMultiproof(r.Hash(), p.Hashes, p.Leaves, p.Indices)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = VerifyCompressedMultiproof(r.Hash(), p.Compress())
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// wrong zero level
+	c := p.Compress()
+	c.ZeroLevels[0]++
+	ok, err = VerifyCompressedMultiproof(r.Hash(), c)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// missing zero levels
+	c = p.Compress()
+	c.ZeroLevels = c.ZeroLevels[1:]
+	_, err = VerifyCompressedMultiproof(r.Hash(), c)
+	require.Error(t, err)
+}
+
+func TestMerkleBranch(t *testing.T) {
+	r := testProofTree(t)
+
+	for _, indx := range []int{2, 3, 32, 33, 34} {
+		p, err := r.Prove(indx)
+		require.NoError(t, err)
+
+		b, err := p.MerkleBranch()
+		require.NoError(t, err)
+		require.Equal(t, uint64(getPathLength(indx)), b.Depth)
+		require.True(t, b.Verify(r.Hash()))
+		require.True(t, IsValidMerkleBranch(b.Leaf, b.Branch, b.Depth, b.Index, r.Hash()))
+
+		p2, err := b.Proof()
+		require.NoError(t, err)
+		require.Equal(t, p, p2)
+
+		data, err := json.Marshal(b)
+		require.NoError(t, err)
+
+		b2 := new(MerkleBranch)
+		require.NoError(t, json.Unmarshal(data, b2))
+		require.Equal(t, b, b2)
+
+		b2.Index ^= 1
+		require.False(t, b2.Verify(r.Hash()))
+	}
+
+	// incorrect proof indices
+	for _, p := range []*Proof{{}, {Index: -1}, {Index: 4, Hashes: [][]byte{make([]byte, 32)}}} {
+		_, err := p.MerkleBranch()
+		require.Error(t, err)
+	}
+
+	// the root is a branch of depth zero
+	b, err := (&Proof{Index: 1, Leaf: r.Hash()}).MerkleBranch()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), b.Depth)
+	require.True(t, b.Verify(r.Hash()))
+}
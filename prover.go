@@ -0,0 +1,379 @@
+package ssz
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+var _ HashWalker = (*Prover)(nil)
+
+// ProveMulti returns a proof of the nodes at the given generalized indices of the
+// object. The result is the same as the one of Node.ProveMulti for the tree returned
+// by GetTree, but the tree is not built in memory.
+func ProveMulti(v HashRoot, indices []int) (*Multiproof, error) {
+	p := NewProver(indices)
+	if err := v.HashTreeRootWith(p); err != nil {
+		return nil, err
+	}
+	return p.Multiproof()
+}
+
+// Prove returns a proof of the node at the given generalized index of the
+// object without building the tree in memory. See ProveMulti.
+func Prove(v HashRoot, index int) (*Proof, error) {
+	proof, err := ProveMulti(v, []int{index})
+	if err != nil {
+		return nil, err
+	}
+	// the required indices of a single node are the siblings
+	// of its path sorted from the bottom to the top
+	return &Proof{Index: index, Leaf: proof.Leaves[0], Hashes: proof.Hashes}, nil
+}
+
+// Prover is a HashWalker that hashes the object like Hasher and keeps only
+// the hashes of the nodes required to prove a set of generalized indices.
+// The tree layout is the same one used by Wrapper.
+type Prover struct {
+	indices []int
+
+	// suffixes are the relative generalized indices of the nodes of a subtree
+	// that may end up being part of the proof once the subtree is placed in the
+	// final tree (i.e. the suffixes of the path of any required node).
+	suffixes map[int]struct{}
+
+	nodes []proverNode
+	buf   []byte
+	tmp   []byte
+	err   error
+}
+
+// proverNode is a subtree of the object
+type proverNode struct {
+	hash [32]byte
+
+	// captured nodes of the subtree that may be part of the proof
+	captured []provedNode
+}
+
+// provedNode is a node of a subtree indexed by its generalized index relative
+// to the root of the subtree
+type provedNode struct {
+	gindex int
+	hash   [32]byte
+}
+
+// NewProver creates a new Prover for the given generalized indices
+func NewProver(indices []int) *Prover {
+	p := &Prover{
+		indices:  indices,
+		suffixes: map[int]struct{}{},
+		tmp:      make([]byte, 64),
+	}
+
+	required := append(getRequiredIndices(indices), indices...)
+	for _, indx := range required {
+		if indx < 1 {
+			p.err = fmt.Errorf("incorrect generalized index %d", indx)
+			return p
+		}
+		for k := 0; k <= getPathLength(indx); k++ {
+			p.suffixes[indx&(1<<k-1)|1<<k] = struct{}{}
+		}
+	}
+	return p
+}
+
+// Reset resets the Prover obj
+func (p *Prover) Reset() {
+	p.nodes = p.nodes[:0]
+	p.buf = p.buf[:0]
+}
+
+// Multiproof returns the proof of the generalized indices once the object has been hashed
+func (p *Prover) Multiproof() (*Multiproof, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	if len(p.nodes) != 1 {
+		return nil, fmt.Errorf("expected one root node but found %d", len(p.nodes))
+	}
+
+	root := p.nodes[0]
+	db := map[int][32]byte{1: root.hash}
+	for _, n := range root.captured {
+		db[n.gindex] = n.hash
+	}
+
+	get := func(indx int) ([]byte, error) {
+		hash, ok := db[indx]
+		if !ok {
+			return nil, fmt.Errorf("node %d not found in tree", indx)
+		}
+		return append([]byte{}, hash[:]...), nil
+	}
+
+	reqIndices := getRequiredIndices(p.indices)
+	proof := &Multiproof{
+		Indices: p.indices,
+		Leaves:  make([][]byte, len(p.indices)),
+		Hashes:  make([][]byte, len(reqIndices)),
+	}
+
+	var err error
+	for i, indx := range p.indices {
+		if proof.Leaves[i], err = get(indx); err != nil {
+			return nil, err
+		}
+	}
+	for i, indx := range reqIndices {
+		if proof.Hashes[i], err = get(indx); err != nil {
+			return nil, err
+		}
+	}
+	return proof, nil
+}
+
+func (p *Prover) isRequired(gindex int) bool {
+	_, ok := p.suffixes[gindex]
+	return ok
+}
+
+// capture stores the node if it may be part of the proof
+func (p *Prover) capture(n *proverNode, gindex int, hash [32]byte) {
+	if gindex > 0 && p.isRequired(gindex) {
+		n.captured = append(n.captured, provedNode{gindex: gindex, hash: hash})
+	}
+}
+
+// place moves the subtree src to the position pos of the subtree dst
+func (p *Prover) place(dst *proverNode, pos int, src *proverNode) {
+	p.capture(dst, pos, src.hash)
+
+	posLen := bits.Len(uint(pos))
+	for _, n := range src.captured {
+		length := getPathLength(n.gindex)
+		if posLen+length > 63 {
+			// too deep to be a required node
+			continue
+		}
+		p.capture(dst, pos<<length|(n.gindex^1<<length), n.hash)
+	}
+}
+
+// merkleize builds a subtree with the given nodes as leaves. The limit is
+// the number of leaves of the subtree and it must be a power of two.
+func (p *Prover) merkleize(leaves []proverNode, limit uint64) proverNode {
+	depth := int(getDepth(limit))
+	if len(leaves) == 0 {
+		return proverNode{hash: zeroHashes[depth]}
+	}
+	if depth == 0 {
+		return leaves[0]
+	}
+
+	res := proverNode{}
+	layer := make([]byte, 0, (len(leaves)+1)*32)
+	for i := range leaves {
+		p.place(&res, 1<<depth|i, &leaves[i])
+		layer = append(layer, leaves[i].hash[:]...)
+	}
+
+	var hash [32]byte
+	for i := 0; i < depth; i++ {
+		layerLen := len(layer) / 32
+		if layerLen%2 == 1 {
+			// is odd length
+			layer = append(layer, zeroHashes[i][:]...)
+			p.capture(&res, 1<<(depth-i)|layerLen, zeroHashes[i])
+			layerLen++
+		}
+
+		for j := 0; j < layerLen/2; j++ {
+			copy(hash[:], hashFn(layer[j*64:(j+1)*64]))
+			copy(layer[j*32:], hash[:])
+
+			if gindex := 1<<(depth-i-1) | j; gindex != 1 {
+				p.capture(&res, gindex, hash)
+			}
+		}
+		layer = layer[:(layerLen/2)*32]
+	}
+
+	copy(res.hash[:], layer)
+	return res
+}
+
+func (p *Prover) commit(indx int, limit uint64) {
+	leaves := p.nodes[indx:]
+	if num := uint64(len(leaves)); num > limit {
+		p.setErr(fmt.Errorf("BUG: count '%d' higher than limit '%d'", num, limit))
+		limit = uint64(nextPowerOfTwo(num))
+	}
+	res := p.merkleize(leaves, limit)
+	p.nodes = append(p.nodes[:indx], res)
+}
+
+func (p *Prover) commitWithMixin(indx int, num, limit uint64) {
+	leaves := p.nodes[indx:]
+	if limit == 0 {
+		limit = uint64(len(leaves))
+	}
+	limit = uint64(nextPowerOfTwo(limit))
+	if count := uint64(len(leaves)); count > limit {
+		p.setErr(fmt.Errorf("BUG: count '%d' higher than limit '%d'", count, limit))
+		limit = uint64(nextPowerOfTwo(count))
+	}
+	data := p.merkleize(leaves, limit)
+
+	// mixin with the size
+	size := proverNode{}
+	MarshalUint64(size.hash[:0], num)
+
+	res := proverNode{}
+	p.place(&res, 2, &data)
+	p.place(&res, 3, &size)
+
+	copy(p.tmp[:32], data.hash[:])
+	copy(p.tmp[32:], size.hash[:])
+	copy(res.hash[:], hashFn(p.tmp))
+
+	p.nodes = append(p.nodes[:indx], res)
+}
+
+func (p *Prover) setErr(err error) {
+	if p.err == nil {
+		p.err = err
+	}
+}
+
+func (p *Prover) appendLeaf(b []byte) {
+	n := proverNode{}
+	copy(n.hash[:], b)
+	p.nodes = append(p.nodes, n)
+}
+
+func (p *Prover) appendBytesAsNodes(b []byte) {
+	// if byte list is empty, fill with zeros
+	if len(b) == 0 {
+		p.appendLeaf(zeroBytes)
+	}
+	for i := 0; i < len(b); i += 32 {
+		p.appendLeaf(b[i:min(len(b), i+32)])
+	}
+}
+
+/// --- prover implements the HashWalker interface ---
+
+func (p *Prover) Index() int {
+	return len(p.nodes)
+}
+
+func (p *Prover) Hash() []byte {
+	return p.nodes[len(p.nodes)-1].hash[:]
+}
+
+func (p *Prover) Append(i []byte) {
+	p.buf = append(p.buf, i...)
+}
+
+func (p *Prover) AppendUint64(i uint64) {
+	p.buf = MarshalUint64(p.buf, i)
+}
+
+func (p *Prover) AppendUint32(i uint32) {
+	p.buf = MarshalUint32(p.buf, i)
+}
+
+func (p *Prover) AppendUint8(i uint8) {
+	p.buf = MarshalUint8(p.buf, i)
+}
+
+func (p *Prover) AppendBytes32(b []byte) {
+	p.buf = append(p.buf, b...)
+	p.FillUpTo32()
+}
+
+func (p *Prover) FillUpTo32() {
+	// pad zero bytes to the left
+	if rest := len(p.buf) % 32; rest != 0 {
+		p.buf = append(p.buf, zeroBytes[:32-rest]...)
+	}
+}
+
+func (p *Prover) Merkleize(indx int) {
+	if len(p.buf) != 0 {
+		p.appendBytesAsNodes(p.buf)
+		p.buf = p.buf[:0]
+	}
+	p.commit(indx, uint64(nextPowerOfTwo(uint64(len(p.nodes)-indx))))
+}
+
+func (p *Prover) MerkleizeWithMixin(indx int, num, limit uint64) {
+	if len(p.buf) != 0 {
+		p.appendBytesAsNodes(p.buf)
+		p.buf = p.buf[:0]
+	}
+	p.commitWithMixin(indx, num, limit)
+}
+
+func (p *Prover) PutBitlist(bb []byte, maxSize uint64) {
+	b, size := parseBitlist(nil, bb)
+
+	indx := p.Index()
+	p.appendBytesAsNodes(b)
+	p.commitWithMixin(indx, size, (maxSize+255)/256)
+}
+
+func (p *Prover) PutBool(b bool) {
+	if b {
+		p.appendLeaf(trueBytes)
+	} else {
+		p.appendLeaf(falseBytes)
+	}
+}
+
+func (p *Prover) PutBytes(b []byte) {
+	if len(b) <= 32 {
+		p.appendLeaf(b)
+	} else {
+		indx := p.Index()
+		p.appendBytesAsNodes(b)
+		p.commit(indx, uint64(nextPowerOfTwo(uint64(len(p.nodes)-indx))))
+	}
+}
+
+func (p *Prover) PutUint16(i uint16) {
+	p.appendLeaf(MarshalUint16(p.tmp[:0], i))
+}
+
+func (p *Prover) PutUint64(i uint64) {
+	p.appendLeaf(MarshalUint64(p.tmp[:0], i))
+}
+
+func (p *Prover) PutUint8(i uint8) {
+	p.appendLeaf(MarshalUint8(p.tmp[:0], i))
+}
+
+func (p *Prover) PutUint32(i uint32) {
+	p.appendLeaf(MarshalUint32(p.tmp[:0], i))
+}
+
+func (p *Prover) PutUint64Array(b []uint64, maxCapacity ...uint64) {
+	indx := p.Index()
+	for _, i := range b {
+		p.AppendUint64(i)
+	}
+
+	// pad zero bytes to the left
+	p.FillUpTo32()
+
+	if len(maxCapacity) == 0 {
+		// Array with fixed size
+		p.Merkleize(indx)
+	} else {
+		numItems := uint64(len(b))
+		limit := CalculateLimit(maxCapacity[0], numItems, 8)
+
+		p.MerkleizeWithMixin(indx, numItems, limit)
+	}
+}
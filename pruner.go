@@ -0,0 +1,94 @@
+package ssz
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Pruner removes from a store the nodes that are not reachable from a set of
+// roots with a mark-and-sweep. The trees must be persisted with the Pruner so
+// that a tree is never written while the store is being pruned. Otherwise, a
+// concurrent Persist could skip a subtree that is removed later by the sweep.
+type Pruner struct {
+	store IterableNodeStore
+	lock  sync.RWMutex
+}
+
+// NewPruner creates a new Pruner for the store
+func NewPruner(store IterableNodeStore) *Pruner {
+	return &Pruner{store: store}
+}
+
+// Persist stores the tree in the store of the pruner. It is safe to
+// call it concurrently with other calls to Persist and with Prune.
+func (p *Pruner) Persist(n *Node) error {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return n.Persist(p.store)
+}
+
+// Prune removes all the nodes of the store that are not part of the trees with
+// the given roots and returns the number of removed nodes.
+func (p *Pruner) Prune(roots ...[]byte) (int, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	// mark
+	marked := map[string]struct{}{}
+	for _, root := range roots {
+		if err := p.mark(root, marked); err != nil {
+			return 0, err
+		}
+	}
+
+	// sweep
+	var unmarked [][]byte
+	err := p.store.ForEach(func(hash []byte) error {
+		if _, ok := marked[string(hash)]; !ok {
+			unmarked = append(unmarked, hash)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	for i, hash := range unmarked {
+		if err := p.store.Delete(hash); err != nil {
+			return i, err
+		}
+	}
+	return len(unmarked), nil
+}
+
+func (p *Pruner) mark(hash []byte, marked map[string]struct{}) error {
+	if _, ok := marked[string(hash)]; ok {
+		// the subtree is shared with a tree already marked
+		return nil
+	}
+	data, err := p.store.Get(hash)
+	if errors.Is(err, ErrNodeNotFound) {
+		// leaf, zero subtree or a root that is not stored
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	marked[string(hash)] = struct{}{}
+
+	if len(data) != 65 {
+		return fmt.Errorf("node %x: incorrect data length %d", hash, len(data))
+	}
+	if data[64]&flagLeftBranch != 0 {
+		if err := p.mark(data[:32], marked); err != nil {
+			return err
+		}
+	}
+	if data[64]&flagRightBranch != 0 {
+		if err := p.mark(data[32:64], marked); err != nil {
+			return err
+		}
+	}
+	return nil
+}
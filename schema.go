@@ -0,0 +1,362 @@
+package ssz
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Kind is the SSZ kind of a value described by a Schema.
+type Kind int
+
+const (
+	// KindUnknown describes values whose layout is not known, like objects
+	// that implement the ssz interfaces by hand.
+	KindUnknown Kind = iota
+	// KindUint is a little endian unsigned integer
+	KindUint
+	// KindBool is a boolean
+	KindBool
+	// KindVector is a fixed size sequence of elements
+	KindVector
+	// KindList is a variable size sequence of elements with a limit
+	KindList
+	// KindBitlist is a variable size sequence of bits with a limit
+	KindBitlist
+	// KindContainer is a sequence of named fields
+	KindContainer
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindUnknown:
+		return "unknown"
+	case KindUint:
+		return "uint"
+	case KindBool:
+		return "bool"
+	case KindVector:
+		return "vector"
+	case KindList:
+		return "list"
+	case KindBitlist:
+		return "bitlist"
+	case KindContainer:
+		return "container"
+	default:
+		return "kind(" + strconv.Itoa(int(k)) + ")"
+	}
+}
+
+// Schema describes the SSZ layout of a type. It is emitted by sszgen for
+// every generated object and it is used to resolve generalized indices.
+// Byte vectors and byte lists are described as vectors and lists of uint8.
+type Schema struct {
+	Kind Kind
+
+	// Size is the size in bytes of uints and bools, the number of elements
+	// of a vector and the fixed size of an unknown value (if any).
+	Size uint64
+
+	// Limit is the maximum number of elements of a list (bits for bitlists).
+	Limit uint64
+
+	// Elem is the schema of the elements of a vector or a list.
+	Elem *Schema
+
+	// Fields are the fields of a container.
+	Fields []*Field
+}
+
+// Field is a field of a container schema.
+type Field struct {
+	// Name is the Go name of the field
+	Name string
+	// Tag is the name of the field in the json tag (if any)
+	Tag string
+	// Schema is the schema of the value of the field
+	Schema *Schema
+}
+
+// SchemaProvider is the interface implemented by types that can describe their SSZ layout.
+type SchemaProvider interface {
+	SSZSchema() *Schema
+}
+
+// FixedSizer is the interface implemented by the types with a fixed SSZ size that
+// do not describe their layout, like the types that implement the ssz interfaces by hand.
+type FixedSizer interface {
+	FixedSizeSSZ() int
+}
+
+// SchemaOf returns the schema of v if it implements SchemaProvider and a schema of
+// unknown kind otherwise, with the fixed size of v if it implements FixedSizer.
+func SchemaOf(v interface{}) *Schema {
+	if p, ok := v.(SchemaProvider); ok {
+		return p.SSZSchema()
+	}
+	if f, ok := v.(FixedSizer); ok {
+		return &Schema{Kind: KindUnknown, Size: uint64(f.FixedSizeSSZ())}
+	}
+	return &Schema{Kind: KindUnknown}
+}
+
+// SchemaOfSize returns the schema of v like SchemaOf, with the given fixed size if
+// the size of v is not known. It is used by sszgen for the types implemented by hand
+// that have a size in the ssz-size tag.
+func SchemaOfSize(v interface{}, size uint64) *Schema {
+	schema := SchemaOf(v)
+	if schema.Kind == KindUnknown && schema.Size == 0 {
+		return &Schema{Kind: KindUnknown, Size: size}
+	}
+	return schema
+}
+
+// UintSchema returns the schema of an uint of the given size in bytes
+func UintSchema(size uint64) *Schema {
+	return &Schema{Kind: KindUint, Size: size}
+}
+
+// BoolSchema returns the schema of a boolean
+func BoolSchema() *Schema {
+	return &Schema{Kind: KindBool, Size: 1}
+}
+
+// BytesSchema returns the schema of a byte vector of the given size
+func BytesSchema(size uint64) *Schema {
+	return VectorSchema(UintSchema(1), size)
+}
+
+// ByteListSchema returns the schema of a byte list with the given limit
+func ByteListSchema(limit uint64) *Schema {
+	return ListSchema(UintSchema(1), limit)
+}
+
+// VectorSchema returns the schema of a vector of size elements
+func VectorSchema(elem *Schema, size uint64) *Schema {
+	return &Schema{Kind: KindVector, Elem: elem, Size: size}
+}
+
+// ListSchema returns the schema of a list with the given limit of elements
+func ListSchema(elem *Schema, limit uint64) *Schema {
+	return &Schema{Kind: KindList, Elem: elem, Limit: limit}
+}
+
+// BitlistSchema returns the schema of a bitlist with the given limit of bits
+func BitlistSchema(limit uint64) *Schema {
+	return &Schema{Kind: KindBitlist, Limit: limit}
+}
+
+// IsBasic returns true if the schema is an uint or a bool. Basic values
+// are packed together in the chunks of vectors and lists.
+func (s *Schema) IsBasic() bool {
+	return s.Kind == KindUint || s.Kind == KindBool
+}
+
+// IsFixed returns true if the values described by the schema have a fixed size.
+// Values of unknown kind have a fixed size if the schema has a size.
+func (s *Schema) IsFixed() bool {
+	switch s.Kind {
+	case KindUint, KindBool:
+		return true
+	case KindVector:
+		return s.Elem.IsFixed()
+	case KindContainer:
+		for _, f := range s.Fields {
+			if !f.Schema.IsFixed() {
+				return false
+			}
+		}
+		return true
+	case KindUnknown:
+		return s.Size != 0
+	default:
+		return false
+	}
+}
+
+// Field returns the index and the field with the given Go or json tag name
+func (s *Schema) Field(name string) (int, *Field, bool) {
+	for i, f := range s.Fields {
+		if f.Name == name || (f.Tag != "" && f.Tag == name) {
+			return i, f, true
+		}
+	}
+	return 0, nil, false
+}
+
+// chunkCount returns the number of leaves of the data tree of the schema.
+// For lists and bitlists it is the limit of the tree below the length mixin.
+func (s *Schema) chunkCount() uint64 {
+	switch s.Kind {
+	case KindContainer:
+		return uint64(len(s.Fields))
+	case KindVector:
+		if s.Elem.IsBasic() {
+			return (s.Size*s.Elem.Size + 31) / 32
+		}
+		return s.Size
+	case KindList:
+		if s.Elem.IsBasic() {
+			return (s.Limit*s.Elem.Size + 31) / 32
+		}
+		return s.Limit
+	case KindBitlist:
+		return (s.Limit + 255) / 256
+	default:
+		return 1
+	}
+}
+
+// depth returns the depth of the data tree of the schema
+func (s *Schema) depth() int {
+	return int(getDepth(s.chunkCount()))
+}
+
+// schemas are the schemas of the types looked up by GeneralizedIndex
+var schemas sync.Map
+
+// GeneralizedIndex returns the generalized index of the node described by the
+// path of obj. See Schema.GeneralizedIndex for the syntax of the path. The schema
+// is built once for each type of obj, it must not depend on the value of obj.
+func GeneralizedIndex(obj SchemaProvider, path string) (int, error) {
+	typ := reflect.TypeOf(obj)
+	schema, ok := schemas.Load(typ)
+	if !ok {
+		schema, _ = schemas.LoadOrStore(typ, obj.SSZSchema())
+	}
+	return schema.(*Schema).GeneralizedIndex(path)
+}
+
+// GeneralizedIndex returns the generalized index of the node described by the path,
+// relative to the root of the schema. The path is a dot separated list of field
+// names (either the Go name or the json tag) and [i] element accessors, like
+// "execution_payload.transactions[3]", and the empty path is the root. The "__len__"
+// element resolves to the length mixin of a list. Elements of vectors and lists of
+// basic types resolve to the chunk that packs them. It fails if the index does not
+// fit in an int, use Schema.GIndex for deep trees.
+func (s *Schema) GeneralizedIndex(path string) (int, error) {
+	g, err := s.GIndex(path)
+	if err != nil {
+		return 0, err
+	}
+	gindex, ok := g.Int()
+	if !ok {
+		return 0, fmt.Errorf("generalized index of '%s' overflows", path)
+	}
+	return gindex, nil
+}
+
+// GIndex returns the generalized index of the node described by the path. It
+// follows the same syntax as Schema.GeneralizedIndex.
+func (s *Schema) GIndex(path string) (GIndex, error) {
+	elems, err := parsePath(path)
+	if err != nil {
+		return GIndex{}, err
+	}
+
+	gindex := RootGIndex
+	cur := s
+	descend := func(depth int, pos uint64) {
+		// the subtree index of pos at the given depth
+		sub := new(big.Int).Lsh(bigOne, uint(depth))
+		sub.Or(sub, new(big.Int).SetUint64(pos))
+		gindex = gindex.Concat(GIndex{v: sub})
+	}
+
+	for _, elem := range elems {
+		if cur == nil {
+			return GIndex{}, fmt.Errorf("cannot resolve '%s' in '%s': basic value has no children", elem, path)
+		}
+
+		if elem == "__len__" {
+			if cur.Kind != KindList && cur.Kind != KindBitlist {
+				return GIndex{}, fmt.Errorf("cannot resolve '%s' in '%s': %s has no length", elem, path, cur.Kind)
+			}
+			gindex = gindex.Child(true)
+			cur = nil
+			continue
+		}
+
+		if !strings.HasPrefix(elem, "[") {
+			if cur.Kind != KindContainer {
+				return GIndex{}, fmt.Errorf("cannot resolve field '%s' in '%s': %s is not a container", elem, path, cur.Kind)
+			}
+			indx, field, ok := cur.Field(elem)
+			if !ok {
+				return GIndex{}, fmt.Errorf("field '%s' not found in '%s'", elem, path)
+			}
+			descend(cur.depth(), uint64(indx))
+			cur = field.Schema
+			continue
+		}
+
+		indx, err := strconv.ParseUint(elem[1:len(elem)-1], 10, 64)
+		if err != nil {
+			return GIndex{}, fmt.Errorf("incorrect index '%s' in '%s'", elem, path)
+		}
+
+		var pos uint64
+		switch cur.Kind {
+		case KindVector:
+			if indx >= cur.Size {
+				return GIndex{}, fmt.Errorf("index %d out of bounds in '%s': vector size is %d", indx, path, cur.Size)
+			}
+		case KindList, KindBitlist:
+			if indx >= cur.Limit {
+				return GIndex{}, fmt.Errorf("index %d out of bounds in '%s': list limit is %d", indx, path, cur.Limit)
+			}
+			// move to the data tree on the left of the length mixin
+			gindex = gindex.Child(false)
+		default:
+			return GIndex{}, fmt.Errorf("cannot resolve index '%s' in '%s': %s has no elements", elem, path, cur.Kind)
+		}
+
+		next := cur.Elem
+		switch {
+		case cur.Kind == KindBitlist:
+			pos, next = indx/256, nil
+		case cur.Elem.IsBasic():
+			pos, next = indx*cur.Elem.Size/32, nil
+		default:
+			pos = indx
+		}
+		descend(cur.depth(), pos)
+		cur = next
+	}
+	return gindex, nil
+}
+
+// parsePath splits a path like "a.b[1][2].c" into its elements "a", "b", "[1]", "[2]" and "c".
+func parsePath(path string) ([]string, error) {
+	elems := []string{}
+	if path == "" {
+		// the root of the schema
+		return elems, nil
+	}
+	for _, part := range strings.Split(path, ".") {
+		if part == "" {
+			return nil, fmt.Errorf("empty element in path '%s'", path)
+		}
+		indx := strings.Index(part, "[")
+		if indx == -1 {
+			elems = append(elems, part)
+			continue
+		}
+		if indx != 0 {
+			elems = append(elems, part[:indx])
+		}
+		for rest := part[indx:]; rest != ""; {
+			end := strings.Index(rest, "]")
+			if rest[0] != '[' || end == -1 {
+				return nil, fmt.Errorf("incorrect index in path '%s'", path)
+			}
+			elems = append(elems, rest[:end+1])
+			rest = rest[end+1:]
+		}
+	}
+	return elems, nil
+}
@@ -0,0 +1,119 @@
+package ssz
+
+import (
+	"testing"
+)
+
+func TestSchemaGeneralizedIndex(t *testing.T) {
+	point := &Schema{
+		Kind: KindContainer,
+		Fields: []*Field{
+			{Name: "X", Tag: "x", Schema: UintSchema(8)},
+			{Name: "Y", Tag: "y", Schema: UintSchema(8)},
+		},
+	}
+	s := &Schema{
+		Kind: KindContainer,
+		Fields: []*Field{
+			{Name: "A", Tag: "a", Schema: UintSchema(8)},
+			{Name: "B", Tag: "b", Schema: ListSchema(UintSchema(8), 8)},
+			{Name: "C", Tag: "c", Schema: VectorSchema(point, 4)},
+			{Name: "D", Tag: "d", Schema: BitlistSchema(512)},
+			{Name: "E", Tag: "e", Schema: BytesSchema(32)},
+		},
+	}
+
+	cases := []struct {
+		path   string
+		gindex int
+	}{
+		{"", 1},
+		{"A", 8},
+		{"a", 8},
+		{"B", 9},
+		{"B.__len__", 19},
+		{"B[0]", 36},
+		{"B[5]", 37},
+		{"C", 10},
+		{"C[2]", 42},
+		{"C[2].y", 85},
+		{"c[3].X", 86},
+		{"D", 11},
+		{"D.__len__", 23},
+		{"D[300]", 45},
+		{"E", 12},
+		{"E[31]", 12},
+	}
+	for _, c := range cases {
+		gindex, err := s.GeneralizedIndex(c.path)
+		if err != nil {
+			t.Fatalf("%s: %v", c.path, err)
+		}
+		if gindex != c.gindex {
+			t.Fatalf("%s: expected %d but found %d", c.path, c.gindex, gindex)
+		}
+	}
+
+	invalid := []string{
+		".",
+		"F",
+		"A.B",
+		"A[0]",
+		"A.__len__",
+		"C.__len__",
+		"C[4]",
+		"B[8]",
+		"B[",
+		"B[a]",
+		"C[1]..X",
+	}
+	for _, path := range invalid {
+		if _, err := s.GeneralizedIndex(path); err == nil {
+			t.Fatalf("%s: expected an error", path)
+		}
+	}
+}
+
+func TestSchemaGeneralizedIndexOverflow(t *testing.T) {
+	s := ListSchema(ListSchema(ListSchema(UintSchema(8), 1<<40), 1<<20), 1<<10)
+	if _, err := s.GeneralizedIndex("[0][0]"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GeneralizedIndex("[0][0][0]"); err == nil {
+		t.Fatal("expected overflow error")
+	}
+
+	g, err := s.GIndex("[0][0][0]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Length() != 71 {
+		t.Fatalf("expected length 71 but found %d", g.Length())
+	}
+}
+
+// countSchema counts the calls to SSZSchema
+type countSchema struct {
+	calls *int
+}
+
+func (c countSchema) SSZSchema() *Schema {
+	*c.calls++
+	return &Schema{Kind: KindContainer, Fields: []*Field{{Name: "A", Schema: UintSchema(8)}}}
+}
+
+func TestGeneralizedIndex_Cache(t *testing.T) {
+	calls := 0
+	for i := 0; i < 3; i++ {
+		gindex, err := GeneralizedIndex(countSchema{calls: &calls}, "A")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gindex != 1 {
+			t.Fatalf("expected 1 but found %d", gindex)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("the schema is built %d times", calls)
+	}
+}
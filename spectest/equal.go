@@ -1,4 +1,4 @@
-package spectests
+package spectest
 
 import (
 	"fmt"
@@ -48,6 +48,12 @@ func deepEqualImpl(v1, v2 reflect.Value, depth int) bool {
 		}
 		return deepEqualImpl(v1.Elem(), v2.Elem(), depth+1)
 
+	case reflect.Interface:
+		if v1.IsNil() || v2.IsNil() {
+			return v1.IsNil() == v2.IsNil()
+		}
+		return deepEqualImpl(v1.Elem(), v2.Elem(), depth+1)
+
 	case reflect.Struct:
 		for i, n := 0, v1.NumField(); i < n; i++ {
 			if !deepEqualImpl(v1.Field(i), v2.Field(i), depth+1) {
@@ -62,9 +68,15 @@ func deepEqualImpl(v1, v2 reflect.Value, depth int) bool {
 			panic("BUG")
 		}
 		switch v1.Kind() {
-		case reflect.Uint8, reflect.Uint64:
+		case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
 			return v1.Uint() == v2.Uint()
 
+		case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+			return v1.Int() == v2.Int()
+
+		case reflect.String:
+			return v1.String() == v2.String()
+
 		case reflect.Bool:
 			return v1.Bool() == v2.Bool()
 
@@ -74,7 +86,9 @@ func deepEqualImpl(v1, v2 reflect.Value, depth int) bool {
 	}
 }
 
-func deepEqual(x, y interface{}) bool {
+// Equal returns true if the objects are deeply equal. Unlike reflect.DeepEqual,
+// nil and empty slices are equal since they have the same ssz encoding.
+func Equal(x, y interface{}) bool {
 	if x == nil || y == nil {
 		return x == y
 	}
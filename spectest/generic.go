@@ -0,0 +1,158 @@
+package spectest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// GenericRegistry are the constructors of the objects of the ssz_generic tests by
+// handler (uints, boolean, basic_vector, bitvector, bitlist and containers) and by the
+// prefix of the names of the cases that are of the type (i.e. uint_64 or vec_uint16_4).
+// A case is checked with the type of the longest prefix that is followed by '_' in its
+// name, or the type of the empty prefix, and the other cases are skipped.
+type GenericRegistry map[string]map[string]func() Object
+
+// lookup returns the constructor of the type of the case
+func (r GenericRegistry) lookup(handler, name string) (func() Object, bool) {
+	var prefix string
+	var newObj func() Object
+	for p, fn := range r[handler] {
+		if p != "" && name != p && !strings.HasPrefix(name, p+"_") {
+			continue
+		}
+		if newObj == nil || len(p) > len(prefix) {
+			prefix, newObj = p, fn
+		}
+	}
+	return newObj, newObj != nil
+}
+
+// RunGeneric checks the ssz_generic cases of the registered types in the directory of
+// the spec tests (the 'tests' directory). The valid cases are checked like the ssz_static
+// cases with CheckCase and the encodings of the invalid cases must fail to unmarshal.
+// The results have the handler as the type and 'valid' or 'invalid' as the handler.
+func RunGeneric(dir string, registry GenericRegistry) ([]*Result, error) {
+	results := []*Result{}
+	err := walkGeneric(dir, registry, func(res *Result, newObj func() Object) {
+		res.Err = checkGenericCase(res, newObj)
+		results = append(results, res)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// RunGenericTest checks the ssz_generic cases like RunGeneric with a subtest for each case
+func RunGenericTest(t *testing.T, dir string, registry GenericRegistry) {
+	t.Helper()
+
+	err := walkGeneric(dir, registry, func(res *Result, newObj func() Object) {
+		t.Run(res.Type+"/"+res.Handler+"/"+res.Case, func(t *testing.T) {
+			if err := checkGenericCase(res, newObj); err != nil {
+				t.Fatalf("%s: %v", res.Path, err)
+			}
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func checkGenericCase(res *Result, newObj func() Object) error {
+	if res.Handler == "valid" {
+		return CheckCase(res.Path, newObj, nil)
+	}
+	return CheckInvalidCase(res.Path, newObj)
+}
+
+// CheckInvalidCase checks that the encoding of the invalid case in the directory
+// cannot be decoded with the objects of newObj.
+func CheckInvalidCase(path string, newObj func() Object) error {
+	serialized, err := readSerialized(path)
+	if err != nil {
+		return err
+	}
+	if err := newObj().UnmarshalSSZ(serialized); err == nil {
+		return fmt.Errorf("invalid encoding %x decoded without errors", serialized)
+	}
+	return nil
+}
+
+func walkGeneric(dir string, registry GenericRegistry, fn func(res *Result, newObj func() Object)) error {
+	genericDir := filepath.Join(dir, "general", string(Phase0), "ssz_generic")
+	handlers, err := readDir(genericDir)
+	if err != nil {
+		return err
+	}
+	for _, handler := range handlers {
+		if _, ok := registry[handler]; !ok {
+			continue
+		}
+		for _, kind := range []string{"valid", "invalid"} {
+			cases, err := readDir(filepath.Join(genericDir, handler, kind))
+			if os.IsNotExist(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			for _, name := range cases {
+				newObj, ok := registry.lookup(handler, name)
+				if !ok {
+					continue
+				}
+				res := &Result{
+					Fork:    Phase0,
+					Type:    handler,
+					Handler: kind,
+					Case:    name,
+					Path:    filepath.Join(genericDir, handler, kind, name),
+				}
+				fn(res, newObj)
+			}
+		}
+	}
+	return nil
+}
+
+// SingleField returns a constructor of objects that encode a value that is not a container
+// (i.e. a bitlist) with a container of the object of newObj whose only field is the value.
+// The encoding of the value is the encoding of the container without the offset of the
+// field, and the root of the container is the root of the value.
+func SingleField(newObj func() Object) func() Object {
+	return func() Object {
+		return &singleField{Object: newObj()}
+	}
+}
+
+type singleField struct {
+	Object
+}
+
+func (s *singleField) MarshalSSZ() ([]byte, error) {
+	return s.MarshalSSZTo(make([]byte, 0, s.SizeSSZ()))
+}
+
+func (s *singleField) MarshalSSZTo(dst []byte) ([]byte, error) {
+	start := len(dst)
+	res, err := s.Object.MarshalSSZTo(dst)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) < start+4 {
+		return nil, fmt.Errorf("object %T does not have a variable size field", s.Object)
+	}
+	return append(res[:start], res[start+4:]...), nil
+}
+
+func (s *singleField) SizeSSZ() int {
+	return s.Object.SizeSSZ() - 4
+}
+
+func (s *singleField) UnmarshalSSZ(buf []byte) error {
+	return s.Object.UnmarshalSSZ(append([]byte{4, 0, 0, 0}, buf...))
+}
@@ -0,0 +1,75 @@
+package spectest_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NilFoundation/fastssz/spectest"
+	"github.com/NilFoundation/fastssz/spectests/generic"
+	"github.com/golang/snappy"
+)
+
+func TestRunGeneric(t *testing.T) {
+	dir := t.TempDir()
+	handler := func(name, kind string) string {
+		return filepath.Join(dir, "general", "phase0", "ssz_generic", name, kind)
+	}
+	writeValid := func(path string, obj spectest.Object) {
+		writeCase(t, path, obj, "{}", nil)
+		if err := os.Rename(filepath.Join(path, spectest.RootsFile), filepath.Join(path, spectest.MetaFile)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeInvalid := func(path string, serialized []byte) {
+		if err := os.MkdirAll(path, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(path, spectest.SerializedFile), snappy.Encode(nil, serialized), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeValid(filepath.Join(handler("uints", "valid"), "uint_16_max"), &generic.Uint16{Value: 0xffff})
+	writeValid(filepath.Join(handler("uints", "valid"), "uint_8_zero"), &generic.Uint8{})
+	writeInvalid(filepath.Join(handler("uints", "invalid"), "uint_16_one_too_high"), []byte{0x01, 0x02, 0x03})
+	// the invalid encoding is accepted by the type of the case
+	writeInvalid(filepath.Join(handler("uints", "invalid"), "uint_8_one_byte_longer"), []byte{0x01})
+	// the cases without a registered type are skipped
+	writeInvalid(filepath.Join(handler("uints", "invalid"), "uint_512_one_byte_longer"), []byte{0x01})
+	writeValid(filepath.Join(handler("bitlist", "valid"), "bitlist_8_random_0"), newBitlist8(0x0f))
+	writeInvalid(filepath.Join(handler("bitlist", "invalid"), "bitlist_8_but_9"), []byte{0xff, 0x03})
+	writeInvalid(filepath.Join(handler("basic_vector", "invalid"), "vec_bool_0"), []byte{})
+
+	results, err := spectest.RunGeneric(dir, generic.Registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []struct {
+		name string
+		ok   bool
+	}{
+		{"phase0/bitlist/valid/bitlist_8_random_0", true},
+		{"phase0/bitlist/invalid/bitlist_8_but_9", true},
+		{"phase0/uints/valid/uint_16_max", true},
+		{"phase0/uints/valid/uint_8_zero", true},
+		{"phase0/uints/invalid/uint_16_one_too_high", true},
+		{"phase0/uints/invalid/uint_8_one_byte_longer", false},
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("expected %d results but found %d: %v", len(expected), len(results), results)
+	}
+	for indx, res := range results {
+		if res.Name() != expected[indx].name || (res.Err == nil) != expected[indx].ok {
+			t.Fatalf("unexpected result %s", res)
+		}
+	}
+}
+
+func newBitlist8(b ...byte) spectest.Object {
+	obj := spectest.SingleField(func() spectest.Object { return new(generic.Bitlist8) })()
+	if err := obj.UnmarshalSSZ(b); err != nil {
+		panic(err)
+	}
+	return obj
+}
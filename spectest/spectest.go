@@ -0,0 +1,425 @@
+// Package spectest runs the ssz_static tests of the consensus spec tests
+// (https://github.com/ethereum/consensus-spec-tests) against ssz objects.
+//
+// The objects are registered by the name of the type in the spec tests with a
+// constructor that returns the object of each fork:
+//
+//	registry := spectest.Registry{
+//		"Checkpoint": spectest.All(func() spectest.Object { return new(Checkpoint) }),
+//		"BeaconBlock": spectest.Forks(map[spectest.Fork]func() spectest.Object{
+//			spectest.Phase0:  func() spectest.Object { return new(BeaconBlock) },
+//			spectest.Capella: func() spectest.Object { return new(BeaconBlockCapella) },
+//		}),
+//	}
+//
+//	func TestSpec(t *testing.T) {
+//		spectest.RunTest(t, "../consensus-spec-tests/tests", registry, nil)
+//	}
+package spectest
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	ssz "github.com/NilFoundation/fastssz"
+	"github.com/golang/snappy"
+	"gopkg.in/yaml.v3"
+)
+
+// The files of a ssz_static test case
+const (
+	SerializedFile = "serialized.ssz_snappy"
+	ValueFile      = "value.yaml"
+	RootsFile      = "roots.yaml"
+	// MetaFile has the root of the valid ssz_generic cases
+	MetaFile = "meta.yaml"
+)
+
+// Fork is the name of a fork in the spec tests
+type Fork string
+
+// The forks of the spec tests
+const (
+	Phase0    Fork = "phase0"
+	Altair    Fork = "altair"
+	Bellatrix Fork = "bellatrix"
+	Capella   Fork = "capella"
+	Deneb     Fork = "deneb"
+	Electra   Fork = "electra"
+)
+
+// Object is the interface of the objects checked with the spec tests
+type Object interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+// Constructor returns a new object of a type for the fork or nil
+// if the type is not checked in the fork.
+type Constructor func(fork Fork) Object
+
+// Registry are the constructors of the objects by the name of the type in the spec tests
+type Registry map[string]Constructor
+
+// All returns a constructor of a type that is the same in all the forks
+func All(newObj func() Object) Constructor {
+	return func(Fork) Object {
+		return newObj()
+	}
+}
+
+// Forks returns a constructor of a type that changes between forks.
+// The type is not checked in the forks without a constructor.
+func Forks(forks map[Fork]func() Object) Constructor {
+	return func(fork Fork) Object {
+		newObj, ok := forks[fork]
+		if !ok {
+			return nil
+		}
+		return newObj()
+	}
+}
+
+// Options are the options to run the spec tests
+type Options struct {
+	// Config is the name of the config of the spec tests, 'mainnet' by default
+	Config string
+	// Forks are the forks to check, all the forks in the directory by default
+	Forks []Fork
+	// Handlers are the handlers of the cases to check (i.e. ssz_random),
+	// all of them by default
+	Handlers []string
+	// Value checks that the object decoded from value.yaml is equal
+	// to the object decoded from the ssz encoding
+	Value bool
+	// HashFns are other hash functions to check the roots with
+	HashFns []ssz.HashFn
+}
+
+func (o *Options) config() string {
+	if o == nil || o.Config == "" {
+		return "mainnet"
+	}
+	return o.Config
+}
+
+// Result is the result of a test case
+type Result struct {
+	Fork    Fork
+	Type    string
+	Handler string
+	Case    string
+	// Path is the directory of the case
+	Path string
+	// Err is the reason of the failure, nil if the case passed
+	Err error
+}
+
+// Name returns the name of the case as fork/type/handler/case
+func (r *Result) Name() string {
+	return strings.Join([]string{string(r.Fork), r.Type, r.Handler, r.Case}, "/")
+}
+
+func (r *Result) String() string {
+	if r.Err != nil {
+		return fmt.Sprintf("FAIL %s: %v", r.Name(), r.Err)
+	}
+	return fmt.Sprintf("ok   %s", r.Name())
+}
+
+// Run checks the ssz_static cases of the registered types in the directory of
+// the spec tests (the 'tests' directory) and returns the result of each case.
+// It fails if the directory of a fork cannot be read.
+func Run(dir string, registry Registry, opts *Options) ([]*Result, error) {
+	results := []*Result{}
+	err := walk(dir, registry, opts, func(res *Result, newObj func() Object) {
+		res.Err = CheckCase(res.Path, newObj, opts)
+		results = append(results, res)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// RunTest checks the ssz_static cases like Run with a subtest for each fork, type and case
+func RunTest(t *testing.T, dir string, registry Registry, opts *Options) {
+	t.Helper()
+
+	forks, err := listForks(dir, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, fork := range forks {
+		t.Run(string(fork), func(t *testing.T) {
+			forkOpts := Options{}
+			if opts != nil {
+				forkOpts = *opts
+			}
+			forkOpts.Forks = []Fork{fork}
+
+			cases := []*Result{}
+			constructors := []func() Object{}
+			err := walk(dir, registry, &forkOpts, func(res *Result, newObj func() Object) {
+				cases = append(cases, res)
+				constructors = append(constructors, newObj)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for indx, res := range cases {
+				newObj := constructors[indx]
+				t.Run(res.Type+"/"+res.Handler+"/"+res.Case, func(t *testing.T) {
+					if err := CheckCase(res.Path, newObj, opts); err != nil {
+						t.Fatalf("%s: %v", res.Path, err)
+					}
+				})
+			}
+		})
+	}
+}
+
+func listForks(dir string, opts *Options) ([]Fork, error) {
+	if opts != nil && len(opts.Forks) != 0 {
+		return opts.Forks, nil
+	}
+	names, err := readDir(filepath.Join(dir, opts.config()))
+	if err != nil {
+		return nil, err
+	}
+	forks := []Fork{}
+	for _, name := range names {
+		forks = append(forks, Fork(name))
+	}
+	return forks, nil
+}
+
+// walk calls fn with the cases of the registered types of each fork in order
+func walk(dir string, registry Registry, opts *Options, fn func(res *Result, newObj func() Object)) error {
+	forks, err := listForks(dir, opts)
+	if err != nil {
+		return err
+	}
+	for _, fork := range forks {
+		forkDir := filepath.Join(dir, opts.config(), string(fork), "ssz_static")
+		types, err := readDir(forkDir)
+		if err != nil {
+			return err
+		}
+		for _, typ := range types {
+			constructor, ok := registry[typ]
+			if !ok {
+				continue
+			}
+			fork := fork
+			newObj := func() Object {
+				return constructor(fork)
+			}
+			if newObj() == nil {
+				// the type is not checked in this fork
+				continue
+			}
+
+			handlers, err := readDir(filepath.Join(forkDir, typ))
+			if err != nil {
+				return err
+			}
+			for _, handler := range handlers {
+				if opts != nil && len(opts.Handlers) != 0 && !contains(opts.Handlers, handler) {
+					continue
+				}
+				cases, err := readDir(filepath.Join(forkDir, typ, handler))
+				if err != nil {
+					return err
+				}
+				for _, name := range cases {
+					res := &Result{
+						Fork:    fork,
+						Type:    typ,
+						Handler: handler,
+						Case:    name,
+						Path:    filepath.Join(forkDir, typ, handler, name),
+					}
+					fn(res, newObj)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// readDir returns the sorted names of the directories in the path
+func readDir(path string) ([]string, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func contains(list []string, item string) bool {
+	for _, i := range list {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
+// Case are the files of a ssz_static test case
+type Case struct {
+	// Serialized is the ssz encoding of the object
+	Serialized []byte
+	// Root is the hash tree root of the object
+	Root [32]byte
+	// Value is the content of value.yaml
+	Value []byte
+}
+
+// ReadCase reads the files of the test case in the directory. The root is read
+// from roots.yaml or, in the ssz_generic cases, from meta.yaml.
+func ReadCase(path string) (*Case, error) {
+	serialized, err := readSerialized(path)
+	if err != nil {
+		return nil, err
+	}
+	value, err := os.ReadFile(filepath.Join(path, ValueFile))
+	if err != nil {
+		return nil, err
+	}
+
+	rootsFile := RootsFile
+	raw, err := os.ReadFile(filepath.Join(path, rootsFile))
+	if os.IsNotExist(err) {
+		rootsFile = MetaFile
+		raw, err = os.ReadFile(filepath.Join(path, rootsFile))
+	}
+	if err != nil {
+		return nil, err
+	}
+	var roots map[string]string
+	if err := yaml.Unmarshal(raw, &roots); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %v", rootsFile, err)
+	}
+	root, err := hex.DecodeString(strings.TrimPrefix(roots["root"], "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the root: %v", err)
+	}
+	if len(root) != 32 {
+		return nil, fmt.Errorf("incorrect root length %d", len(root))
+	}
+
+	c := &Case{
+		Serialized: serialized,
+		Value:      value,
+	}
+	copy(c.Root[:], root)
+	return c, nil
+}
+
+func readSerialized(path string) ([]byte, error) {
+	serializedSnappy, err := os.ReadFile(filepath.Join(path, SerializedFile))
+	if err != nil {
+		return nil, err
+	}
+	serialized, err := snappy.Decode(nil, serializedSnappy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %v", SerializedFile, err)
+	}
+	return serialized, nil
+}
+
+// CheckCase checks the test case in the directory with the objects of newObj. The object
+// decoded from the ssz encoding has to be encoded to the same bytes and hashed to the
+// same root. Its tree must have the same root and, if the object implements
+// ssz.TreeDecoder, decode to the same object.
+func CheckCase(path string, newObj func() Object, opts *Options) error {
+	c, err := ReadCase(path)
+	if err != nil {
+		return err
+	}
+
+	// Unmarshal
+	obj := newObj()
+	if err := obj.UnmarshalSSZ(c.Serialized); err != nil {
+		return fmt.Errorf("failed to unmarshal: %v", err)
+	}
+
+	// Value
+	if opts != nil && opts.Value {
+		obj2 := newObj()
+		if err := ssz.UnmarshalSSZTest(c.Value, obj2); err != nil {
+			return fmt.Errorf("failed to decode %s: %v", ValueFile, err)
+		}
+		if !Equal(obj, obj2) {
+			return fmt.Errorf("the object of %s is different", ValueFile)
+		}
+	}
+
+	// Marshal
+	buf, err := obj.MarshalSSZTo(nil)
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %v", err)
+	}
+	if !bytes.Equal(buf, c.Serialized) {
+		return fmt.Errorf("bad marshal")
+	}
+
+	// Root
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		return fmt.Errorf("failed to hash: %v", err)
+	}
+	if root != c.Root {
+		return fmt.Errorf("bad root %x, expected %x", root, c.Root)
+	}
+	if opts != nil {
+		for indx, fn := range opts.HashFns {
+			hh := ssz.NewHasherWithHashFn(fn)
+			if err := obj.HashTreeRootWith(hh); err != nil {
+				return fmt.Errorf("failed to hash with hash function %d: %v", indx, err)
+			}
+			root, err := hh.HashRoot()
+			if err != nil {
+				return fmt.Errorf("failed to hash with hash function %d: %v", indx, err)
+			}
+			if root != c.Root {
+				return fmt.Errorf("bad root %x with hash function %d, expected %x", root, indx, c.Root)
+			}
+		}
+	}
+
+	// Tree
+	node, err := obj.GetTree()
+	if err != nil {
+		return fmt.Errorf("failed to build the tree: %v", err)
+	}
+	if !bytes.Equal(node.Hash(), c.Root[:]) {
+		return fmt.Errorf("bad tree root")
+	}
+	if _, ok := obj.(ssz.TreeDecoder); !ok {
+		return nil
+	}
+	obj3 := newObj()
+	if err := ssz.DecodeTree(node, obj3); err != nil {
+		return fmt.Errorf("failed to decode the tree: %v", err)
+	}
+	if !Equal(obj, obj3) {
+		return fmt.Errorf("bad decode from tree")
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package spectest_test
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NilFoundation/fastssz/spectest"
+	"github.com/NilFoundation/fastssz/spectests"
+	"github.com/golang/snappy"
+)
+
+func writeCase(t *testing.T, path string, obj spectest.Object, value string, root []byte) {
+	t.Helper()
+
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root == nil {
+		hash, err := obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		root = hash[:]
+	}
+	files := map[string][]byte{
+		spectest.SerializedFile: snappy.Encode(nil, buf),
+		spectest.ValueFile:      []byte(value),
+		spectest.RootsFile:      []byte("{root: '0x" + hex.EncodeToString(root) + "'}\n"),
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(path, name), content, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	static := func(fork, typ string) string {
+		return filepath.Join(dir, "mainnet", fork, "ssz_static", typ, "ssz_random")
+	}
+
+	checkpoint := &spectests.Checkpoint{Epoch: 1, Root: make([]byte, 32)}
+	checkpoint.Root[0] = 2
+	value := "{epoch: 1, root: '0x02" + hex.EncodeToString(make([]byte, 31)) + "'}\n"
+
+	writeCase(t, filepath.Join(static("phase0", "Checkpoint"), "case_0"), checkpoint, value, nil)
+	writeCase(t, filepath.Join(static("phase0", "Checkpoint"), "case_1"), checkpoint, value, make([]byte, 32))
+	writeCase(t, filepath.Join(static("altair", "Checkpoint"), "case_0"), checkpoint, "{epoch: 2}\n", nil)
+	// types that are not registered or not checked in the fork are skipped
+	writeCase(t, filepath.Join(static("phase0", "Fork"), "case_0"), &spectests.Fork{PreviousVersion: make([]byte, 4), CurrentVersion: make([]byte, 4)}, "{}", nil)
+	writeCase(t, filepath.Join(static("phase0", "Eth1Data"), "case_0"), &spectests.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)}, "{}", nil)
+
+	registry := spectest.Registry{
+		"Checkpoint": spectest.All(func() spectest.Object { return new(spectests.Checkpoint) }),
+		"Eth1Data": spectest.Forks(map[spectest.Fork]func() spectest.Object{
+			spectest.Altair: func() spectest.Object { return new(spectests.Eth1Data) },
+		}),
+	}
+
+	results, err := spectest.Run(dir, registry, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []struct {
+		name string
+		ok   bool
+	}{
+		{"altair/Checkpoint/ssz_random/case_0", true},
+		{"phase0/Checkpoint/ssz_random/case_0", true},
+		{"phase0/Checkpoint/ssz_random/case_1", false},
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("expected %d results but found %d", len(expected), len(results))
+	}
+	for indx, res := range results {
+		if res.Name() != expected[indx].name || (res.Err == nil) != expected[indx].ok {
+			t.Fatalf("unexpected result %s", res)
+		}
+	}
+
+	// the value of the altair case is different
+	results, err = spectest.Run(dir, registry, &spectest.Options{Forks: []spectest.Fork{spectest.Altair}, Value: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Err == nil {
+		t.Fatalf("expected a failure in the value: %v", results)
+	}
+
+	// the value of the valid phase0 case is the same
+	if err := spectest.CheckCase(filepath.Join(static("phase0", "Checkpoint"), "case_0"), func() spectest.Object { return new(spectests.Checkpoint) }, &spectest.Options{Value: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the fork does not exist
+	if _, err := spectest.Run(dir, registry, &spectest.Options{Forks: []spectest.Fork{spectest.Deneb}}); err == nil {
+		t.Fatal("expected an error for a missing fork")
+	}
+}
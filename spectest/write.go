@@ -0,0 +1,188 @@
+package spectest
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	ssz "github.com/NilFoundation/fastssz"
+	"github.com/NilFoundation/fastssz/fuzz"
+	"github.com/golang/snappy"
+	"gopkg.in/yaml.v3"
+)
+
+// WriteCase writes the object as a ssz_static test case in the directory, which is
+// created if it does not exist. The encoding is written to serialized.ssz_snappy, the
+// value to value.yaml (see MarshalValue) and the hash tree root to roots.yaml.
+func WriteCase(dir string, obj Object) error {
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %v", err)
+	}
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		return fmt.Errorf("failed to hash: %v", err)
+	}
+	value, err := MarshalValue(obj)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	files := []struct {
+		name    string
+		content []byte
+	}{
+		{SerializedFile, snappy.Encode(nil, buf)},
+		{ValueFile, value},
+		{RootsFile, []byte("{root: '0x" + hex.EncodeToString(root[:]) + "'}\n")},
+	}
+	for _, file := range files {
+		if err := os.WriteFile(filepath.Join(dir, file.name), file.content, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteRandomCases writes count objects of newObj filled with random values by the fuzzer
+// as the cases case_0, case_1... in the directory, like the ssz_random cases of the spec
+// tests. The fuzzer should be created with a seed to write the same cases again. The random
+// objects whose encoding cannot be decoded are skipped. It returns the written directories.
+func WriteRandomCases(dir string, newObj func() Object, f *fuzz.Fuzzer, count int) ([]string, error) {
+	paths := []string{}
+	for i := 0; len(paths) < count; i++ {
+		if i >= 10*count {
+			return paths, fmt.Errorf("only %d of %d random objects of %T could be encoded", len(paths), count, newObj())
+		}
+		obj := newObj()
+		if err := fill(f, obj); err != nil {
+			return paths, err
+		}
+		// the sizes are out of bounds if the fuzzer has a failure ratio, the
+		// object is written only if its encoding can be decoded
+		buf, err := obj.MarshalSSZ()
+		if err != nil {
+			continue
+		}
+		obj = newObj()
+		if err := obj.UnmarshalSSZ(buf); err != nil {
+			continue
+		}
+		path := filepath.Join(dir, "case_"+strconv.Itoa(len(paths)))
+		if err := WriteCase(path, obj); err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+func fill(f *fuzz.Fuzzer, obj Object) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to fill %T with random values: %v", obj, r)
+		}
+	}()
+	f.Fuzz(obj)
+	return nil
+}
+
+// MarshalValue encodes the object as the value.yaml file of the spec tests, which is
+// decoded with ssz.UnmarshalSSZTest. The fields are written in order with the name of
+// their json tag, the integers as numbers, the bytes and the values that are not
+// structs but implement ssz.Marshaler as quoted hex strings and the nil pointers as
+// zero values, like they are encoded.
+func MarshalValue(obj interface{}) ([]byte, error) {
+	node, err := valueNode(reflect.ValueOf(obj))
+	if err != nil {
+		return nil, err
+	}
+	out, err := yaml.Marshal(node)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+var marshalerType = reflect.TypeOf((*ssz.Marshaler)(nil)).Elem()
+
+func valueNode(v reflect.Value) (*yaml.Node, error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v = reflect.New(v.Type().Elem())
+		}
+		if v.Elem().Kind() != reflect.Struct && v.Type().Implements(marshalerType) {
+			buf, err := v.Interface().(ssz.Marshaler).MarshalSSZ()
+			if err != nil {
+				return nil, err
+			}
+			return hexNode(buf), nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return scalarNode("!!bool", strconv.FormatBool(v.Bool())), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return scalarNode("!!int", strconv.FormatUint(v.Uint(), 10)), nil
+
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			buf := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(buf), v)
+			return hexNode(buf), nil
+		}
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for i := 0; i < v.Len(); i++ {
+			elem, err := valueNode(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, elem)
+		}
+		return node, nil
+
+	case reflect.Struct:
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		typ := v.Type()
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			elem, err := valueNode(v.Field(i))
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %v", field.Name, err)
+			}
+			node.Content = append(node.Content, scalarNode("!!str", fieldName(field)), elem)
+		}
+		return node, nil
+	}
+	return nil, fmt.Errorf("type %s is not supported", v.Type())
+}
+
+// fieldName returns the name of the field in the json tag or the name of the field
+func fieldName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
+
+func scalarNode(tag, value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value}
+}
+
+func hexNode(buf []byte) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Style: yaml.SingleQuotedStyle, Value: "0x" + hex.EncodeToString(buf)}
+}
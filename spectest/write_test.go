@@ -0,0 +1,69 @@
+package spectest_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NilFoundation/fastssz/fuzz"
+	"github.com/NilFoundation/fastssz/spectest"
+	"github.com/NilFoundation/fastssz/spectests"
+)
+
+func TestWriteCase(t *testing.T) {
+	dir := t.TempDir()
+
+	checkpoint := &spectests.Checkpoint{Epoch: 18446744073709551615, Root: make([]byte, 32)}
+	checkpoint.Root[31] = 0xff
+	if err := spectest.WriteCase(dir, checkpoint); err != nil {
+		t.Fatal(err)
+	}
+	value, err := os.ReadFile(filepath.Join(dir, spectest.ValueFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "epoch: 18446744073709551615\nroot: '0x00000000000000000000000000000000000000000000000000000000000000ff'\n"
+	if string(value) != expected {
+		t.Fatalf("unexpected value:\n%s", value)
+	}
+	if err := spectest.CheckCase(dir, func() spectest.Object { return new(spectests.Checkpoint) }, &spectest.Options{Value: true}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWriteRandomCases(t *testing.T) {
+	newObjs := []func() spectest.Object{
+		func() spectest.Object { return new(spectests.Validator) },
+		func() spectest.Object { return new(spectests.Deposit) },
+		func() spectest.Object { return new(spectests.AttesterSlashing) },
+		func() spectest.Object { return new(spectests.Attestation) },
+	}
+	for _, newObj := range newObjs {
+		dir := t.TempDir()
+		paths, err := spectest.WriteRandomCases(dir, newObj, fuzz.NewWithSeed(1), 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(paths) != 3 {
+			t.Fatalf("expected 3 cases but found %d", len(paths))
+		}
+		for _, path := range paths {
+			if err := spectest.CheckCase(path, newObj, &spectest.Options{Value: true}); err != nil {
+				t.Fatalf("%T: %v", newObj(), err)
+			}
+		}
+
+		// the same seed writes the same cases
+		dir2 := t.TempDir()
+		if _, err := spectest.WriteRandomCases(dir2, newObj, fuzz.NewWithSeed(1), 3); err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range []string{spectest.SerializedFile, spectest.ValueFile, spectest.RootsFile} {
+			a, _ := os.ReadFile(filepath.Join(paths[2], name))
+			b, _ := os.ReadFile(filepath.Join(dir2, "case_2", name))
+			if string(a) != string(b) {
+				t.Fatalf("%T: %s is different with the same seed", newObj(), name)
+			}
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package spectests
+
+import (
+	"errors"
+	"testing"
+
+	ssz "github.com/NilFoundation/fastssz"
+	"github.com/NilFoundation/fastssz/fuzz"
+	"github.com/NilFoundation/fastssz/ssztest"
+)
+
+func TestDifferential_Boundary(t *testing.T) {
+	// the objects filled with random values are checked by the generated tests
+	for name, codec := range codecs {
+		if name == "BeaconState" {
+			continue
+		}
+		codec := codec
+		t.Run(name, func(t *testing.T) {
+			f := fuzz.NewWithSeed(1)
+			f.SetStrategy(fuzz.StrategyBoundary)
+			ssztest.DifferentialFuzz(t, func() ssztest.Object { return codec("phase0") }, f, ssztest.RoundTripCount)
+		})
+	}
+}
+
+// swappedCheckpoint has a schema with the fields in the wrong order
+type swappedCheckpoint struct {
+	Checkpoint
+}
+
+func (c *swappedCheckpoint) SSZSchema() *ssz.Schema {
+	schema := *c.Checkpoint.SSZSchema()
+	schema.Fields = []*ssz.Field{schema.Fields[1], schema.Fields[0]}
+	return &schema
+}
+
+// shortRoots has a schema with one state root less
+type shortRoots struct {
+	HistoricalBatch
+}
+
+func (h *shortRoots) SSZSchema() *ssz.Schema {
+	schema := *h.HistoricalBatch.SSZSchema()
+	field := *schema.Fields[1]
+	field.Schema = ssz.VectorSchema(ssz.BytesSchema(32), 8191)
+	schema.Fields = []*ssz.Field{schema.Fields[0], &field}
+	return &schema
+}
+
+// longBits has a schema with a higher limit of aggregation bits, the
+// encoding is the same but the root is different
+type longBits struct {
+	PendingAttestation
+}
+
+func (p *longBits) SSZSchema() *ssz.Schema {
+	schema := *p.PendingAttestation.SSZSchema()
+	field := *schema.Fields[0]
+	field.Schema = ssz.BitlistSchema(4096)
+	schema.Fields = append([]*ssz.Field{&field}, schema.Fields[1:]...)
+	return &schema
+}
+
+// badRoot returns a wrong root with HashTreeRoot
+type badRoot struct {
+	Checkpoint
+}
+
+func (c *badRoot) HashTreeRoot() ([32]byte, error) {
+	return [32]byte{1}, nil
+}
+
+func TestDifferential_Divergence(t *testing.T) {
+	batch := HistoricalBatch{BlockRoots: make([][32]byte, 8192), StateRoots: make([][32]byte, 8192)}
+	data := &AttestationData{
+		Source: &Checkpoint{Root: make([]byte, 32)},
+		Target: &Checkpoint{Root: make([]byte, 32)},
+	}
+
+	cases := []struct {
+		obj   ssztest.Object
+		stage string
+		field string
+	}{
+		{
+			&swappedCheckpoint{Checkpoint{Epoch: 1, Root: make([]byte, 32)}},
+			"marshal", "Root",
+		},
+		{
+			&shortRoots{batch},
+			"marshal", "StateRoots",
+		},
+		{
+			&longBits{PendingAttestation{AggregationBits: []byte{0x05}, Data: data}},
+			"hash", "AggregationBits",
+		},
+		{
+			&badRoot{Checkpoint{Epoch: 1, Root: make([]byte, 32)}},
+			"hash", "",
+		},
+	}
+	for _, c := range cases {
+		err := ssztest.CheckDifferential(c.obj)
+		var d *ssztest.Divergence
+		if !errors.As(err, &d) {
+			t.Fatalf("%T: expected a divergence but got %v", c.obj, err)
+		}
+		if d.Stage != c.stage || d.Field != c.field {
+			t.Fatalf("%T: bad divergence: %v", c.obj, d)
+		}
+	}
+}
+
+func TestDifferential_Invalid(t *testing.T) {
+	// the bitlist without the length bit is rejected by both
+	obj := &PendingAttestation{
+		AggregationBits: []byte{0x00},
+		Data: &AttestationData{
+			Source: &Checkpoint{Root: make([]byte, 32)},
+			Target: &Checkpoint{Root: make([]byte, 32)},
+		},
+	}
+	if err := ssztest.CheckDifferential(obj); !errors.Is(err, ssztest.ErrInvalidObject) {
+		t.Fatalf("expected an invalid object but got %v", err)
+	}
+}
+
+func TestDifferential_Shrink(t *testing.T) {
+	// the bitlists of the boundary strategy have the length bit
+	f := fuzz.NewWithSeed(1)
+	f.SetStrategy(fuzz.StrategyBoundary)
+	obj := new(longBits)
+	f.Fuzz(obj)
+
+	diverges := func(obj interface{}) bool {
+		var d *ssztest.Divergence
+		return errors.As(ssztest.CheckDifferential(obj.(ssztest.Object)), &d) && d.Stage == "hash"
+	}
+	if err := fuzz.Shrink(obj, diverges); err != nil {
+		t.Fatal(err)
+	}
+	src, err := fuzz.GoLiteral(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the roots of the checkpoints cannot be dropped since the object is not
+	// encoded, the bitlist only has the length bit
+	expected := `&spectests.longBits{
+	PendingAttestation: spectests.PendingAttestation{
+		AggregationBits: []byte{0x01},
+		Data: &spectests.AttestationData{
+			Source: &spectests.Checkpoint{
+				Root: make([]byte, 32),
+			},
+			Target: &spectests.Checkpoint{
+				Root: make([]byte, 32),
+			},
+		},
+	},
+}`
+	if src != expected {
+		t.Fatalf("bad reproducer:\n%s", src)
+	}
+}
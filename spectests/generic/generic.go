@@ -0,0 +1,438 @@
+// Package generic contains the types of the ssz_generic spec tests
+// (general/phase0/ssz_generic), which cover the basic ssz types and the
+// invalid encodings that a canonical decoder must reject.
+//
+// The values that are not containers are the single field of a container, which
+// has the same encoding and root if the value has a fixed size. Bitlists are
+// checked with spectest.SingleField since they have a variable size.
+package generic
+
+// Uint8 is a uint8 value (uint_8)
+type Uint8 struct {
+	Value uint8
+}
+
+// Uint16 is a uint16 value (uint_16)
+type Uint16 struct {
+	Value uint16
+}
+
+// Uint32 is a uint32 value (uint_32)
+type Uint32 struct {
+	Value uint32
+}
+
+// Uint64 is a uint64 value (uint_64)
+type Uint64 struct {
+	Value uint64
+}
+
+// Uint128 is a little endian uint128 value (uint_128)
+type Uint128 struct {
+	Value [16]byte
+}
+
+// Uint256 is a little endian uint256 value (uint_256)
+type Uint256 struct {
+	Value [32]byte
+}
+
+// Boolean is a boolean value (boolean)
+type Boolean struct {
+	Value bool
+}
+
+// VecUint8x1 is a vector of 1 uint8 values (vec_uint8_1)
+type VecUint8x1 struct {
+	Value [1]byte
+}
+
+// VecUint8x2 is a vector of 2 uint8 values (vec_uint8_2)
+type VecUint8x2 struct {
+	Value [2]byte
+}
+
+// VecUint8x3 is a vector of 3 uint8 values (vec_uint8_3)
+type VecUint8x3 struct {
+	Value [3]byte
+}
+
+// VecUint8x4 is a vector of 4 uint8 values (vec_uint8_4)
+type VecUint8x4 struct {
+	Value [4]byte
+}
+
+// VecUint8x5 is a vector of 5 uint8 values (vec_uint8_5)
+type VecUint8x5 struct {
+	Value [5]byte
+}
+
+// VecUint8x8 is a vector of 8 uint8 values (vec_uint8_8)
+type VecUint8x8 struct {
+	Value [8]byte
+}
+
+// VecUint8x16 is a vector of 16 uint8 values (vec_uint8_16)
+type VecUint8x16 struct {
+	Value [16]byte
+}
+
+// VecUint8x31 is a vector of 31 uint8 values (vec_uint8_31)
+type VecUint8x31 struct {
+	Value [31]byte
+}
+
+// VecUint8x512 is a vector of 512 uint8 values (vec_uint8_512)
+type VecUint8x512 struct {
+	Value [512]byte
+}
+
+// VecUint8x513 is a vector of 513 uint8 values (vec_uint8_513)
+type VecUint8x513 struct {
+	Value [513]byte
+}
+
+// VecUint16x1 is a vector of 1 uint16 values (vec_uint16_1)
+type VecUint16x1 struct {
+	Value [1]uint16
+}
+
+// VecUint16x2 is a vector of 2 uint16 values (vec_uint16_2)
+type VecUint16x2 struct {
+	Value [2]uint16
+}
+
+// VecUint16x3 is a vector of 3 uint16 values (vec_uint16_3)
+type VecUint16x3 struct {
+	Value [3]uint16
+}
+
+// VecUint16x4 is a vector of 4 uint16 values (vec_uint16_4)
+type VecUint16x4 struct {
+	Value [4]uint16
+}
+
+// VecUint16x5 is a vector of 5 uint16 values (vec_uint16_5)
+type VecUint16x5 struct {
+	Value [5]uint16
+}
+
+// VecUint16x8 is a vector of 8 uint16 values (vec_uint16_8)
+type VecUint16x8 struct {
+	Value [8]uint16
+}
+
+// VecUint16x16 is a vector of 16 uint16 values (vec_uint16_16)
+type VecUint16x16 struct {
+	Value [16]uint16
+}
+
+// VecUint16x31 is a vector of 31 uint16 values (vec_uint16_31)
+type VecUint16x31 struct {
+	Value [31]uint16
+}
+
+// VecUint16x512 is a vector of 512 uint16 values (vec_uint16_512)
+type VecUint16x512 struct {
+	Value [512]uint16
+}
+
+// VecUint16x513 is a vector of 513 uint16 values (vec_uint16_513)
+type VecUint16x513 struct {
+	Value [513]uint16
+}
+
+// VecUint32x1 is a vector of 1 uint32 values (vec_uint32_1)
+type VecUint32x1 struct {
+	Value [1]uint32
+}
+
+// VecUint32x2 is a vector of 2 uint32 values (vec_uint32_2)
+type VecUint32x2 struct {
+	Value [2]uint32
+}
+
+// VecUint32x3 is a vector of 3 uint32 values (vec_uint32_3)
+type VecUint32x3 struct {
+	Value [3]uint32
+}
+
+// VecUint32x4 is a vector of 4 uint32 values (vec_uint32_4)
+type VecUint32x4 struct {
+	Value [4]uint32
+}
+
+// VecUint32x5 is a vector of 5 uint32 values (vec_uint32_5)
+type VecUint32x5 struct {
+	Value [5]uint32
+}
+
+// VecUint32x8 is a vector of 8 uint32 values (vec_uint32_8)
+type VecUint32x8 struct {
+	Value [8]uint32
+}
+
+// VecUint32x16 is a vector of 16 uint32 values (vec_uint32_16)
+type VecUint32x16 struct {
+	Value [16]uint32
+}
+
+// VecUint32x31 is a vector of 31 uint32 values (vec_uint32_31)
+type VecUint32x31 struct {
+	Value [31]uint32
+}
+
+// VecUint32x512 is a vector of 512 uint32 values (vec_uint32_512)
+type VecUint32x512 struct {
+	Value [512]uint32
+}
+
+// VecUint32x513 is a vector of 513 uint32 values (vec_uint32_513)
+type VecUint32x513 struct {
+	Value [513]uint32
+}
+
+// VecUint64x1 is a vector of 1 uint64 values (vec_uint64_1)
+type VecUint64x1 struct {
+	Value [1]uint64
+}
+
+// VecUint64x2 is a vector of 2 uint64 values (vec_uint64_2)
+type VecUint64x2 struct {
+	Value [2]uint64
+}
+
+// VecUint64x3 is a vector of 3 uint64 values (vec_uint64_3)
+type VecUint64x3 struct {
+	Value [3]uint64
+}
+
+// VecUint64x4 is a vector of 4 uint64 values (vec_uint64_4)
+type VecUint64x4 struct {
+	Value [4]uint64
+}
+
+// VecUint64x5 is a vector of 5 uint64 values (vec_uint64_5)
+type VecUint64x5 struct {
+	Value [5]uint64
+}
+
+// VecUint64x8 is a vector of 8 uint64 values (vec_uint64_8)
+type VecUint64x8 struct {
+	Value [8]uint64
+}
+
+// VecUint64x16 is a vector of 16 uint64 values (vec_uint64_16)
+type VecUint64x16 struct {
+	Value [16]uint64
+}
+
+// VecUint64x31 is a vector of 31 uint64 values (vec_uint64_31)
+type VecUint64x31 struct {
+	Value [31]uint64
+}
+
+// VecUint64x512 is a vector of 512 uint64 values (vec_uint64_512)
+type VecUint64x512 struct {
+	Value [512]uint64
+}
+
+// VecUint64x513 is a vector of 513 uint64 values (vec_uint64_513)
+type VecUint64x513 struct {
+	Value [513]uint64
+}
+
+// VecUint256x1 is a vector of 1 uint256 values (vec_uint256_1)
+type VecUint256x1 struct {
+	Value [1][32]byte
+}
+
+// VecUint256x2 is a vector of 2 uint256 values (vec_uint256_2)
+type VecUint256x2 struct {
+	Value [2][32]byte
+}
+
+// VecUint256x3 is a vector of 3 uint256 values (vec_uint256_3)
+type VecUint256x3 struct {
+	Value [3][32]byte
+}
+
+// VecUint256x4 is a vector of 4 uint256 values (vec_uint256_4)
+type VecUint256x4 struct {
+	Value [4][32]byte
+}
+
+// VecUint256x5 is a vector of 5 uint256 values (vec_uint256_5)
+type VecUint256x5 struct {
+	Value [5][32]byte
+}
+
+// VecUint256x8 is a vector of 8 uint256 values (vec_uint256_8)
+type VecUint256x8 struct {
+	Value [8][32]byte
+}
+
+// VecUint256x16 is a vector of 16 uint256 values (vec_uint256_16)
+type VecUint256x16 struct {
+	Value [16][32]byte
+}
+
+// VecUint256x31 is a vector of 31 uint256 values (vec_uint256_31)
+type VecUint256x31 struct {
+	Value [31][32]byte
+}
+
+// VecUint256x512 is a vector of 512 uint256 values (vec_uint256_512)
+type VecUint256x512 struct {
+	Value [512][32]byte
+}
+
+// VecUint256x513 is a vector of 513 uint256 values (vec_uint256_513)
+type VecUint256x513 struct {
+	Value [513][32]byte
+}
+
+// Bitvector1 is a bitvector of 1 bits (bitvec_1)
+type Bitvector1 struct {
+	Value []byte `ssz:"bitvector" ssz-size:"1"`
+}
+
+// Bitvector2 is a bitvector of 2 bits (bitvec_2)
+type Bitvector2 struct {
+	Value []byte `ssz:"bitvector" ssz-size:"2"`
+}
+
+// Bitvector3 is a bitvector of 3 bits (bitvec_3)
+type Bitvector3 struct {
+	Value []byte `ssz:"bitvector" ssz-size:"3"`
+}
+
+// Bitvector4 is a bitvector of 4 bits (bitvec_4)
+type Bitvector4 struct {
+	Value []byte `ssz:"bitvector" ssz-size:"4"`
+}
+
+// Bitvector5 is a bitvector of 5 bits (bitvec_5)
+type Bitvector5 struct {
+	Value []byte `ssz:"bitvector" ssz-size:"5"`
+}
+
+// Bitvector8 is a bitvector of 8 bits (bitvec_8)
+type Bitvector8 struct {
+	Value []byte `ssz:"bitvector" ssz-size:"8"`
+}
+
+// Bitvector16 is a bitvector of 16 bits (bitvec_16)
+type Bitvector16 struct {
+	Value []byte `ssz:"bitvector" ssz-size:"16"`
+}
+
+// Bitvector31 is a bitvector of 31 bits (bitvec_31)
+type Bitvector31 struct {
+	Value []byte `ssz:"bitvector" ssz-size:"31"`
+}
+
+// Bitvector512 is a bitvector of 512 bits (bitvec_512)
+type Bitvector512 struct {
+	Value []byte `ssz:"bitvector" ssz-size:"512"`
+}
+
+// Bitvector513 is a bitvector of 513 bits (bitvec_513)
+type Bitvector513 struct {
+	Value []byte `ssz:"bitvector" ssz-size:"513"`
+}
+
+// Bitlist1 is a bitlist of up to 1 bits (bitlist_1)
+type Bitlist1 struct {
+	Value []byte `ssz:"bitlist" ssz-max:"1"`
+}
+
+// Bitlist2 is a bitlist of up to 2 bits (bitlist_2)
+type Bitlist2 struct {
+	Value []byte `ssz:"bitlist" ssz-max:"2"`
+}
+
+// Bitlist3 is a bitlist of up to 3 bits (bitlist_3)
+type Bitlist3 struct {
+	Value []byte `ssz:"bitlist" ssz-max:"3"`
+}
+
+// Bitlist4 is a bitlist of up to 4 bits (bitlist_4)
+type Bitlist4 struct {
+	Value []byte `ssz:"bitlist" ssz-max:"4"`
+}
+
+// Bitlist5 is a bitlist of up to 5 bits (bitlist_5)
+type Bitlist5 struct {
+	Value []byte `ssz:"bitlist" ssz-max:"5"`
+}
+
+// Bitlist8 is a bitlist of up to 8 bits (bitlist_8)
+type Bitlist8 struct {
+	Value []byte `ssz:"bitlist" ssz-max:"8"`
+}
+
+// Bitlist16 is a bitlist of up to 16 bits (bitlist_16)
+type Bitlist16 struct {
+	Value []byte `ssz:"bitlist" ssz-max:"16"`
+}
+
+// Bitlist31 is a bitlist of up to 31 bits (bitlist_31)
+type Bitlist31 struct {
+	Value []byte `ssz:"bitlist" ssz-max:"31"`
+}
+
+// Bitlist512 is a bitlist of up to 512 bits (bitlist_512)
+type Bitlist512 struct {
+	Value []byte `ssz:"bitlist" ssz-max:"512"`
+}
+
+// Bitlist513 is a bitlist of up to 513 bits (bitlist_513)
+type Bitlist513 struct {
+	Value []byte `ssz:"bitlist" ssz-max:"513"`
+}
+
+// SingleFieldTestStruct is a container of the containers tests
+type SingleFieldTestStruct struct {
+	A uint8
+}
+
+// SmallTestStruct is a container of the containers tests
+type SmallTestStruct struct {
+	A uint16
+	B uint16
+}
+
+// FixedTestStruct is a container of the containers tests
+type FixedTestStruct struct {
+	A uint8
+	B uint64
+	C uint32
+}
+
+// VarTestStruct is a container of the containers tests
+type VarTestStruct struct {
+	A uint16
+	B []uint16 `ssz-max:"1024"`
+	C uint8
+}
+
+// ComplexTestStruct is a container of the containers tests
+type ComplexTestStruct struct {
+	A uint16
+	B []uint16 `ssz-max:"128"`
+	C uint8
+	D []byte `ssz-max:"256"`
+	E *VarTestStruct
+	F [4]*FixedTestStruct
+	G [2]*VarTestStruct
+}
+
+// BitsStruct is a container of the containers tests
+type BitsStruct struct {
+	A []byte `ssz:"bitlist" ssz-max:"5"`
+	B []byte `ssz:"bitvector" ssz-size:"2"`
+	C []byte `ssz:"bitvector" ssz-size:"1"`
+	D []byte `ssz:"bitlist" ssz-max:"6"`
+	E []byte `ssz:"bitvector" ssz-size:"8"`
+}
@@ -0,0 +1,9321 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 7189b81d3ebecf4f6d166a39efba75d7836cd114347ebe67040fd42d5b4663ee
+// Version: 0.1.3
+package generic
+
+import (
+	ssz "github.com/NilFoundation/fastssz"
+)
+
+// MarshalSSZ ssz marshals the Uint8 object
+func (u *Uint8) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(u)
+}
+
+// MarshalSSZTo ssz marshals the Uint8 object to a target array
+func (u *Uint8) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	dst = ssz.MarshalUint8(dst, u.Value)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Uint8 object
+func (u *Uint8) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 1 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	u.Value = ssz.UnmarshallUint8(buf[0:1])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Uint8 object
+func (u *Uint8) SizeSSZ() (size int) {
+	size = 1
+	return
+}
+
+// HashTreeRoot ssz hashes the Uint8 object
+func (u *Uint8) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(u)
+}
+
+// HashTreeRootWith ssz hashes the Uint8 object with a hasher
+func (u *Uint8) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	hh.PutUint8(u.Value)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Uint8 object
+func (u *Uint8) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(u)
+}
+
+// SSZSchema returns the ssz schema of the Uint8 object
+func (u *Uint8) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.UintSchema(1)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Uint8 object
+const (
+	Uint8_ValueGIndex = 1
+)
+
+// FromTree decodes the Uint8 object from its tree
+func (u *Uint8) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 1)
+		if err != nil {
+			return err
+		}
+		u.Value = ssz.UnmarshallUint8(buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Uint16 object
+func (u *Uint16) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(u)
+}
+
+// MarshalSSZTo ssz marshals the Uint16 object to a target array
+func (u *Uint16) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	dst = ssz.MarshalUint16(dst, u.Value)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Uint16 object
+func (u *Uint16) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 2 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	u.Value = ssz.UnmarshallUint16(buf[0:2])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Uint16 object
+func (u *Uint16) SizeSSZ() (size int) {
+	size = 2
+	return
+}
+
+// HashTreeRoot ssz hashes the Uint16 object
+func (u *Uint16) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(u)
+}
+
+// HashTreeRootWith ssz hashes the Uint16 object with a hasher
+func (u *Uint16) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	hh.PutUint16(u.Value)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Uint16 object
+func (u *Uint16) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(u)
+}
+
+// SSZSchema returns the ssz schema of the Uint16 object
+func (u *Uint16) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.UintSchema(2)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Uint16 object
+const (
+	Uint16_ValueGIndex = 1
+)
+
+// FromTree decodes the Uint16 object from its tree
+func (u *Uint16) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 2)
+		if err != nil {
+			return err
+		}
+		u.Value = ssz.UnmarshallUint16(buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Uint32 object
+func (u *Uint32) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(u)
+}
+
+// MarshalSSZTo ssz marshals the Uint32 object to a target array
+func (u *Uint32) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	dst = ssz.MarshalUint32(dst, u.Value)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Uint32 object
+func (u *Uint32) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 4 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	u.Value = ssz.UnmarshallUint32(buf[0:4])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Uint32 object
+func (u *Uint32) SizeSSZ() (size int) {
+	size = 4
+	return
+}
+
+// HashTreeRoot ssz hashes the Uint32 object
+func (u *Uint32) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(u)
+}
+
+// HashTreeRootWith ssz hashes the Uint32 object with a hasher
+func (u *Uint32) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	hh.PutUint32(u.Value)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Uint32 object
+func (u *Uint32) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(u)
+}
+
+// SSZSchema returns the ssz schema of the Uint32 object
+func (u *Uint32) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.UintSchema(4)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Uint32 object
+const (
+	Uint32_ValueGIndex = 1
+)
+
+// FromTree decodes the Uint32 object from its tree
+func (u *Uint32) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 4)
+		if err != nil {
+			return err
+		}
+		u.Value = ssz.UnmarshallUint32(buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Uint64 object
+func (u *Uint64) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(u)
+}
+
+// MarshalSSZTo ssz marshals the Uint64 object to a target array
+func (u *Uint64) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	dst = ssz.MarshalUint64(dst, u.Value)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Uint64 object
+func (u *Uint64) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 8 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	u.Value = ssz.UnmarshallUint64(buf[0:8])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Uint64 object
+func (u *Uint64) SizeSSZ() (size int) {
+	size = 8
+	return
+}
+
+// HashTreeRoot ssz hashes the Uint64 object
+func (u *Uint64) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(u)
+}
+
+// HashTreeRootWith ssz hashes the Uint64 object with a hasher
+func (u *Uint64) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	hh.PutUint64(u.Value)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Uint64 object
+func (u *Uint64) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(u)
+}
+
+// SSZSchema returns the ssz schema of the Uint64 object
+func (u *Uint64) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.UintSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Uint64 object
+const (
+	Uint64_ValueGIndex = 1
+)
+
+// FromTree decodes the Uint64 object from its tree
+func (u *Uint64) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		u.Value = ssz.UnmarshallUint64(buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Uint128 object
+func (u *Uint128) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(u)
+}
+
+// MarshalSSZTo ssz marshals the Uint128 object to a target array
+func (u *Uint128) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	dst = append(dst, u.Value[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Uint128 object
+func (u *Uint128) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	copy(u.Value[:], buf[0:16])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Uint128 object
+func (u *Uint128) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// HashTreeRoot ssz hashes the Uint128 object
+func (u *Uint128) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(u)
+}
+
+// HashTreeRootWith ssz hashes the Uint128 object with a hasher
+func (u *Uint128) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	hh.PutBytes(u.Value[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Uint128 object
+func (u *Uint128) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(u)
+}
+
+// SSZSchema returns the ssz schema of the Uint128 object
+func (u *Uint128) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(16)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Uint128 object
+const (
+	Uint128_ValueGIndex = 1
+)
+
+// FromTree decodes the Uint128 object from its tree
+func (u *Uint128) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 16)
+		if err != nil {
+			return err
+		}
+		copy(u.Value[:], buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Uint256 object
+func (u *Uint256) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(u)
+}
+
+// MarshalSSZTo ssz marshals the Uint256 object to a target array
+func (u *Uint256) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	dst = append(dst, u.Value[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Uint256 object
+func (u *Uint256) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 32 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	copy(u.Value[:], buf[0:32])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Uint256 object
+func (u *Uint256) SizeSSZ() (size int) {
+	size = 32
+	return
+}
+
+// HashTreeRoot ssz hashes the Uint256 object
+func (u *Uint256) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(u)
+}
+
+// HashTreeRootWith ssz hashes the Uint256 object with a hasher
+func (u *Uint256) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	hh.PutBytes(u.Value[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Uint256 object
+func (u *Uint256) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(u)
+}
+
+// SSZSchema returns the ssz schema of the Uint256 object
+func (u *Uint256) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Uint256 object
+const (
+	Uint256_ValueGIndex = 1
+)
+
+// FromTree decodes the Uint256 object from its tree
+func (u *Uint256) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+		copy(u.Value[:], buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Boolean object
+func (b *Boolean) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Boolean object to a target array
+func (b *Boolean) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	dst = ssz.MarshalBool(dst, b.Value)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Boolean object
+func (b *Boolean) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 1 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	if err = ssz.ValidateBool(buf[0:1]); err != nil {
+		return err
+	}
+	b.Value = ssz.UnmarshalBool(buf[0:1])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Boolean object
+func (b *Boolean) SizeSSZ() (size int) {
+	size = 1
+	return
+}
+
+// HashTreeRoot ssz hashes the Boolean object
+func (b *Boolean) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Boolean object with a hasher
+func (b *Boolean) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	hh.PutBool(b.Value)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Boolean object
+func (b *Boolean) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Boolean object
+func (b *Boolean) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BoolSchema()},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Boolean object
+const (
+	Boolean_ValueGIndex = 1
+)
+
+// FromTree decodes the Boolean object from its tree
+func (b *Boolean) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 1)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBool(buf); err != nil {
+			return err
+		}
+		b.Value = ssz.UnmarshalBool(buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint8x1 object
+func (v *VecUint8x1) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint8x1 object to a target array
+func (v *VecUint8x1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	dst = append(dst, v.Value[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint8x1 object
+func (v *VecUint8x1) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 1 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	copy(v.Value[:], buf[0:1])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint8x1 object
+func (v *VecUint8x1) SizeSSZ() (size int) {
+	size = 1
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint8x1 object
+func (v *VecUint8x1) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint8x1 object with a hasher
+func (v *VecUint8x1) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	hh.PutBytes(v.Value[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint8x1 object
+func (v *VecUint8x1) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint8x1 object
+func (v *VecUint8x1) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(1)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint8x1 object
+const (
+	VecUint8x1_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint8x1 object from its tree
+func (v *VecUint8x1) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 1)
+		if err != nil {
+			return err
+		}
+		copy(v.Value[:], buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint8x2 object
+func (v *VecUint8x2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint8x2 object to a target array
+func (v *VecUint8x2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	dst = append(dst, v.Value[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint8x2 object
+func (v *VecUint8x2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 2 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	copy(v.Value[:], buf[0:2])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint8x2 object
+func (v *VecUint8x2) SizeSSZ() (size int) {
+	size = 2
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint8x2 object
+func (v *VecUint8x2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint8x2 object with a hasher
+func (v *VecUint8x2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	hh.PutBytes(v.Value[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint8x2 object
+func (v *VecUint8x2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint8x2 object
+func (v *VecUint8x2) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(2)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint8x2 object
+const (
+	VecUint8x2_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint8x2 object from its tree
+func (v *VecUint8x2) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 2)
+		if err != nil {
+			return err
+		}
+		copy(v.Value[:], buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint8x3 object
+func (v *VecUint8x3) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint8x3 object to a target array
+func (v *VecUint8x3) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	dst = append(dst, v.Value[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint8x3 object
+func (v *VecUint8x3) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 3 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	copy(v.Value[:], buf[0:3])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint8x3 object
+func (v *VecUint8x3) SizeSSZ() (size int) {
+	size = 3
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint8x3 object
+func (v *VecUint8x3) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint8x3 object with a hasher
+func (v *VecUint8x3) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	hh.PutBytes(v.Value[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint8x3 object
+func (v *VecUint8x3) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint8x3 object
+func (v *VecUint8x3) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(3)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint8x3 object
+const (
+	VecUint8x3_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint8x3 object from its tree
+func (v *VecUint8x3) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 3)
+		if err != nil {
+			return err
+		}
+		copy(v.Value[:], buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint8x4 object
+func (v *VecUint8x4) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint8x4 object to a target array
+func (v *VecUint8x4) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	dst = append(dst, v.Value[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint8x4 object
+func (v *VecUint8x4) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 4 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	copy(v.Value[:], buf[0:4])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint8x4 object
+func (v *VecUint8x4) SizeSSZ() (size int) {
+	size = 4
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint8x4 object
+func (v *VecUint8x4) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint8x4 object with a hasher
+func (v *VecUint8x4) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	hh.PutBytes(v.Value[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint8x4 object
+func (v *VecUint8x4) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint8x4 object
+func (v *VecUint8x4) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(4)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint8x4 object
+const (
+	VecUint8x4_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint8x4 object from its tree
+func (v *VecUint8x4) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 4)
+		if err != nil {
+			return err
+		}
+		copy(v.Value[:], buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint8x5 object
+func (v *VecUint8x5) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint8x5 object to a target array
+func (v *VecUint8x5) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	dst = append(dst, v.Value[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint8x5 object
+func (v *VecUint8x5) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 5 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	copy(v.Value[:], buf[0:5])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint8x5 object
+func (v *VecUint8x5) SizeSSZ() (size int) {
+	size = 5
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint8x5 object
+func (v *VecUint8x5) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint8x5 object with a hasher
+func (v *VecUint8x5) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	hh.PutBytes(v.Value[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint8x5 object
+func (v *VecUint8x5) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint8x5 object
+func (v *VecUint8x5) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(5)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint8x5 object
+const (
+	VecUint8x5_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint8x5 object from its tree
+func (v *VecUint8x5) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 5)
+		if err != nil {
+			return err
+		}
+		copy(v.Value[:], buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint8x8 object
+func (v *VecUint8x8) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint8x8 object to a target array
+func (v *VecUint8x8) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	dst = append(dst, v.Value[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint8x8 object
+func (v *VecUint8x8) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 8 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	copy(v.Value[:], buf[0:8])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint8x8 object
+func (v *VecUint8x8) SizeSSZ() (size int) {
+	size = 8
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint8x8 object
+func (v *VecUint8x8) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint8x8 object with a hasher
+func (v *VecUint8x8) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	hh.PutBytes(v.Value[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint8x8 object
+func (v *VecUint8x8) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint8x8 object
+func (v *VecUint8x8) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint8x8 object
+const (
+	VecUint8x8_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint8x8 object from its tree
+func (v *VecUint8x8) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		copy(v.Value[:], buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint8x16 object
+func (v *VecUint8x16) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint8x16 object to a target array
+func (v *VecUint8x16) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	dst = append(dst, v.Value[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint8x16 object
+func (v *VecUint8x16) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	copy(v.Value[:], buf[0:16])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint8x16 object
+func (v *VecUint8x16) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint8x16 object
+func (v *VecUint8x16) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint8x16 object with a hasher
+func (v *VecUint8x16) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	hh.PutBytes(v.Value[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint8x16 object
+func (v *VecUint8x16) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint8x16 object
+func (v *VecUint8x16) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(16)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint8x16 object
+const (
+	VecUint8x16_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint8x16 object from its tree
+func (v *VecUint8x16) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 16)
+		if err != nil {
+			return err
+		}
+		copy(v.Value[:], buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint8x31 object
+func (v *VecUint8x31) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint8x31 object to a target array
+func (v *VecUint8x31) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	dst = append(dst, v.Value[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint8x31 object
+func (v *VecUint8x31) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 31 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	copy(v.Value[:], buf[0:31])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint8x31 object
+func (v *VecUint8x31) SizeSSZ() (size int) {
+	size = 31
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint8x31 object
+func (v *VecUint8x31) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint8x31 object with a hasher
+func (v *VecUint8x31) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	hh.PutBytes(v.Value[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint8x31 object
+func (v *VecUint8x31) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint8x31 object
+func (v *VecUint8x31) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(31)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint8x31 object
+const (
+	VecUint8x31_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint8x31 object from its tree
+func (v *VecUint8x31) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 31)
+		if err != nil {
+			return err
+		}
+		copy(v.Value[:], buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint8x512 object
+func (v *VecUint8x512) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint8x512 object to a target array
+func (v *VecUint8x512) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	dst = append(dst, v.Value[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint8x512 object
+func (v *VecUint8x512) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 512 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	copy(v.Value[:], buf[0:512])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint8x512 object
+func (v *VecUint8x512) SizeSSZ() (size int) {
+	size = 512
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint8x512 object
+func (v *VecUint8x512) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint8x512 object with a hasher
+func (v *VecUint8x512) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	hh.PutBytes(v.Value[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint8x512 object
+func (v *VecUint8x512) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint8x512 object
+func (v *VecUint8x512) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(512)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint8x512 object
+const (
+	VecUint8x512_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint8x512 object from its tree
+func (v *VecUint8x512) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 512)
+		if err != nil {
+			return err
+		}
+		copy(v.Value[:], buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint8x513 object
+func (v *VecUint8x513) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint8x513 object to a target array
+func (v *VecUint8x513) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	dst = append(dst, v.Value[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint8x513 object
+func (v *VecUint8x513) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 513 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	copy(v.Value[:], buf[0:513])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint8x513 object
+func (v *VecUint8x513) SizeSSZ() (size int) {
+	size = 513
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint8x513 object
+func (v *VecUint8x513) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint8x513 object with a hasher
+func (v *VecUint8x513) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	hh.PutBytes(v.Value[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint8x513 object
+func (v *VecUint8x513) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint8x513 object
+func (v *VecUint8x513) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(513)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint8x513 object
+const (
+	VecUint8x513_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint8x513 object from its tree
+func (v *VecUint8x513) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 513)
+		if err != nil {
+			return err
+		}
+		copy(v.Value[:], buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint16x1 object
+func (v *VecUint16x1) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint16x1 object to a target array
+func (v *VecUint16x1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 1; ii++ {
+		dst = ssz.MarshalUint16(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint16x1 object
+func (v *VecUint16x1) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 2 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 1; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint16(buf[0:2][ii*2 : (ii+1)*2])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint16x1 object
+func (v *VecUint16x1) SizeSSZ() (size int) {
+	size = 2
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint16x1 object
+func (v *VecUint16x1) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint16x1 object with a hasher
+func (v *VecUint16x1) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(ssz.MarshalUint16(nil, i))
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint16x1 object
+func (v *VecUint16x1) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint16x1 object
+func (v *VecUint16x1) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(2), 1)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint16x1 object
+const (
+	VecUint16x1_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint16x1 object from its tree
+func (v *VecUint16x1) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 2)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 1; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint16(buf[ii*2 : (ii+1)*2])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint16x2 object
+func (v *VecUint16x2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint16x2 object to a target array
+func (v *VecUint16x2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 2; ii++ {
+		dst = ssz.MarshalUint16(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint16x2 object
+func (v *VecUint16x2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 4 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 2; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint16(buf[0:4][ii*2 : (ii+1)*2])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint16x2 object
+func (v *VecUint16x2) SizeSSZ() (size int) {
+	size = 4
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint16x2 object
+func (v *VecUint16x2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint16x2 object with a hasher
+func (v *VecUint16x2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(ssz.MarshalUint16(nil, i))
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint16x2 object
+func (v *VecUint16x2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint16x2 object
+func (v *VecUint16x2) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(2), 2)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint16x2 object
+const (
+	VecUint16x2_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint16x2 object from its tree
+func (v *VecUint16x2) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 4)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 2; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint16(buf[ii*2 : (ii+1)*2])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint16x3 object
+func (v *VecUint16x3) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint16x3 object to a target array
+func (v *VecUint16x3) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 3; ii++ {
+		dst = ssz.MarshalUint16(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint16x3 object
+func (v *VecUint16x3) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 6 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 3; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint16(buf[0:6][ii*2 : (ii+1)*2])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint16x3 object
+func (v *VecUint16x3) SizeSSZ() (size int) {
+	size = 6
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint16x3 object
+func (v *VecUint16x3) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint16x3 object with a hasher
+func (v *VecUint16x3) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(ssz.MarshalUint16(nil, i))
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint16x3 object
+func (v *VecUint16x3) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint16x3 object
+func (v *VecUint16x3) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(2), 3)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint16x3 object
+const (
+	VecUint16x3_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint16x3 object from its tree
+func (v *VecUint16x3) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 6)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 3; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint16(buf[ii*2 : (ii+1)*2])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint16x4 object
+func (v *VecUint16x4) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint16x4 object to a target array
+func (v *VecUint16x4) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 4; ii++ {
+		dst = ssz.MarshalUint16(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint16x4 object
+func (v *VecUint16x4) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 8 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 4; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint16(buf[0:8][ii*2 : (ii+1)*2])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint16x4 object
+func (v *VecUint16x4) SizeSSZ() (size int) {
+	size = 8
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint16x4 object
+func (v *VecUint16x4) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint16x4 object with a hasher
+func (v *VecUint16x4) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(ssz.MarshalUint16(nil, i))
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint16x4 object
+func (v *VecUint16x4) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint16x4 object
+func (v *VecUint16x4) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(2), 4)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint16x4 object
+const (
+	VecUint16x4_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint16x4 object from its tree
+func (v *VecUint16x4) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 4; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint16(buf[ii*2 : (ii+1)*2])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint16x5 object
+func (v *VecUint16x5) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint16x5 object to a target array
+func (v *VecUint16x5) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 5; ii++ {
+		dst = ssz.MarshalUint16(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint16x5 object
+func (v *VecUint16x5) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 10 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 5; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint16(buf[0:10][ii*2 : (ii+1)*2])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint16x5 object
+func (v *VecUint16x5) SizeSSZ() (size int) {
+	size = 10
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint16x5 object
+func (v *VecUint16x5) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint16x5 object with a hasher
+func (v *VecUint16x5) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(ssz.MarshalUint16(nil, i))
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint16x5 object
+func (v *VecUint16x5) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint16x5 object
+func (v *VecUint16x5) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(2), 5)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint16x5 object
+const (
+	VecUint16x5_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint16x5 object from its tree
+func (v *VecUint16x5) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 10)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 5; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint16(buf[ii*2 : (ii+1)*2])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint16x8 object
+func (v *VecUint16x8) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint16x8 object to a target array
+func (v *VecUint16x8) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 8; ii++ {
+		dst = ssz.MarshalUint16(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint16x8 object
+func (v *VecUint16x8) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 8; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint16(buf[0:16][ii*2 : (ii+1)*2])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint16x8 object
+func (v *VecUint16x8) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint16x8 object
+func (v *VecUint16x8) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint16x8 object with a hasher
+func (v *VecUint16x8) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(ssz.MarshalUint16(nil, i))
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint16x8 object
+func (v *VecUint16x8) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint16x8 object
+func (v *VecUint16x8) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(2), 8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint16x8 object
+const (
+	VecUint16x8_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint16x8 object from its tree
+func (v *VecUint16x8) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 16)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 8; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint16(buf[ii*2 : (ii+1)*2])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint16x16 object
+func (v *VecUint16x16) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint16x16 object to a target array
+func (v *VecUint16x16) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 16; ii++ {
+		dst = ssz.MarshalUint16(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint16x16 object
+func (v *VecUint16x16) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 32 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 16; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint16(buf[0:32][ii*2 : (ii+1)*2])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint16x16 object
+func (v *VecUint16x16) SizeSSZ() (size int) {
+	size = 32
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint16x16 object
+func (v *VecUint16x16) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint16x16 object with a hasher
+func (v *VecUint16x16) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(ssz.MarshalUint16(nil, i))
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint16x16 object
+func (v *VecUint16x16) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint16x16 object
+func (v *VecUint16x16) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(2), 16)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint16x16 object
+const (
+	VecUint16x16_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint16x16 object from its tree
+func (v *VecUint16x16) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 16; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint16(buf[ii*2 : (ii+1)*2])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint16x31 object
+func (v *VecUint16x31) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint16x31 object to a target array
+func (v *VecUint16x31) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 31; ii++ {
+		dst = ssz.MarshalUint16(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint16x31 object
+func (v *VecUint16x31) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 62 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 31; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint16(buf[0:62][ii*2 : (ii+1)*2])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint16x31 object
+func (v *VecUint16x31) SizeSSZ() (size int) {
+	size = 62
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint16x31 object
+func (v *VecUint16x31) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint16x31 object with a hasher
+func (v *VecUint16x31) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(ssz.MarshalUint16(nil, i))
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint16x31 object
+func (v *VecUint16x31) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint16x31 object
+func (v *VecUint16x31) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(2), 31)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint16x31 object
+const (
+	VecUint16x31_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint16x31 object from its tree
+func (v *VecUint16x31) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 62)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 31; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint16(buf[ii*2 : (ii+1)*2])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint16x512 object
+func (v *VecUint16x512) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint16x512 object to a target array
+func (v *VecUint16x512) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 512; ii++ {
+		dst = ssz.MarshalUint16(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint16x512 object
+func (v *VecUint16x512) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 1024 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 512; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint16(buf[0:1024][ii*2 : (ii+1)*2])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint16x512 object
+func (v *VecUint16x512) SizeSSZ() (size int) {
+	size = 1024
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint16x512 object
+func (v *VecUint16x512) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint16x512 object with a hasher
+func (v *VecUint16x512) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(ssz.MarshalUint16(nil, i))
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint16x512 object
+func (v *VecUint16x512) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint16x512 object
+func (v *VecUint16x512) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(2), 512)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint16x512 object
+const (
+	VecUint16x512_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint16x512 object from its tree
+func (v *VecUint16x512) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 1024)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 512; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint16(buf[ii*2 : (ii+1)*2])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint16x513 object
+func (v *VecUint16x513) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint16x513 object to a target array
+func (v *VecUint16x513) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 513; ii++ {
+		dst = ssz.MarshalUint16(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint16x513 object
+func (v *VecUint16x513) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 1026 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 513; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint16(buf[0:1026][ii*2 : (ii+1)*2])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint16x513 object
+func (v *VecUint16x513) SizeSSZ() (size int) {
+	size = 1026
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint16x513 object
+func (v *VecUint16x513) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint16x513 object with a hasher
+func (v *VecUint16x513) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(ssz.MarshalUint16(nil, i))
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint16x513 object
+func (v *VecUint16x513) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint16x513 object
+func (v *VecUint16x513) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(2), 513)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint16x513 object
+const (
+	VecUint16x513_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint16x513 object from its tree
+func (v *VecUint16x513) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 1026)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 513; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint16(buf[ii*2 : (ii+1)*2])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint32x1 object
+func (v *VecUint32x1) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint32x1 object to a target array
+func (v *VecUint32x1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 1; ii++ {
+		dst = ssz.MarshalUint32(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint32x1 object
+func (v *VecUint32x1) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 4 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 1; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint32(buf[0:4][ii*4 : (ii+1)*4])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint32x1 object
+func (v *VecUint32x1) SizeSSZ() (size int) {
+	size = 4
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint32x1 object
+func (v *VecUint32x1) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint32x1 object with a hasher
+func (v *VecUint32x1) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint32(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint32x1 object
+func (v *VecUint32x1) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint32x1 object
+func (v *VecUint32x1) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(4), 1)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint32x1 object
+const (
+	VecUint32x1_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint32x1 object from its tree
+func (v *VecUint32x1) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 4)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 1; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint32(buf[ii*4 : (ii+1)*4])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint32x2 object
+func (v *VecUint32x2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint32x2 object to a target array
+func (v *VecUint32x2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 2; ii++ {
+		dst = ssz.MarshalUint32(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint32x2 object
+func (v *VecUint32x2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 8 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 2; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint32(buf[0:8][ii*4 : (ii+1)*4])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint32x2 object
+func (v *VecUint32x2) SizeSSZ() (size int) {
+	size = 8
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint32x2 object
+func (v *VecUint32x2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint32x2 object with a hasher
+func (v *VecUint32x2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint32(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint32x2 object
+func (v *VecUint32x2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint32x2 object
+func (v *VecUint32x2) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(4), 2)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint32x2 object
+const (
+	VecUint32x2_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint32x2 object from its tree
+func (v *VecUint32x2) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 2; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint32(buf[ii*4 : (ii+1)*4])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint32x3 object
+func (v *VecUint32x3) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint32x3 object to a target array
+func (v *VecUint32x3) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 3; ii++ {
+		dst = ssz.MarshalUint32(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint32x3 object
+func (v *VecUint32x3) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 12 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 3; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint32(buf[0:12][ii*4 : (ii+1)*4])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint32x3 object
+func (v *VecUint32x3) SizeSSZ() (size int) {
+	size = 12
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint32x3 object
+func (v *VecUint32x3) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint32x3 object with a hasher
+func (v *VecUint32x3) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint32(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint32x3 object
+func (v *VecUint32x3) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint32x3 object
+func (v *VecUint32x3) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(4), 3)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint32x3 object
+const (
+	VecUint32x3_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint32x3 object from its tree
+func (v *VecUint32x3) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 12)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 3; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint32(buf[ii*4 : (ii+1)*4])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint32x4 object
+func (v *VecUint32x4) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint32x4 object to a target array
+func (v *VecUint32x4) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 4; ii++ {
+		dst = ssz.MarshalUint32(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint32x4 object
+func (v *VecUint32x4) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 4; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint32(buf[0:16][ii*4 : (ii+1)*4])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint32x4 object
+func (v *VecUint32x4) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint32x4 object
+func (v *VecUint32x4) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint32x4 object with a hasher
+func (v *VecUint32x4) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint32(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint32x4 object
+func (v *VecUint32x4) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint32x4 object
+func (v *VecUint32x4) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(4), 4)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint32x4 object
+const (
+	VecUint32x4_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint32x4 object from its tree
+func (v *VecUint32x4) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 16)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 4; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint32(buf[ii*4 : (ii+1)*4])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint32x5 object
+func (v *VecUint32x5) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint32x5 object to a target array
+func (v *VecUint32x5) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 5; ii++ {
+		dst = ssz.MarshalUint32(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint32x5 object
+func (v *VecUint32x5) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 20 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 5; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint32(buf[0:20][ii*4 : (ii+1)*4])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint32x5 object
+func (v *VecUint32x5) SizeSSZ() (size int) {
+	size = 20
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint32x5 object
+func (v *VecUint32x5) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint32x5 object with a hasher
+func (v *VecUint32x5) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint32(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint32x5 object
+func (v *VecUint32x5) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint32x5 object
+func (v *VecUint32x5) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(4), 5)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint32x5 object
+const (
+	VecUint32x5_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint32x5 object from its tree
+func (v *VecUint32x5) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 20)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 5; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint32(buf[ii*4 : (ii+1)*4])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint32x8 object
+func (v *VecUint32x8) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint32x8 object to a target array
+func (v *VecUint32x8) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 8; ii++ {
+		dst = ssz.MarshalUint32(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint32x8 object
+func (v *VecUint32x8) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 32 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 8; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint32(buf[0:32][ii*4 : (ii+1)*4])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint32x8 object
+func (v *VecUint32x8) SizeSSZ() (size int) {
+	size = 32
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint32x8 object
+func (v *VecUint32x8) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint32x8 object with a hasher
+func (v *VecUint32x8) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint32(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint32x8 object
+func (v *VecUint32x8) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint32x8 object
+func (v *VecUint32x8) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(4), 8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint32x8 object
+const (
+	VecUint32x8_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint32x8 object from its tree
+func (v *VecUint32x8) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 8; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint32(buf[ii*4 : (ii+1)*4])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint32x16 object
+func (v *VecUint32x16) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint32x16 object to a target array
+func (v *VecUint32x16) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 16; ii++ {
+		dst = ssz.MarshalUint32(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint32x16 object
+func (v *VecUint32x16) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 64 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 16; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint32(buf[0:64][ii*4 : (ii+1)*4])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint32x16 object
+func (v *VecUint32x16) SizeSSZ() (size int) {
+	size = 64
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint32x16 object
+func (v *VecUint32x16) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint32x16 object with a hasher
+func (v *VecUint32x16) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint32(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint32x16 object
+func (v *VecUint32x16) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint32x16 object
+func (v *VecUint32x16) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(4), 16)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint32x16 object
+const (
+	VecUint32x16_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint32x16 object from its tree
+func (v *VecUint32x16) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 64)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 16; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint32(buf[ii*4 : (ii+1)*4])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint32x31 object
+func (v *VecUint32x31) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint32x31 object to a target array
+func (v *VecUint32x31) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 31; ii++ {
+		dst = ssz.MarshalUint32(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint32x31 object
+func (v *VecUint32x31) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 124 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 31; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint32(buf[0:124][ii*4 : (ii+1)*4])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint32x31 object
+func (v *VecUint32x31) SizeSSZ() (size int) {
+	size = 124
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint32x31 object
+func (v *VecUint32x31) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint32x31 object with a hasher
+func (v *VecUint32x31) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint32(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint32x31 object
+func (v *VecUint32x31) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint32x31 object
+func (v *VecUint32x31) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(4), 31)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint32x31 object
+const (
+	VecUint32x31_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint32x31 object from its tree
+func (v *VecUint32x31) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 124)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 31; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint32(buf[ii*4 : (ii+1)*4])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint32x512 object
+func (v *VecUint32x512) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint32x512 object to a target array
+func (v *VecUint32x512) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 512; ii++ {
+		dst = ssz.MarshalUint32(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint32x512 object
+func (v *VecUint32x512) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 2048 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 512; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint32(buf[0:2048][ii*4 : (ii+1)*4])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint32x512 object
+func (v *VecUint32x512) SizeSSZ() (size int) {
+	size = 2048
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint32x512 object
+func (v *VecUint32x512) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint32x512 object with a hasher
+func (v *VecUint32x512) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint32(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint32x512 object
+func (v *VecUint32x512) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint32x512 object
+func (v *VecUint32x512) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(4), 512)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint32x512 object
+const (
+	VecUint32x512_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint32x512 object from its tree
+func (v *VecUint32x512) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 2048)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 512; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint32(buf[ii*4 : (ii+1)*4])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint32x513 object
+func (v *VecUint32x513) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint32x513 object to a target array
+func (v *VecUint32x513) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 513; ii++ {
+		dst = ssz.MarshalUint32(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint32x513 object
+func (v *VecUint32x513) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 2052 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 513; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint32(buf[0:2052][ii*4 : (ii+1)*4])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint32x513 object
+func (v *VecUint32x513) SizeSSZ() (size int) {
+	size = 2052
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint32x513 object
+func (v *VecUint32x513) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint32x513 object with a hasher
+func (v *VecUint32x513) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint32(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint32x513 object
+func (v *VecUint32x513) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint32x513 object
+func (v *VecUint32x513) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(4), 513)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint32x513 object
+const (
+	VecUint32x513_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint32x513 object from its tree
+func (v *VecUint32x513) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 2052)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 513; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint32(buf[ii*4 : (ii+1)*4])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint64x1 object
+func (v *VecUint64x1) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint64x1 object to a target array
+func (v *VecUint64x1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 1; ii++ {
+		dst = ssz.MarshalUint64(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint64x1 object
+func (v *VecUint64x1) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 8 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 1; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint64(buf[0:8][ii*8 : (ii+1)*8])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint64x1 object
+func (v *VecUint64x1) SizeSSZ() (size int) {
+	size = 8
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint64x1 object
+func (v *VecUint64x1) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint64x1 object with a hasher
+func (v *VecUint64x1) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint64x1 object
+func (v *VecUint64x1) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint64x1 object
+func (v *VecUint64x1) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(8), 1)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint64x1 object
+const (
+	VecUint64x1_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint64x1 object from its tree
+func (v *VecUint64x1) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 1; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint64x2 object
+func (v *VecUint64x2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint64x2 object to a target array
+func (v *VecUint64x2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 2; ii++ {
+		dst = ssz.MarshalUint64(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint64x2 object
+func (v *VecUint64x2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 2; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint64(buf[0:16][ii*8 : (ii+1)*8])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint64x2 object
+func (v *VecUint64x2) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint64x2 object
+func (v *VecUint64x2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint64x2 object with a hasher
+func (v *VecUint64x2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint64x2 object
+func (v *VecUint64x2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint64x2 object
+func (v *VecUint64x2) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(8), 2)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint64x2 object
+const (
+	VecUint64x2_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint64x2 object from its tree
+func (v *VecUint64x2) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 16)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 2; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint64x3 object
+func (v *VecUint64x3) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint64x3 object to a target array
+func (v *VecUint64x3) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 3; ii++ {
+		dst = ssz.MarshalUint64(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint64x3 object
+func (v *VecUint64x3) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 24 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 3; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint64(buf[0:24][ii*8 : (ii+1)*8])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint64x3 object
+func (v *VecUint64x3) SizeSSZ() (size int) {
+	size = 24
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint64x3 object
+func (v *VecUint64x3) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint64x3 object with a hasher
+func (v *VecUint64x3) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint64x3 object
+func (v *VecUint64x3) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint64x3 object
+func (v *VecUint64x3) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(8), 3)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint64x3 object
+const (
+	VecUint64x3_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint64x3 object from its tree
+func (v *VecUint64x3) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 24)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 3; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint64x4 object
+func (v *VecUint64x4) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint64x4 object to a target array
+func (v *VecUint64x4) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 4; ii++ {
+		dst = ssz.MarshalUint64(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint64x4 object
+func (v *VecUint64x4) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 32 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 4; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint64(buf[0:32][ii*8 : (ii+1)*8])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint64x4 object
+func (v *VecUint64x4) SizeSSZ() (size int) {
+	size = 32
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint64x4 object
+func (v *VecUint64x4) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint64x4 object with a hasher
+func (v *VecUint64x4) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint64x4 object
+func (v *VecUint64x4) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint64x4 object
+func (v *VecUint64x4) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(8), 4)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint64x4 object
+const (
+	VecUint64x4_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint64x4 object from its tree
+func (v *VecUint64x4) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 4; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint64x5 object
+func (v *VecUint64x5) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint64x5 object to a target array
+func (v *VecUint64x5) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 5; ii++ {
+		dst = ssz.MarshalUint64(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint64x5 object
+func (v *VecUint64x5) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 5; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint64(buf[0:40][ii*8 : (ii+1)*8])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint64x5 object
+func (v *VecUint64x5) SizeSSZ() (size int) {
+	size = 40
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint64x5 object
+func (v *VecUint64x5) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint64x5 object with a hasher
+func (v *VecUint64x5) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint64x5 object
+func (v *VecUint64x5) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint64x5 object
+func (v *VecUint64x5) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(8), 5)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint64x5 object
+const (
+	VecUint64x5_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint64x5 object from its tree
+func (v *VecUint64x5) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 40)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 5; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint64x8 object
+func (v *VecUint64x8) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint64x8 object to a target array
+func (v *VecUint64x8) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 8; ii++ {
+		dst = ssz.MarshalUint64(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint64x8 object
+func (v *VecUint64x8) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 64 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 8; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint64(buf[0:64][ii*8 : (ii+1)*8])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint64x8 object
+func (v *VecUint64x8) SizeSSZ() (size int) {
+	size = 64
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint64x8 object
+func (v *VecUint64x8) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint64x8 object with a hasher
+func (v *VecUint64x8) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint64x8 object
+func (v *VecUint64x8) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint64x8 object
+func (v *VecUint64x8) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(8), 8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint64x8 object
+const (
+	VecUint64x8_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint64x8 object from its tree
+func (v *VecUint64x8) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 64)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 8; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint64x16 object
+func (v *VecUint64x16) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint64x16 object to a target array
+func (v *VecUint64x16) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 16; ii++ {
+		dst = ssz.MarshalUint64(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint64x16 object
+func (v *VecUint64x16) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 128 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 16; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint64(buf[0:128][ii*8 : (ii+1)*8])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint64x16 object
+func (v *VecUint64x16) SizeSSZ() (size int) {
+	size = 128
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint64x16 object
+func (v *VecUint64x16) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint64x16 object with a hasher
+func (v *VecUint64x16) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint64x16 object
+func (v *VecUint64x16) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint64x16 object
+func (v *VecUint64x16) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(8), 16)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint64x16 object
+const (
+	VecUint64x16_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint64x16 object from its tree
+func (v *VecUint64x16) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 128)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 16; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint64x31 object
+func (v *VecUint64x31) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint64x31 object to a target array
+func (v *VecUint64x31) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 31; ii++ {
+		dst = ssz.MarshalUint64(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint64x31 object
+func (v *VecUint64x31) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 248 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 31; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint64(buf[0:248][ii*8 : (ii+1)*8])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint64x31 object
+func (v *VecUint64x31) SizeSSZ() (size int) {
+	size = 248
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint64x31 object
+func (v *VecUint64x31) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint64x31 object with a hasher
+func (v *VecUint64x31) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint64x31 object
+func (v *VecUint64x31) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint64x31 object
+func (v *VecUint64x31) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(8), 31)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint64x31 object
+const (
+	VecUint64x31_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint64x31 object from its tree
+func (v *VecUint64x31) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 248)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 31; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint64x512 object
+func (v *VecUint64x512) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint64x512 object to a target array
+func (v *VecUint64x512) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 512; ii++ {
+		dst = ssz.MarshalUint64(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint64x512 object
+func (v *VecUint64x512) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 4096 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 512; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint64(buf[0:4096][ii*8 : (ii+1)*8])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint64x512 object
+func (v *VecUint64x512) SizeSSZ() (size int) {
+	size = 4096
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint64x512 object
+func (v *VecUint64x512) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint64x512 object with a hasher
+func (v *VecUint64x512) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint64x512 object
+func (v *VecUint64x512) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint64x512 object
+func (v *VecUint64x512) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(8), 512)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint64x512 object
+const (
+	VecUint64x512_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint64x512 object from its tree
+func (v *VecUint64x512) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 4096)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 512; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint64x513 object
+func (v *VecUint64x513) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint64x513 object to a target array
+func (v *VecUint64x513) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 513; ii++ {
+		dst = ssz.MarshalUint64(dst, v.Value[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint64x513 object
+func (v *VecUint64x513) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 4104 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 513; ii++ {
+		v.Value[ii] = ssz.UnmarshallUint64(buf[0:4104][ii*8 : (ii+1)*8])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint64x513 object
+func (v *VecUint64x513) SizeSSZ() (size int) {
+	size = 4104
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint64x513 object
+func (v *VecUint64x513) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint64x513 object with a hasher
+func (v *VecUint64x513) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint64x513 object
+func (v *VecUint64x513) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint64x513 object
+func (v *VecUint64x513) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(8), 513)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint64x513 object
+const (
+	VecUint64x513_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint64x513 object from its tree
+func (v *VecUint64x513) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 4104)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 513; ii++ {
+			v.Value[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint256x1 object
+func (v *VecUint256x1) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint256x1 object to a target array
+func (v *VecUint256x1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 1; ii++ {
+		dst = append(dst, v.Value[ii][:]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint256x1 object
+func (v *VecUint256x1) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 32 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 1; ii++ {
+		copy(v.Value[ii][:], buf[0:32][ii*32:(ii+1)*32])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint256x1 object
+func (v *VecUint256x1) SizeSSZ() (size int) {
+	size = 32
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint256x1 object
+func (v *VecUint256x1) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint256x1 object with a hasher
+func (v *VecUint256x1) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint256x1 object
+func (v *VecUint256x1) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint256x1 object
+func (v *VecUint256x1) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 1)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint256x1 object
+const (
+	VecUint256x1_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint256x1 object from its tree
+func (v *VecUint256x1) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[0], 1, 32)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 1; ii++ {
+			copy(v.Value[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint256x2 object
+func (v *VecUint256x2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint256x2 object to a target array
+func (v *VecUint256x2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 2; ii++ {
+		dst = append(dst, v.Value[ii][:]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint256x2 object
+func (v *VecUint256x2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 64 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 2; ii++ {
+		copy(v.Value[ii][:], buf[0:64][ii*32:(ii+1)*32])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint256x2 object
+func (v *VecUint256x2) SizeSSZ() (size int) {
+	size = 64
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint256x2 object
+func (v *VecUint256x2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint256x2 object with a hasher
+func (v *VecUint256x2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint256x2 object
+func (v *VecUint256x2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint256x2 object
+func (v *VecUint256x2) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 2)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint256x2 object
+const (
+	VecUint256x2_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint256x2 object from its tree
+func (v *VecUint256x2) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[0], 2, 32)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 2; ii++ {
+			copy(v.Value[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint256x3 object
+func (v *VecUint256x3) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint256x3 object to a target array
+func (v *VecUint256x3) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 3; ii++ {
+		dst = append(dst, v.Value[ii][:]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint256x3 object
+func (v *VecUint256x3) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 96 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 3; ii++ {
+		copy(v.Value[ii][:], buf[0:96][ii*32:(ii+1)*32])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint256x3 object
+func (v *VecUint256x3) SizeSSZ() (size int) {
+	size = 96
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint256x3 object
+func (v *VecUint256x3) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint256x3 object with a hasher
+func (v *VecUint256x3) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint256x3 object
+func (v *VecUint256x3) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint256x3 object
+func (v *VecUint256x3) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 3)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint256x3 object
+const (
+	VecUint256x3_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint256x3 object from its tree
+func (v *VecUint256x3) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[0], 3, 32)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 3; ii++ {
+			copy(v.Value[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint256x4 object
+func (v *VecUint256x4) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint256x4 object to a target array
+func (v *VecUint256x4) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 4; ii++ {
+		dst = append(dst, v.Value[ii][:]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint256x4 object
+func (v *VecUint256x4) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 128 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 4; ii++ {
+		copy(v.Value[ii][:], buf[0:128][ii*32:(ii+1)*32])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint256x4 object
+func (v *VecUint256x4) SizeSSZ() (size int) {
+	size = 128
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint256x4 object
+func (v *VecUint256x4) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint256x4 object with a hasher
+func (v *VecUint256x4) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint256x4 object
+func (v *VecUint256x4) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint256x4 object
+func (v *VecUint256x4) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 4)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint256x4 object
+const (
+	VecUint256x4_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint256x4 object from its tree
+func (v *VecUint256x4) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[0], 4, 32)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 4; ii++ {
+			copy(v.Value[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint256x5 object
+func (v *VecUint256x5) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint256x5 object to a target array
+func (v *VecUint256x5) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 5; ii++ {
+		dst = append(dst, v.Value[ii][:]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint256x5 object
+func (v *VecUint256x5) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 160 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 5; ii++ {
+		copy(v.Value[ii][:], buf[0:160][ii*32:(ii+1)*32])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint256x5 object
+func (v *VecUint256x5) SizeSSZ() (size int) {
+	size = 160
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint256x5 object
+func (v *VecUint256x5) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint256x5 object with a hasher
+func (v *VecUint256x5) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint256x5 object
+func (v *VecUint256x5) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint256x5 object
+func (v *VecUint256x5) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 5)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint256x5 object
+const (
+	VecUint256x5_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint256x5 object from its tree
+func (v *VecUint256x5) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[0], 5, 32)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 5; ii++ {
+			copy(v.Value[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint256x8 object
+func (v *VecUint256x8) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint256x8 object to a target array
+func (v *VecUint256x8) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 8; ii++ {
+		dst = append(dst, v.Value[ii][:]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint256x8 object
+func (v *VecUint256x8) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 256 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 8; ii++ {
+		copy(v.Value[ii][:], buf[0:256][ii*32:(ii+1)*32])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint256x8 object
+func (v *VecUint256x8) SizeSSZ() (size int) {
+	size = 256
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint256x8 object
+func (v *VecUint256x8) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint256x8 object with a hasher
+func (v *VecUint256x8) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint256x8 object
+func (v *VecUint256x8) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint256x8 object
+func (v *VecUint256x8) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint256x8 object
+const (
+	VecUint256x8_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint256x8 object from its tree
+func (v *VecUint256x8) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[0], 8, 32)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 8; ii++ {
+			copy(v.Value[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint256x16 object
+func (v *VecUint256x16) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint256x16 object to a target array
+func (v *VecUint256x16) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 16; ii++ {
+		dst = append(dst, v.Value[ii][:]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint256x16 object
+func (v *VecUint256x16) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 512 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 16; ii++ {
+		copy(v.Value[ii][:], buf[0:512][ii*32:(ii+1)*32])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint256x16 object
+func (v *VecUint256x16) SizeSSZ() (size int) {
+	size = 512
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint256x16 object
+func (v *VecUint256x16) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint256x16 object with a hasher
+func (v *VecUint256x16) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint256x16 object
+func (v *VecUint256x16) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint256x16 object
+func (v *VecUint256x16) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 16)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint256x16 object
+const (
+	VecUint256x16_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint256x16 object from its tree
+func (v *VecUint256x16) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[0], 16, 32)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 16; ii++ {
+			copy(v.Value[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint256x31 object
+func (v *VecUint256x31) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint256x31 object to a target array
+func (v *VecUint256x31) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 31; ii++ {
+		dst = append(dst, v.Value[ii][:]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint256x31 object
+func (v *VecUint256x31) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 992 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 31; ii++ {
+		copy(v.Value[ii][:], buf[0:992][ii*32:(ii+1)*32])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint256x31 object
+func (v *VecUint256x31) SizeSSZ() (size int) {
+	size = 992
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint256x31 object
+func (v *VecUint256x31) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint256x31 object with a hasher
+func (v *VecUint256x31) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint256x31 object
+func (v *VecUint256x31) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint256x31 object
+func (v *VecUint256x31) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 31)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint256x31 object
+const (
+	VecUint256x31_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint256x31 object from its tree
+func (v *VecUint256x31) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[0], 31, 32)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 31; ii++ {
+			copy(v.Value[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint256x512 object
+func (v *VecUint256x512) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint256x512 object to a target array
+func (v *VecUint256x512) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 512; ii++ {
+		dst = append(dst, v.Value[ii][:]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint256x512 object
+func (v *VecUint256x512) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16384 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 512; ii++ {
+		copy(v.Value[ii][:], buf[0:16384][ii*32:(ii+1)*32])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint256x512 object
+func (v *VecUint256x512) SizeSSZ() (size int) {
+	size = 16384
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint256x512 object
+func (v *VecUint256x512) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint256x512 object with a hasher
+func (v *VecUint256x512) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint256x512 object
+func (v *VecUint256x512) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint256x512 object
+func (v *VecUint256x512) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 512)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint256x512 object
+const (
+	VecUint256x512_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint256x512 object from its tree
+func (v *VecUint256x512) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[0], 512, 32)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 512; ii++ {
+			copy(v.Value[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VecUint256x513 object
+func (v *VecUint256x513) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VecUint256x513 object to a target array
+func (v *VecUint256x513) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	for ii := 0; ii < 513; ii++ {
+		dst = append(dst, v.Value[ii][:]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VecUint256x513 object
+func (v *VecUint256x513) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16416 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+
+	for ii := 0; ii < 513; ii++ {
+		copy(v.Value[ii][:], buf[0:16416][ii*32:(ii+1)*32])
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VecUint256x513 object
+func (v *VecUint256x513) SizeSSZ() (size int) {
+	size = 16416
+	return
+}
+
+// HashTreeRoot ssz hashes the VecUint256x513 object
+func (v *VecUint256x513) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VecUint256x513 object with a hasher
+func (v *VecUint256x513) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	{
+		subIndx := hh.Index()
+		for _, i := range v.Value {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VecUint256x513 object
+func (v *VecUint256x513) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VecUint256x513 object
+func (v *VecUint256x513) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 513)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VecUint256x513 object
+const (
+	VecUint256x513_ValueGIndex = 1
+)
+
+// FromTree decodes the VecUint256x513 object from its tree
+func (v *VecUint256x513) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[0], 513, 32)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 513; ii++ {
+			copy(v.Value[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitvector1 object
+func (b *Bitvector1) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitvector1 object to a target array
+func (b *Bitvector1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 1 {
+		err = ssz.ErrBytesLengthFn("Bitvector1.Value", size, 1)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitvector1 object
+func (b *Bitvector1) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 1 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	if err = ssz.ValidateBitvector(buf[0:1], 1); err != nil {
+		return err
+	}
+	if cap(b.Value) == 0 {
+		b.Value = make([]byte, 0, len(buf[0:1]))
+	}
+	b.Value = append(b.Value, buf[0:1]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitvector1 object
+func (b *Bitvector1) SizeSSZ() (size int) {
+	size = 1
+	return
+}
+
+// HashTreeRoot ssz hashes the Bitvector1 object
+func (b *Bitvector1) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitvector1 object with a hasher
+func (b *Bitvector1) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 1 {
+		err = ssz.ErrBytesLengthFn("Bitvector1.Value", size, 1)
+		return
+	}
+	hh.PutBytes(b.Value)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitvector1 object
+func (b *Bitvector1) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitvector1 object
+func (b *Bitvector1) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(1)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitvector1 object
+const (
+	Bitvector1_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitvector1 object from its tree
+func (b *Bitvector1) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 1)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitvector(buf, 1); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitvector2 object
+func (b *Bitvector2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitvector2 object to a target array
+func (b *Bitvector2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 1 {
+		err = ssz.ErrBytesLengthFn("Bitvector2.Value", size, 1)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitvector2 object
+func (b *Bitvector2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 1 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	if err = ssz.ValidateBitvector(buf[0:1], 2); err != nil {
+		return err
+	}
+	if cap(b.Value) == 0 {
+		b.Value = make([]byte, 0, len(buf[0:1]))
+	}
+	b.Value = append(b.Value, buf[0:1]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitvector2 object
+func (b *Bitvector2) SizeSSZ() (size int) {
+	size = 1
+	return
+}
+
+// HashTreeRoot ssz hashes the Bitvector2 object
+func (b *Bitvector2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitvector2 object with a hasher
+func (b *Bitvector2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 1 {
+		err = ssz.ErrBytesLengthFn("Bitvector2.Value", size, 1)
+		return
+	}
+	hh.PutBytes(b.Value)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitvector2 object
+func (b *Bitvector2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitvector2 object
+func (b *Bitvector2) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(1)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitvector2 object
+const (
+	Bitvector2_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitvector2 object from its tree
+func (b *Bitvector2) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 1)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitvector(buf, 2); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitvector3 object
+func (b *Bitvector3) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitvector3 object to a target array
+func (b *Bitvector3) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 1 {
+		err = ssz.ErrBytesLengthFn("Bitvector3.Value", size, 1)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitvector3 object
+func (b *Bitvector3) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 1 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	if err = ssz.ValidateBitvector(buf[0:1], 3); err != nil {
+		return err
+	}
+	if cap(b.Value) == 0 {
+		b.Value = make([]byte, 0, len(buf[0:1]))
+	}
+	b.Value = append(b.Value, buf[0:1]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitvector3 object
+func (b *Bitvector3) SizeSSZ() (size int) {
+	size = 1
+	return
+}
+
+// HashTreeRoot ssz hashes the Bitvector3 object
+func (b *Bitvector3) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitvector3 object with a hasher
+func (b *Bitvector3) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 1 {
+		err = ssz.ErrBytesLengthFn("Bitvector3.Value", size, 1)
+		return
+	}
+	hh.PutBytes(b.Value)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitvector3 object
+func (b *Bitvector3) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitvector3 object
+func (b *Bitvector3) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(1)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitvector3 object
+const (
+	Bitvector3_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitvector3 object from its tree
+func (b *Bitvector3) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 1)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitvector(buf, 3); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitvector4 object
+func (b *Bitvector4) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitvector4 object to a target array
+func (b *Bitvector4) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 1 {
+		err = ssz.ErrBytesLengthFn("Bitvector4.Value", size, 1)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitvector4 object
+func (b *Bitvector4) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 1 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	if err = ssz.ValidateBitvector(buf[0:1], 4); err != nil {
+		return err
+	}
+	if cap(b.Value) == 0 {
+		b.Value = make([]byte, 0, len(buf[0:1]))
+	}
+	b.Value = append(b.Value, buf[0:1]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitvector4 object
+func (b *Bitvector4) SizeSSZ() (size int) {
+	size = 1
+	return
+}
+
+// HashTreeRoot ssz hashes the Bitvector4 object
+func (b *Bitvector4) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitvector4 object with a hasher
+func (b *Bitvector4) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 1 {
+		err = ssz.ErrBytesLengthFn("Bitvector4.Value", size, 1)
+		return
+	}
+	hh.PutBytes(b.Value)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitvector4 object
+func (b *Bitvector4) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitvector4 object
+func (b *Bitvector4) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(1)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitvector4 object
+const (
+	Bitvector4_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitvector4 object from its tree
+func (b *Bitvector4) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 1)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitvector(buf, 4); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitvector5 object
+func (b *Bitvector5) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitvector5 object to a target array
+func (b *Bitvector5) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 1 {
+		err = ssz.ErrBytesLengthFn("Bitvector5.Value", size, 1)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitvector5 object
+func (b *Bitvector5) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 1 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	if err = ssz.ValidateBitvector(buf[0:1], 5); err != nil {
+		return err
+	}
+	if cap(b.Value) == 0 {
+		b.Value = make([]byte, 0, len(buf[0:1]))
+	}
+	b.Value = append(b.Value, buf[0:1]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitvector5 object
+func (b *Bitvector5) SizeSSZ() (size int) {
+	size = 1
+	return
+}
+
+// HashTreeRoot ssz hashes the Bitvector5 object
+func (b *Bitvector5) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitvector5 object with a hasher
+func (b *Bitvector5) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 1 {
+		err = ssz.ErrBytesLengthFn("Bitvector5.Value", size, 1)
+		return
+	}
+	hh.PutBytes(b.Value)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitvector5 object
+func (b *Bitvector5) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitvector5 object
+func (b *Bitvector5) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(1)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitvector5 object
+const (
+	Bitvector5_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitvector5 object from its tree
+func (b *Bitvector5) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 1)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitvector(buf, 5); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitvector8 object
+func (b *Bitvector8) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitvector8 object to a target array
+func (b *Bitvector8) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 1 {
+		err = ssz.ErrBytesLengthFn("Bitvector8.Value", size, 1)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitvector8 object
+func (b *Bitvector8) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 1 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	if cap(b.Value) == 0 {
+		b.Value = make([]byte, 0, len(buf[0:1]))
+	}
+	b.Value = append(b.Value, buf[0:1]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitvector8 object
+func (b *Bitvector8) SizeSSZ() (size int) {
+	size = 1
+	return
+}
+
+// HashTreeRoot ssz hashes the Bitvector8 object
+func (b *Bitvector8) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitvector8 object with a hasher
+func (b *Bitvector8) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 1 {
+		err = ssz.ErrBytesLengthFn("Bitvector8.Value", size, 1)
+		return
+	}
+	hh.PutBytes(b.Value)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitvector8 object
+func (b *Bitvector8) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitvector8 object
+func (b *Bitvector8) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(1)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitvector8 object
+const (
+	Bitvector8_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitvector8 object from its tree
+func (b *Bitvector8) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 1)
+		if err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitvector16 object
+func (b *Bitvector16) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitvector16 object to a target array
+func (b *Bitvector16) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 2 {
+		err = ssz.ErrBytesLengthFn("Bitvector16.Value", size, 2)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitvector16 object
+func (b *Bitvector16) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 2 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	if cap(b.Value) == 0 {
+		b.Value = make([]byte, 0, len(buf[0:2]))
+	}
+	b.Value = append(b.Value, buf[0:2]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitvector16 object
+func (b *Bitvector16) SizeSSZ() (size int) {
+	size = 2
+	return
+}
+
+// HashTreeRoot ssz hashes the Bitvector16 object
+func (b *Bitvector16) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitvector16 object with a hasher
+func (b *Bitvector16) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 2 {
+		err = ssz.ErrBytesLengthFn("Bitvector16.Value", size, 2)
+		return
+	}
+	hh.PutBytes(b.Value)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitvector16 object
+func (b *Bitvector16) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitvector16 object
+func (b *Bitvector16) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(2)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitvector16 object
+const (
+	Bitvector16_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitvector16 object from its tree
+func (b *Bitvector16) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 2)
+		if err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitvector31 object
+func (b *Bitvector31) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitvector31 object to a target array
+func (b *Bitvector31) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 4 {
+		err = ssz.ErrBytesLengthFn("Bitvector31.Value", size, 4)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitvector31 object
+func (b *Bitvector31) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 4 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	if err = ssz.ValidateBitvector(buf[0:4], 31); err != nil {
+		return err
+	}
+	if cap(b.Value) == 0 {
+		b.Value = make([]byte, 0, len(buf[0:4]))
+	}
+	b.Value = append(b.Value, buf[0:4]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitvector31 object
+func (b *Bitvector31) SizeSSZ() (size int) {
+	size = 4
+	return
+}
+
+// HashTreeRoot ssz hashes the Bitvector31 object
+func (b *Bitvector31) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitvector31 object with a hasher
+func (b *Bitvector31) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 4 {
+		err = ssz.ErrBytesLengthFn("Bitvector31.Value", size, 4)
+		return
+	}
+	hh.PutBytes(b.Value)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitvector31 object
+func (b *Bitvector31) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitvector31 object
+func (b *Bitvector31) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(4)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitvector31 object
+const (
+	Bitvector31_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitvector31 object from its tree
+func (b *Bitvector31) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 4)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitvector(buf, 31); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitvector512 object
+func (b *Bitvector512) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitvector512 object to a target array
+func (b *Bitvector512) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 64 {
+		err = ssz.ErrBytesLengthFn("Bitvector512.Value", size, 64)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitvector512 object
+func (b *Bitvector512) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 64 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	if cap(b.Value) == 0 {
+		b.Value = make([]byte, 0, len(buf[0:64]))
+	}
+	b.Value = append(b.Value, buf[0:64]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitvector512 object
+func (b *Bitvector512) SizeSSZ() (size int) {
+	size = 64
+	return
+}
+
+// HashTreeRoot ssz hashes the Bitvector512 object
+func (b *Bitvector512) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitvector512 object with a hasher
+func (b *Bitvector512) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 64 {
+		err = ssz.ErrBytesLengthFn("Bitvector512.Value", size, 64)
+		return
+	}
+	hh.PutBytes(b.Value)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitvector512 object
+func (b *Bitvector512) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitvector512 object
+func (b *Bitvector512) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(64)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitvector512 object
+const (
+	Bitvector512_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitvector512 object from its tree
+func (b *Bitvector512) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 64)
+		if err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitvector513 object
+func (b *Bitvector513) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitvector513 object to a target array
+func (b *Bitvector513) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 65 {
+		err = ssz.ErrBytesLengthFn("Bitvector513.Value", size, 65)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitvector513 object
+func (b *Bitvector513) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 65 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Value'
+	if err = ssz.ValidateBitvector(buf[0:65], 513); err != nil {
+		return err
+	}
+	if cap(b.Value) == 0 {
+		b.Value = make([]byte, 0, len(buf[0:65]))
+	}
+	b.Value = append(b.Value, buf[0:65]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitvector513 object
+func (b *Bitvector513) SizeSSZ() (size int) {
+	size = 65
+	return
+}
+
+// HashTreeRoot ssz hashes the Bitvector513 object
+func (b *Bitvector513) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitvector513 object with a hasher
+func (b *Bitvector513) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size != 65 {
+		err = ssz.ErrBytesLengthFn("Bitvector513.Value", size, 65)
+		return
+	}
+	hh.PutBytes(b.Value)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitvector513 object
+func (b *Bitvector513) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitvector513 object
+func (b *Bitvector513) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(65)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitvector513 object
+const (
+	Bitvector513_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitvector513 object from its tree
+func (b *Bitvector513) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 65)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitvector(buf, 513); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitlist1 object
+func (b *Bitlist1) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitlist1 object to a target array
+func (b *Bitlist1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Value'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size > 1 {
+		err = ssz.ErrBytesLengthFn("Bitlist1.Value", size, 1)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitlist1 object
+func (b *Bitlist1) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Value'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 4 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (0) 'Value'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 1); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist1 object
+func (b *Bitlist1) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'Value'
+	size += len(b.Value)
+
+	return
+}
+
+const Bitlist1MaxValueSize = 1
+
+// HashTreeRoot ssz hashes the Bitlist1 object
+func (b *Bitlist1) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitlist1 object with a hasher
+func (b *Bitlist1) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if len(b.Value) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.Value, 1)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitlist1 object
+func (b *Bitlist1) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitlist1 object
+func (b *Bitlist1) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BitlistSchema(1)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitlist1 object
+const (
+	Bitlist1_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitlist1 object from its tree
+func (b *Bitlist1) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBitlist(nodes[0], 1)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitlist(buf, 1); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitlist2 object
+func (b *Bitlist2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitlist2 object to a target array
+func (b *Bitlist2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Value'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size > 1 {
+		err = ssz.ErrBytesLengthFn("Bitlist2.Value", size, 1)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitlist2 object
+func (b *Bitlist2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Value'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 4 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (0) 'Value'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 2); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist2 object
+func (b *Bitlist2) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'Value'
+	size += len(b.Value)
+
+	return
+}
+
+const Bitlist2MaxValueSize = 2
+
+// HashTreeRoot ssz hashes the Bitlist2 object
+func (b *Bitlist2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitlist2 object with a hasher
+func (b *Bitlist2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if len(b.Value) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.Value, 2)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitlist2 object
+func (b *Bitlist2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitlist2 object
+func (b *Bitlist2) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BitlistSchema(2)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitlist2 object
+const (
+	Bitlist2_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitlist2 object from its tree
+func (b *Bitlist2) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBitlist(nodes[0], 2)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitlist(buf, 2); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitlist3 object
+func (b *Bitlist3) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitlist3 object to a target array
+func (b *Bitlist3) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Value'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size > 1 {
+		err = ssz.ErrBytesLengthFn("Bitlist3.Value", size, 1)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitlist3 object
+func (b *Bitlist3) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Value'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 4 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (0) 'Value'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 3); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist3 object
+func (b *Bitlist3) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'Value'
+	size += len(b.Value)
+
+	return
+}
+
+const Bitlist3MaxValueSize = 3
+
+// HashTreeRoot ssz hashes the Bitlist3 object
+func (b *Bitlist3) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitlist3 object with a hasher
+func (b *Bitlist3) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if len(b.Value) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.Value, 3)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitlist3 object
+func (b *Bitlist3) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitlist3 object
+func (b *Bitlist3) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BitlistSchema(3)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitlist3 object
+const (
+	Bitlist3_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitlist3 object from its tree
+func (b *Bitlist3) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBitlist(nodes[0], 3)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitlist(buf, 3); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitlist4 object
+func (b *Bitlist4) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitlist4 object to a target array
+func (b *Bitlist4) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Value'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size > 1 {
+		err = ssz.ErrBytesLengthFn("Bitlist4.Value", size, 1)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitlist4 object
+func (b *Bitlist4) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Value'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 4 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (0) 'Value'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 4); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist4 object
+func (b *Bitlist4) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'Value'
+	size += len(b.Value)
+
+	return
+}
+
+const Bitlist4MaxValueSize = 4
+
+// HashTreeRoot ssz hashes the Bitlist4 object
+func (b *Bitlist4) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitlist4 object with a hasher
+func (b *Bitlist4) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if len(b.Value) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.Value, 4)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitlist4 object
+func (b *Bitlist4) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitlist4 object
+func (b *Bitlist4) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BitlistSchema(4)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitlist4 object
+const (
+	Bitlist4_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitlist4 object from its tree
+func (b *Bitlist4) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBitlist(nodes[0], 4)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitlist(buf, 4); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitlist5 object
+func (b *Bitlist5) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitlist5 object to a target array
+func (b *Bitlist5) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Value'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size > 1 {
+		err = ssz.ErrBytesLengthFn("Bitlist5.Value", size, 1)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitlist5 object
+func (b *Bitlist5) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Value'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 4 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (0) 'Value'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 5); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist5 object
+func (b *Bitlist5) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'Value'
+	size += len(b.Value)
+
+	return
+}
+
+const Bitlist5MaxValueSize = 5
+
+// HashTreeRoot ssz hashes the Bitlist5 object
+func (b *Bitlist5) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitlist5 object with a hasher
+func (b *Bitlist5) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if len(b.Value) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.Value, 5)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitlist5 object
+func (b *Bitlist5) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitlist5 object
+func (b *Bitlist5) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BitlistSchema(5)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitlist5 object
+const (
+	Bitlist5_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitlist5 object from its tree
+func (b *Bitlist5) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBitlist(nodes[0], 5)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitlist(buf, 5); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitlist8 object
+func (b *Bitlist8) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitlist8 object to a target array
+func (b *Bitlist8) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Value'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size > 2 {
+		err = ssz.ErrBytesLengthFn("Bitlist8.Value", size, 2)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitlist8 object
+func (b *Bitlist8) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Value'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 4 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (0) 'Value'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 8); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist8 object
+func (b *Bitlist8) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'Value'
+	size += len(b.Value)
+
+	return
+}
+
+const Bitlist8MaxValueSize = 8
+
+// HashTreeRoot ssz hashes the Bitlist8 object
+func (b *Bitlist8) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitlist8 object with a hasher
+func (b *Bitlist8) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if len(b.Value) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.Value, 8)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitlist8 object
+func (b *Bitlist8) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitlist8 object
+func (b *Bitlist8) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BitlistSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitlist8 object
+const (
+	Bitlist8_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitlist8 object from its tree
+func (b *Bitlist8) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBitlist(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitlist(buf, 8); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitlist16 object
+func (b *Bitlist16) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitlist16 object to a target array
+func (b *Bitlist16) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Value'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size > 3 {
+		err = ssz.ErrBytesLengthFn("Bitlist16.Value", size, 3)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitlist16 object
+func (b *Bitlist16) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Value'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 4 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (0) 'Value'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 16); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist16 object
+func (b *Bitlist16) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'Value'
+	size += len(b.Value)
+
+	return
+}
+
+const Bitlist16MaxValueSize = 16
+
+// HashTreeRoot ssz hashes the Bitlist16 object
+func (b *Bitlist16) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitlist16 object with a hasher
+func (b *Bitlist16) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if len(b.Value) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.Value, 16)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitlist16 object
+func (b *Bitlist16) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitlist16 object
+func (b *Bitlist16) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BitlistSchema(16)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitlist16 object
+const (
+	Bitlist16_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitlist16 object from its tree
+func (b *Bitlist16) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBitlist(nodes[0], 16)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitlist(buf, 16); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitlist31 object
+func (b *Bitlist31) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitlist31 object to a target array
+func (b *Bitlist31) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Value'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size > 4 {
+		err = ssz.ErrBytesLengthFn("Bitlist31.Value", size, 4)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitlist31 object
+func (b *Bitlist31) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Value'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 4 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (0) 'Value'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 31); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist31 object
+func (b *Bitlist31) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'Value'
+	size += len(b.Value)
+
+	return
+}
+
+const Bitlist31MaxValueSize = 31
+
+// HashTreeRoot ssz hashes the Bitlist31 object
+func (b *Bitlist31) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitlist31 object with a hasher
+func (b *Bitlist31) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if len(b.Value) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.Value, 31)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitlist31 object
+func (b *Bitlist31) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitlist31 object
+func (b *Bitlist31) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BitlistSchema(31)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitlist31 object
+const (
+	Bitlist31_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitlist31 object from its tree
+func (b *Bitlist31) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBitlist(nodes[0], 31)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitlist(buf, 31); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitlist512 object
+func (b *Bitlist512) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitlist512 object to a target array
+func (b *Bitlist512) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Value'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size > 65 {
+		err = ssz.ErrBytesLengthFn("Bitlist512.Value", size, 65)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitlist512 object
+func (b *Bitlist512) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Value'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 4 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (0) 'Value'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 512); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist512 object
+func (b *Bitlist512) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'Value'
+	size += len(b.Value)
+
+	return
+}
+
+const Bitlist512MaxValueSize = 512
+
+// HashTreeRoot ssz hashes the Bitlist512 object
+func (b *Bitlist512) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitlist512 object with a hasher
+func (b *Bitlist512) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if len(b.Value) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.Value, 512)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitlist512 object
+func (b *Bitlist512) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitlist512 object
+func (b *Bitlist512) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BitlistSchema(512)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitlist512 object
+const (
+	Bitlist512_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitlist512 object from its tree
+func (b *Bitlist512) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBitlist(nodes[0], 512)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitlist(buf, 512); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Bitlist513 object
+func (b *Bitlist513) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitlist513 object to a target array
+func (b *Bitlist513) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Value'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Value'
+	if size := len(b.Value); size > 65 {
+		err = ssz.ErrBytesLengthFn("Bitlist513.Value", size, 65)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitlist513 object
+func (b *Bitlist513) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Value'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 4 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (0) 'Value'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 513); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist513 object
+func (b *Bitlist513) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'Value'
+	size += len(b.Value)
+
+	return
+}
+
+const Bitlist513MaxValueSize = 513
+
+// HashTreeRoot ssz hashes the Bitlist513 object
+func (b *Bitlist513) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitlist513 object with a hasher
+func (b *Bitlist513) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Value'
+	if len(b.Value) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.Value, 513)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitlist513 object
+func (b *Bitlist513) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the Bitlist513 object
+func (b *Bitlist513) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.BitlistSchema(513)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Bitlist513 object
+const (
+	Bitlist513_ValueGIndex = 1
+)
+
+// FromTree decodes the Bitlist513 object from its tree
+func (b *Bitlist513) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBitlist(nodes[0], 513)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitlist(buf, 513); err != nil {
+			return err
+		}
+		if cap(b.Value) == 0 {
+			b.Value = make([]byte, 0, len(buf))
+		}
+		b.Value = append(b.Value, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the SingleFieldTestStruct object
+func (s *SingleFieldTestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SingleFieldTestStruct object to a target array
+func (s *SingleFieldTestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint8(dst, s.A)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SingleFieldTestStruct object
+func (s *SingleFieldTestStruct) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 1 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'A'
+	s.A = ssz.UnmarshallUint8(buf[0:1])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SingleFieldTestStruct object
+func (s *SingleFieldTestStruct) SizeSSZ() (size int) {
+	size = 1
+	return
+}
+
+// HashTreeRoot ssz hashes the SingleFieldTestStruct object
+func (s *SingleFieldTestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SingleFieldTestStruct object with a hasher
+func (s *SingleFieldTestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint8(s.A)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SingleFieldTestStruct object
+func (s *SingleFieldTestStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// SSZSchema returns the ssz schema of the SingleFieldTestStruct object
+func (s *SingleFieldTestStruct) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "A", Tag: "", Schema: ssz.UintSchema(1)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the SingleFieldTestStruct object
+const (
+	SingleFieldTestStruct_AGIndex = 1
+)
+
+// FromTree decodes the SingleFieldTestStruct object from its tree
+func (s *SingleFieldTestStruct) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'A'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 1)
+		if err != nil {
+			return err
+		}
+		s.A = ssz.UnmarshallUint8(buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the SmallTestStruct object
+func (s *SmallTestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SmallTestStruct object to a target array
+func (s *SmallTestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint16(dst, s.A)
+
+	// Field (1) 'B'
+	dst = ssz.MarshalUint16(dst, s.B)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SmallTestStruct object
+func (s *SmallTestStruct) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 4 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'A'
+	s.A = ssz.UnmarshallUint16(buf[0:2])
+
+	// Field (1) 'B'
+	s.B = ssz.UnmarshallUint16(buf[2:4])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SmallTestStruct object
+func (s *SmallTestStruct) SizeSSZ() (size int) {
+	size = 4
+	return
+}
+
+// HashTreeRoot ssz hashes the SmallTestStruct object
+func (s *SmallTestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SmallTestStruct object with a hasher
+func (s *SmallTestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint16(s.A)
+
+	// Field (1) 'B'
+	hh.PutUint16(s.B)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SmallTestStruct object
+func (s *SmallTestStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// SSZSchema returns the ssz schema of the SmallTestStruct object
+func (s *SmallTestStruct) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "A", Tag: "", Schema: ssz.UintSchema(2)},
+			{Name: "B", Tag: "", Schema: ssz.UintSchema(2)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the SmallTestStruct object
+const (
+	SmallTestStruct_AGIndex = 2
+	SmallTestStruct_BGIndex = 3
+)
+
+// FromTree decodes the SmallTestStruct object from its tree
+func (s *SmallTestStruct) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'A'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 2)
+		if err != nil {
+			return err
+		}
+		s.A = ssz.UnmarshallUint16(buf)
+	}
+
+	// Field (1) 'B'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 2)
+		if err != nil {
+			return err
+		}
+		s.B = ssz.UnmarshallUint16(buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the FixedTestStruct object
+func (f *FixedTestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(f)
+}
+
+// MarshalSSZTo ssz marshals the FixedTestStruct object to a target array
+func (f *FixedTestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint8(dst, f.A)
+
+	// Field (1) 'B'
+	dst = ssz.MarshalUint64(dst, f.B)
+
+	// Field (2) 'C'
+	dst = ssz.MarshalUint32(dst, f.C)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the FixedTestStruct object
+func (f *FixedTestStruct) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 13 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'A'
+	f.A = ssz.UnmarshallUint8(buf[0:1])
+
+	// Field (1) 'B'
+	f.B = ssz.UnmarshallUint64(buf[1:9])
+
+	// Field (2) 'C'
+	f.C = ssz.UnmarshallUint32(buf[9:13])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the FixedTestStruct object
+func (f *FixedTestStruct) SizeSSZ() (size int) {
+	size = 13
+	return
+}
+
+// HashTreeRoot ssz hashes the FixedTestStruct object
+func (f *FixedTestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith ssz hashes the FixedTestStruct object with a hasher
+func (f *FixedTestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint8(f.A)
+
+	// Field (1) 'B'
+	hh.PutUint64(f.B)
+
+	// Field (2) 'C'
+	hh.PutUint32(f.C)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the FixedTestStruct object
+func (f *FixedTestStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(f)
+}
+
+// SSZSchema returns the ssz schema of the FixedTestStruct object
+func (f *FixedTestStruct) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "A", Tag: "", Schema: ssz.UintSchema(1)},
+			{Name: "B", Tag: "", Schema: ssz.UintSchema(8)},
+			{Name: "C", Tag: "", Schema: ssz.UintSchema(4)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the FixedTestStruct object
+const (
+	FixedTestStruct_AGIndex = 4
+	FixedTestStruct_BGIndex = 5
+	FixedTestStruct_CGIndex = 6
+)
+
+// FromTree decodes the FixedTestStruct object from its tree
+func (f *FixedTestStruct) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 3)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'A'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 1)
+		if err != nil {
+			return err
+		}
+		f.A = ssz.UnmarshallUint8(buf)
+	}
+
+	// Field (1) 'B'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 8)
+		if err != nil {
+			return err
+		}
+		f.B = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (2) 'C'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 4)
+		if err != nil {
+			return err
+		}
+		f.C = ssz.UnmarshallUint32(buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the VarTestStruct object
+func (v *VarTestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VarTestStruct object to a target array
+func (v *VarTestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(7)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint16(dst, v.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'C'
+	dst = ssz.MarshalUint8(dst, v.C)
+
+	// Field (1) 'B'
+	if size := len(v.B); size > 1024 {
+		err = ssz.ErrListTooBigFn("VarTestStruct.B", size, 1024)
+		return
+	}
+	for ii := 0; ii < len(v.B); ii++ {
+		dst = ssz.MarshalUint16(dst, v.B[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VarTestStruct object
+func (v *VarTestStruct) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 7 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'A'
+	v.A = ssz.UnmarshallUint16(buf[0:2])
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[2:6]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 != 7 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (2) 'C'
+	v.C = ssz.UnmarshallUint8(buf[6:7])
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 2, 1024)
+		if err != nil {
+			return err
+		}
+		v.B = ssz.ExtendUint16(v.B, num)
+		for ii := 0; ii < num; ii++ {
+			v.B[ii] = ssz.UnmarshallUint16(buf[ii*2 : (ii+1)*2])
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VarTestStruct object
+func (v *VarTestStruct) SizeSSZ() (size int) {
+	size = 7
+
+	// Field (1) 'B'
+	size += len(v.B) * 2
+
+	return
+}
+
+const VarTestStructMaxBSize = 1024
+
+// HashTreeRoot ssz hashes the VarTestStruct object
+func (v *VarTestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VarTestStruct object with a hasher
+func (v *VarTestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint16(v.A)
+
+	// Field (1) 'B'
+	{
+		if size := len(v.B); size > 1024 {
+			err = ssz.ErrListTooBigFn("VarTestStruct.B", size, 1024)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range v.B {
+			hh.Append(ssz.MarshalUint16(nil, i))
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(v.B))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1024, numItems, 2))
+	}
+
+	// Field (2) 'C'
+	hh.PutUint8(v.C)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VarTestStruct object
+func (v *VarTestStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// SSZSchema returns the ssz schema of the VarTestStruct object
+func (v *VarTestStruct) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "A", Tag: "", Schema: ssz.UintSchema(2)},
+			{Name: "B", Tag: "", Schema: ssz.ListSchema(ssz.UintSchema(2), 1024)},
+			{Name: "C", Tag: "", Schema: ssz.UintSchema(1)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VarTestStruct object
+const (
+	VarTestStruct_AGIndex = 4
+	VarTestStruct_BGIndex = 5
+	VarTestStruct_CGIndex = 6
+)
+
+// FromTree decodes the VarTestStruct object from its tree
+func (v *VarTestStruct) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 3)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'A'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 2)
+		if err != nil {
+			return err
+		}
+		v.A = ssz.UnmarshallUint16(buf)
+	}
+
+	// Field (1) 'B'
+	{
+		buf, err := ssz.TreeListPacked(nodes[1], 1024, 2)
+		if err != nil {
+			return err
+		}
+		num, err := ssz.DivideInt2(len(buf), 2, 1024)
+		if err != nil {
+			return err
+		}
+		v.B = ssz.ExtendUint16(v.B, num)
+		for ii := 0; ii < num; ii++ {
+			v.B[ii] = ssz.UnmarshallUint16(buf[ii*2 : (ii+1)*2])
+		}
+	}
+
+	// Field (2) 'C'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 1)
+		if err != nil {
+			return err
+		}
+		v.C = ssz.UnmarshallUint8(buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the ComplexTestStruct object
+func (c *ComplexTestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the ComplexTestStruct object to a target array
+func (c *ComplexTestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(71)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint16(dst, c.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.B) * 2
+
+	// Field (2) 'C'
+	dst = ssz.MarshalUint8(dst, c.C)
+
+	// Offset (3) 'D'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.D)
+
+	// Offset (4) 'E'
+	dst = ssz.WriteOffset(dst, offset)
+	if c.E == nil {
+		c.E = new(VarTestStruct)
+	}
+	offset += c.E.SizeSSZ()
+
+	// Field (5) 'F'
+	for ii := 0; ii < 4; ii++ {
+		if dst, err = c.F[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Offset (6) 'G'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'B'
+	if size := len(c.B); size > 128 {
+		err = ssz.ErrListTooBigFn("ComplexTestStruct.B", size, 128)
+		return
+	}
+	for ii := 0; ii < len(c.B); ii++ {
+		dst = ssz.MarshalUint16(dst, c.B[ii])
+	}
+
+	// Field (3) 'D'
+	if size := len(c.D); size > 256 {
+		err = ssz.ErrBytesLengthFn("ComplexTestStruct.D", size, 256)
+		return
+	}
+	dst = append(dst, c.D...)
+
+	// Field (4) 'E'
+	if dst, err = c.E.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (6) 'G'
+	{
+		offset = 4 * len(c.G)
+		for ii := 0; ii < len(c.G); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += c.G[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(c.G); ii++ {
+		if dst, err = c.G[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ComplexTestStruct object
+func (c *ComplexTestStruct) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 71 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o3, o4, o6 uint64
+
+	// Field (0) 'A'
+	c.A = ssz.UnmarshallUint16(buf[0:2])
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[2:6]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 != 71 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (2) 'C'
+	c.C = ssz.UnmarshallUint8(buf[6:7])
+
+	// Offset (3) 'D'
+	if o3 = ssz.ReadOffset(buf[7:11]); o3 > size || o1 > o3 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (4) 'E'
+	if o4 = ssz.ReadOffset(buf[11:15]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Field (5) 'F'
+
+	for ii := 0; ii < 4; ii++ {
+		if c.F[ii] == nil {
+			c.F[ii] = new(FixedTestStruct)
+		}
+		if err = c.F[ii].UnmarshalSSZ(buf[15:67][ii*13 : (ii+1)*13]); err != nil {
+			return err
+		}
+	}
+
+	// Offset (6) 'G'
+	if o6 = ssz.ReadOffset(buf[67:71]); o6 > size || o4 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:o3]
+		num, err := ssz.DivideInt2(len(buf), 2, 128)
+		if err != nil {
+			return err
+		}
+		c.B = ssz.ExtendUint16(c.B, num)
+		for ii := 0; ii < num; ii++ {
+			c.B[ii] = ssz.UnmarshallUint16(buf[ii*2 : (ii+1)*2])
+		}
+	}
+
+	// Field (3) 'D'
+	{
+		buf = tail[o3:o4]
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		if cap(c.D) == 0 {
+			c.D = make([]byte, 0, len(buf))
+		}
+		c.D = append(c.D, buf...)
+	}
+
+	// Field (4) 'E'
+	{
+		buf = tail[o4:o6]
+		if c.E == nil {
+			c.E = new(VarTestStruct)
+		}
+		if err = c.E.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'G'
+	{
+		buf = tail[o6:]
+		if len(buf) < 8 || ssz.ReadOffset(buf) != 8 {
+			return ssz.ErrInvalidVariableOffset
+		}
+
+		err = ssz.UnmarshalDynamic(buf, 2, func(indx int, buf []byte) (err error) {
+			if c.G[indx] == nil {
+				c.G[indx] = new(VarTestStruct)
+			}
+			if err = c.G[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ComplexTestStruct object
+func (c *ComplexTestStruct) SizeSSZ() (size int) {
+	size = 71
+
+	// Field (1) 'B'
+	size += len(c.B) * 2
+
+	// Field (3) 'D'
+	size += len(c.D)
+
+	// Field (4) 'E'
+	if c.E == nil {
+		c.E = new(VarTestStruct)
+	}
+	size += c.E.SizeSSZ()
+
+	// Field (6) 'G'
+	for ii := 0; ii < len(c.G); ii++ {
+		size += 4
+		size += c.G[ii].SizeSSZ()
+	}
+
+	return
+}
+
+const ComplexTestStructMaxBSize = 128
+const ComplexTestStructMaxDSize = 256
+const ComplexTestStructMaxESize = 0
+const ComplexTestStructMaxGSize = 2
+
+// HashTreeRoot ssz hashes the ComplexTestStruct object
+func (c *ComplexTestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the ComplexTestStruct object with a hasher
+func (c *ComplexTestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint16(c.A)
+
+	// Field (1) 'B'
+	{
+		if size := len(c.B); size > 128 {
+			err = ssz.ErrListTooBigFn("ComplexTestStruct.B", size, 128)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range c.B {
+			hh.Append(ssz.MarshalUint16(nil, i))
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(c.B))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(128, numItems, 2))
+	}
+
+	// Field (2) 'C'
+	hh.PutUint8(c.C)
+
+	// Field (3) 'D'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(c.D))
+		if byteLen > 256 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(c.D)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
+	}
+
+	// Field (4) 'E'
+	if err = c.E.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (5) 'F'
+	{
+		subIndx := hh.Index()
+		for _, elem := range c.F {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (6) 'G'
+	{
+		subIndx := hh.Index()
+		for _, elem := range c.G {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ComplexTestStruct object
+func (c *ComplexTestStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(c)
+}
+
+// SSZSchema returns the ssz schema of the ComplexTestStruct object
+func (c *ComplexTestStruct) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "A", Tag: "", Schema: ssz.UintSchema(2)},
+			{Name: "B", Tag: "", Schema: ssz.ListSchema(ssz.UintSchema(2), 128)},
+			{Name: "C", Tag: "", Schema: ssz.UintSchema(1)},
+			{Name: "D", Tag: "", Schema: ssz.ByteListSchema(256)},
+			{Name: "E", Tag: "", Schema: ssz.SchemaOf(new(VarTestStruct))},
+			{Name: "F", Tag: "", Schema: ssz.VectorSchema(ssz.SchemaOf(new(FixedTestStruct)), 4)},
+			{Name: "G", Tag: "", Schema: ssz.VectorSchema(ssz.SchemaOf(new(VarTestStruct)), 2)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the ComplexTestStruct object
+const (
+	ComplexTestStruct_AGIndex = 8
+	ComplexTestStruct_BGIndex = 9
+	ComplexTestStruct_CGIndex = 10
+	ComplexTestStruct_DGIndex = 11
+	ComplexTestStruct_EGIndex = 12
+	ComplexTestStruct_FGIndex = 13
+	ComplexTestStruct_GGIndex = 14
+)
+
+// FromTree decodes the ComplexTestStruct object from its tree
+func (c *ComplexTestStruct) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 7)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'A'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 2)
+		if err != nil {
+			return err
+		}
+		c.A = ssz.UnmarshallUint16(buf)
+	}
+
+	// Field (1) 'B'
+	{
+		buf, err := ssz.TreeListPacked(nodes[1], 128, 2)
+		if err != nil {
+			return err
+		}
+		num, err := ssz.DivideInt2(len(buf), 2, 128)
+		if err != nil {
+			return err
+		}
+		c.B = ssz.ExtendUint16(c.B, num)
+		for ii := 0; ii < num; ii++ {
+			c.B[ii] = ssz.UnmarshallUint16(buf[ii*2 : (ii+1)*2])
+		}
+	}
+
+	// Field (2) 'C'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 1)
+		if err != nil {
+			return err
+		}
+		c.C = ssz.UnmarshallUint8(buf)
+	}
+
+	// Field (3) 'D'
+	{
+		buf, err := ssz.TreeListPacked(nodes[3], 256, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		if cap(c.D) == 0 {
+			c.D = make([]byte, 0, len(buf))
+		}
+		c.D = append(c.D, buf...)
+	}
+
+	// Field (4) 'E'
+	{
+		if c.E == nil {
+			c.E = new(VarTestStruct)
+		}
+		if err := c.E.FromTree(nodes[4]); err != nil {
+			return err
+		}
+	}
+
+	// Field (5) 'F'
+	{
+		elems, err := ssz.TreeContainerFields(nodes[5], 4)
+		if err != nil {
+			return err
+		}
+
+		for indx, elem := range elems {
+			if c.F[indx] == nil {
+				c.F[indx] = new(FixedTestStruct)
+			}
+			if err := c.F[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (6) 'G'
+	{
+		elems, err := ssz.TreeContainerFields(nodes[6], 2)
+		if err != nil {
+			return err
+		}
+
+		for indx, elem := range elems {
+			if c.G[indx] == nil {
+				c.G[indx] = new(VarTestStruct)
+			}
+			if err := c.G[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the BitsStruct object
+func (b *BitsStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BitsStruct object to a target array
+func (b *BitsStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(11)
+
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.A)
+
+	// Field (1) 'B'
+	if size := len(b.B); size != 1 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.B", size, 1)
+		return
+	}
+	dst = append(dst, b.B...)
+
+	// Field (2) 'C'
+	if size := len(b.C); size != 1 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.C", size, 1)
+		return
+	}
+	dst = append(dst, b.C...)
+
+	// Offset (3) 'D'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (4) 'E'
+	if size := len(b.E); size != 1 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.E", size, 1)
+		return
+	}
+	dst = append(dst, b.E...)
+
+	// Field (0) 'A'
+	if size := len(b.A); size > 1 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.A", size, 1)
+		return
+	}
+	dst = append(dst, b.A...)
+
+	// Field (3) 'D'
+	if size := len(b.D); size > 1 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.D", size, 1)
+		return
+	}
+	dst = append(dst, b.D...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BitsStruct object
+func (b *BitsStruct) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 11 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o3 uint64
+
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 11 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'B'
+	if err = ssz.ValidateBitvector(buf[4:5], 2); err != nil {
+		return err
+	}
+	if cap(b.B) == 0 {
+		b.B = make([]byte, 0, len(buf[4:5]))
+	}
+	b.B = append(b.B, buf[4:5]...)
+
+	// Field (2) 'C'
+	if err = ssz.ValidateBitvector(buf[5:6], 1); err != nil {
+		return err
+	}
+	if cap(b.C) == 0 {
+		b.C = make([]byte, 0, len(buf[5:6]))
+	}
+	b.C = append(b.C, buf[5:6]...)
+
+	// Offset (3) 'D'
+	if o3 = ssz.ReadOffset(buf[6:10]); o3 > size || o0 > o3 {
+		return ssz.ErrOffset
+	}
+
+	// Field (4) 'E'
+	if cap(b.E) == 0 {
+		b.E = make([]byte, 0, len(buf[10:11]))
+	}
+	b.E = append(b.E, buf[10:11]...)
+
+	// Field (0) 'A'
+	{
+		buf = tail[o0:o3]
+		if err = ssz.ValidateBitlist(buf, 5); err != nil {
+			return err
+		}
+		if cap(b.A) == 0 {
+			b.A = make([]byte, 0, len(buf))
+		}
+		b.A = append(b.A, buf...)
+	}
+
+	// Field (3) 'D'
+	{
+		buf = tail[o3:]
+		if err = ssz.ValidateBitlist(buf, 6); err != nil {
+			return err
+		}
+		if cap(b.D) == 0 {
+			b.D = make([]byte, 0, len(buf))
+		}
+		b.D = append(b.D, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BitsStruct object
+func (b *BitsStruct) SizeSSZ() (size int) {
+	size = 11
+
+	// Field (0) 'A'
+	size += len(b.A)
+
+	// Field (3) 'D'
+	size += len(b.D)
+
+	return
+}
+
+const BitsStructMaxASize = 5
+const BitsStructMaxDSize = 6
+
+// HashTreeRoot ssz hashes the BitsStruct object
+func (b *BitsStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BitsStruct object with a hasher
+func (b *BitsStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	if len(b.A) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.A, 5)
+
+	// Field (1) 'B'
+	if size := len(b.B); size != 1 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.B", size, 1)
+		return
+	}
+	hh.PutBytes(b.B)
+
+	// Field (2) 'C'
+	if size := len(b.C); size != 1 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.C", size, 1)
+		return
+	}
+	hh.PutBytes(b.C)
+
+	// Field (3) 'D'
+	if len(b.D) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.D, 6)
+
+	// Field (4) 'E'
+	if size := len(b.E); size != 1 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.E", size, 1)
+		return
+	}
+	hh.PutBytes(b.E)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BitsStruct object
+func (b *BitsStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the BitsStruct object
+func (b *BitsStruct) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "A", Tag: "", Schema: ssz.BitlistSchema(5)},
+			{Name: "B", Tag: "", Schema: ssz.BytesSchema(1)},
+			{Name: "C", Tag: "", Schema: ssz.BytesSchema(1)},
+			{Name: "D", Tag: "", Schema: ssz.BitlistSchema(6)},
+			{Name: "E", Tag: "", Schema: ssz.BytesSchema(1)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the BitsStruct object
+const (
+	BitsStruct_AGIndex = 8
+	BitsStruct_BGIndex = 9
+	BitsStruct_CGIndex = 10
+	BitsStruct_DGIndex = 11
+	BitsStruct_EGIndex = 12
+)
+
+// FromTree decodes the BitsStruct object from its tree
+func (b *BitsStruct) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 5)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'A'
+	{
+		buf, err := ssz.TreeBitlist(nodes[0], 5)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitlist(buf, 5); err != nil {
+			return err
+		}
+		if cap(b.A) == 0 {
+			b.A = make([]byte, 0, len(buf))
+		}
+		b.A = append(b.A, buf...)
+	}
+
+	// Field (1) 'B'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 1)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitvector(buf, 2); err != nil {
+			return err
+		}
+		if cap(b.B) == 0 {
+			b.B = make([]byte, 0, len(buf))
+		}
+		b.B = append(b.B, buf...)
+	}
+
+	// Field (2) 'C'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 1)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitvector(buf, 1); err != nil {
+			return err
+		}
+		if cap(b.C) == 0 {
+			b.C = make([]byte, 0, len(buf))
+		}
+		b.C = append(b.C, buf...)
+	}
+
+	// Field (3) 'D'
+	{
+		buf, err := ssz.TreeBitlist(nodes[3], 6)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitlist(buf, 6); err != nil {
+			return err
+		}
+		if cap(b.D) == 0 {
+			b.D = make([]byte, 0, len(buf))
+		}
+		b.D = append(b.D, buf...)
+	}
+
+	// Field (4) 'E'
+	{
+		buf, err := ssz.TreeBytes(nodes[4], 1)
+		if err != nil {
+			return err
+		}
+		if cap(b.E) == 0 {
+			b.E = make([]byte, 0, len(buf))
+		}
+		b.E = append(b.E, buf...)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package generic
+
+import (
+	"encoding/binary"
+	"os"
+	"testing"
+
+	ssz "github.com/NilFoundation/fastssz"
+	"github.com/NilFoundation/fastssz/spectest"
+	"github.com/NilFoundation/fastssz/ssztest"
+)
+
+const testsPath = "../../eth2.0-spec-tests/tests"
+
+func TestSpecGeneric(t *testing.T) {
+	if _, err := os.Stat(testsPath + "/general"); os.IsNotExist(err) {
+		t.Skip("the general spec tests are not downloaded")
+	}
+	spectest.RunGenericTest(t, testsPath, Registry)
+}
+
+// the fixtures are a subset of the ssz_generic cases with the layout of
+// the spec tests, they are checked without downloading the spec tests
+const fixturesPath = "../fixtures"
+
+func TestSpecGeneric_Fixtures(t *testing.T) {
+	spectest.RunGenericTest(t, fixturesPath, Registry)
+}
+
+func complexTestStruct() *ComplexTestStruct {
+	obj := &ComplexTestStruct{
+		A: 0xaabb,
+		B: []uint16{0x1122, 0x3344},
+		C: 0xff,
+		D: []byte("foobar"),
+		E: &VarTestStruct{A: 0xabcd, B: []uint16{1, 2, 3}, C: 0xff},
+		G: [2]*VarTestStruct{
+			{A: 0xdead, B: []uint16{1, 2, 3}, C: 0x11},
+			{A: 0xbeef, B: []uint16{4, 5, 6}, C: 0x22},
+		},
+	}
+	for i := range obj.F {
+		obj.F[i] = &FixedTestStruct{A: uint8(i), B: uint64(i) << 32, C: uint32(i)}
+	}
+	return obj
+}
+
+func TestGeneric_RoundTrip(t *testing.T) {
+	bitvector := func(b ...byte) []byte { return b }
+
+	cases := []spectest.Object{
+		complexTestStruct(),
+		&BitsStruct{A: []byte{0x2b}, B: bitvector(0x02), C: bitvector(0x01), D: []byte{0x48}, E: bitvector(0xf0)},
+		&Bitvector513{Value: make([]byte, 65)},
+		&VecUint64x3{Value: [3]uint64{1, 2, 3}},
+	}
+	for _, obj := range cases {
+		buf, err := obj.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		root, err := obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		obj2 := newObject(obj)
+		if err := obj2.UnmarshalSSZ(buf); err != nil {
+			t.Fatalf("%T: %v", obj, err)
+		}
+		if !spectest.Equal(obj, obj2) {
+			t.Fatalf("%T: bad unmarshal", obj)
+		}
+
+		node, err := obj.GetTree()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(node.Hash()) != string(root[:]) {
+			t.Fatalf("%T: bad tree root %x %x", obj, node.Hash(), root)
+		}
+		obj3 := newObject(obj)
+		if err := ssz.DecodeTree(node, obj3.(ssz.TreeDecoder)); err != nil {
+			t.Fatalf("%T: %v", obj, err)
+		}
+		if !spectest.Equal(obj, obj3) {
+			t.Fatalf("%T: bad decode from tree", obj)
+		}
+	}
+}
+
+func TestGeneric_Differential(t *testing.T) {
+	cases := []ssztest.Object{
+		complexTestStruct(),
+		&BitsStruct{A: []byte{0x2b}, B: []byte{0x02}, C: []byte{0x01}, D: []byte{0x48}, E: []byte{0xf0}},
+		&Bitvector513{Value: make([]byte, 65)},
+		&VecUint64x3{Value: [3]uint64{1, 2, 3}},
+		&Boolean{Value: true},
+		&Uint16{Value: 0xabcd},
+	}
+	for _, obj := range cases {
+		ssztest.Differential(t, obj)
+	}
+}
+
+func newObject(obj spectest.Object) spectest.Object {
+	switch obj.(type) {
+	case *ComplexTestStruct:
+		return new(ComplexTestStruct)
+	case *BitsStruct:
+		return new(BitsStruct)
+	case *Bitvector513:
+		return new(Bitvector513)
+	case *VecUint64x3:
+		return new(VecUint64x3)
+	}
+	panic("unknown object")
+}
+
+func TestGeneric_NonCanonical(t *testing.T) {
+	complexBuf, err := complexTestStruct().MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the first offset of the vector G has to be its fixed size
+	badVector := append([]byte{}, complexBuf...)
+	badVector[binary.LittleEndian.Uint32(complexBuf[67:71])]++
+
+	cases := []struct {
+		name string
+		obj  spectest.Object
+		buf  []byte
+	}{
+		{"boolean 2", new(Boolean), []byte{2}},
+		{"boolean 0x80", new(Boolean), []byte{0x80}},
+		{"bitvector 2 padding", new(Bitvector2), []byte{0x04}},
+		{"bitvector 5 padding", new(Bitvector5), []byte{0x20}},
+		{"bitvector 8 short", new(Bitvector8), []byte{}},
+		{"bitvector 16 long", new(Bitvector16), []byte{0xff, 0xff, 0x00}},
+		{"bitlist empty", spectest.SingleField(func() spectest.Object { return new(Bitlist8) })(), []byte{}},
+		{"bitlist no delimiter", spectest.SingleField(func() spectest.Object { return new(Bitlist8) })(), []byte{0x00}},
+		{"bitlist too long", spectest.SingleField(func() spectest.Object { return new(Bitlist1) })(), []byte{0x07}},
+		{"container offset gap", new(VarTestStruct), []byte{0x01, 0x00, 0x08, 0x00, 0x00, 0x00, 0x02, 0xff}},
+		{"container offset short", new(VarTestStruct), []byte{0x01, 0x00, 0x06, 0x00, 0x00, 0x00, 0x02}},
+		{"container vector offset", new(ComplexTestStruct), badVector},
+		{"uint16 short", new(Uint16), []byte{0x01}},
+	}
+	for _, c := range cases {
+		if err := c.obj.UnmarshalSSZ(c.buf); err == nil {
+			t.Fatalf("%s: invalid encoding %x decoded without errors", c.name, c.buf)
+		}
+	}
+}
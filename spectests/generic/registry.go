@@ -0,0 +1,105 @@
+package generic
+
+import "github.com/NilFoundation/fastssz/spectest"
+
+// Registry are the types of the ssz_generic cases. The vectors of booleans and
+// uint128 values are not covered since sszgen cannot generate them.
+var Registry = spectest.GenericRegistry{
+	"uints": {
+		"uint_8":   func() spectest.Object { return new(Uint8) },
+		"uint_16":  func() spectest.Object { return new(Uint16) },
+		"uint_32":  func() spectest.Object { return new(Uint32) },
+		"uint_64":  func() spectest.Object { return new(Uint64) },
+		"uint_128": func() spectest.Object { return new(Uint128) },
+		"uint_256": func() spectest.Object { return new(Uint256) },
+	},
+	"boolean": {
+		"": func() spectest.Object { return new(Boolean) },
+	},
+	"basic_vector": {
+		"vec_uint8_1":     func() spectest.Object { return new(VecUint8x1) },
+		"vec_uint8_2":     func() spectest.Object { return new(VecUint8x2) },
+		"vec_uint8_3":     func() spectest.Object { return new(VecUint8x3) },
+		"vec_uint8_4":     func() spectest.Object { return new(VecUint8x4) },
+		"vec_uint8_5":     func() spectest.Object { return new(VecUint8x5) },
+		"vec_uint8_8":     func() spectest.Object { return new(VecUint8x8) },
+		"vec_uint8_16":    func() spectest.Object { return new(VecUint8x16) },
+		"vec_uint8_31":    func() spectest.Object { return new(VecUint8x31) },
+		"vec_uint8_512":   func() spectest.Object { return new(VecUint8x512) },
+		"vec_uint8_513":   func() spectest.Object { return new(VecUint8x513) },
+		"vec_uint16_1":    func() spectest.Object { return new(VecUint16x1) },
+		"vec_uint16_2":    func() spectest.Object { return new(VecUint16x2) },
+		"vec_uint16_3":    func() spectest.Object { return new(VecUint16x3) },
+		"vec_uint16_4":    func() spectest.Object { return new(VecUint16x4) },
+		"vec_uint16_5":    func() spectest.Object { return new(VecUint16x5) },
+		"vec_uint16_8":    func() spectest.Object { return new(VecUint16x8) },
+		"vec_uint16_16":   func() spectest.Object { return new(VecUint16x16) },
+		"vec_uint16_31":   func() spectest.Object { return new(VecUint16x31) },
+		"vec_uint16_512":  func() spectest.Object { return new(VecUint16x512) },
+		"vec_uint16_513":  func() spectest.Object { return new(VecUint16x513) },
+		"vec_uint32_1":    func() spectest.Object { return new(VecUint32x1) },
+		"vec_uint32_2":    func() spectest.Object { return new(VecUint32x2) },
+		"vec_uint32_3":    func() spectest.Object { return new(VecUint32x3) },
+		"vec_uint32_4":    func() spectest.Object { return new(VecUint32x4) },
+		"vec_uint32_5":    func() spectest.Object { return new(VecUint32x5) },
+		"vec_uint32_8":    func() spectest.Object { return new(VecUint32x8) },
+		"vec_uint32_16":   func() spectest.Object { return new(VecUint32x16) },
+		"vec_uint32_31":   func() spectest.Object { return new(VecUint32x31) },
+		"vec_uint32_512":  func() spectest.Object { return new(VecUint32x512) },
+		"vec_uint32_513":  func() spectest.Object { return new(VecUint32x513) },
+		"vec_uint64_1":    func() spectest.Object { return new(VecUint64x1) },
+		"vec_uint64_2":    func() spectest.Object { return new(VecUint64x2) },
+		"vec_uint64_3":    func() spectest.Object { return new(VecUint64x3) },
+		"vec_uint64_4":    func() spectest.Object { return new(VecUint64x4) },
+		"vec_uint64_5":    func() spectest.Object { return new(VecUint64x5) },
+		"vec_uint64_8":    func() spectest.Object { return new(VecUint64x8) },
+		"vec_uint64_16":   func() spectest.Object { return new(VecUint64x16) },
+		"vec_uint64_31":   func() spectest.Object { return new(VecUint64x31) },
+		"vec_uint64_512":  func() spectest.Object { return new(VecUint64x512) },
+		"vec_uint64_513":  func() spectest.Object { return new(VecUint64x513) },
+		"vec_uint256_1":   func() spectest.Object { return new(VecUint256x1) },
+		"vec_uint256_2":   func() spectest.Object { return new(VecUint256x2) },
+		"vec_uint256_3":   func() spectest.Object { return new(VecUint256x3) },
+		"vec_uint256_4":   func() spectest.Object { return new(VecUint256x4) },
+		"vec_uint256_5":   func() spectest.Object { return new(VecUint256x5) },
+		"vec_uint256_8":   func() spectest.Object { return new(VecUint256x8) },
+		"vec_uint256_16":  func() spectest.Object { return new(VecUint256x16) },
+		"vec_uint256_31":  func() spectest.Object { return new(VecUint256x31) },
+		"vec_uint256_512": func() spectest.Object { return new(VecUint256x512) },
+		"vec_uint256_513": func() spectest.Object { return new(VecUint256x513) },
+	},
+	"bitvector": {
+		"bitvec_1":   func() spectest.Object { return new(Bitvector1) },
+		"bitvec_2":   func() spectest.Object { return new(Bitvector2) },
+		"bitvec_3":   func() spectest.Object { return new(Bitvector3) },
+		"bitvec_4":   func() spectest.Object { return new(Bitvector4) },
+		"bitvec_5":   func() spectest.Object { return new(Bitvector5) },
+		"bitvec_8":   func() spectest.Object { return new(Bitvector8) },
+		"bitvec_16":  func() spectest.Object { return new(Bitvector16) },
+		"bitvec_31":  func() spectest.Object { return new(Bitvector31) },
+		"bitvec_512": func() spectest.Object { return new(Bitvector512) },
+		"bitvec_513": func() spectest.Object { return new(Bitvector513) },
+	},
+	"bitlist": {
+		"bitlist_1":   spectest.SingleField(func() spectest.Object { return new(Bitlist1) }),
+		"bitlist_2":   spectest.SingleField(func() spectest.Object { return new(Bitlist2) }),
+		"bitlist_3":   spectest.SingleField(func() spectest.Object { return new(Bitlist3) }),
+		"bitlist_4":   spectest.SingleField(func() spectest.Object { return new(Bitlist4) }),
+		"bitlist_5":   spectest.SingleField(func() spectest.Object { return new(Bitlist5) }),
+		"bitlist_8":   spectest.SingleField(func() spectest.Object { return new(Bitlist8) }),
+		"bitlist_16":  spectest.SingleField(func() spectest.Object { return new(Bitlist16) }),
+		"bitlist_31":  spectest.SingleField(func() spectest.Object { return new(Bitlist31) }),
+		"bitlist_512": spectest.SingleField(func() spectest.Object { return new(Bitlist512) }),
+		"bitlist_513": spectest.SingleField(func() spectest.Object { return new(Bitlist513) }),
+		// the cases without the length bit are invalid for any limit
+		"bitlist_no_delimiter": spectest.SingleField(func() spectest.Object { return new(Bitlist8) }),
+	},
+	"containers": {
+		"SingleFieldTestStruct": func() spectest.Object { return new(SingleFieldTestStruct) },
+		"SmallTestStruct":       func() spectest.Object { return new(SmallTestStruct) },
+		"FixedTestStruct":       func() spectest.Object { return new(FixedTestStruct) },
+		"VarTestStruct":         func() spectest.Object { return new(VarTestStruct) },
+		"ComplexTestStruct":     func() spectest.Object { return new(ComplexTestStruct) },
+		"BitsStruct":            func() spectest.Object { return new(BitsStruct) },
+	},
+}
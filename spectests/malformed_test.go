@@ -0,0 +1,19 @@
+package spectests
+
+import (
+	"testing"
+
+	"github.com/NilFoundation/fastssz/ssztest"
+)
+
+func TestMalformed(t *testing.T) {
+	for name, codec := range codecs {
+		if name == "BeaconState" {
+			continue
+		}
+		codec := codec
+		t.Run(name, func(t *testing.T) {
+			ssztest.Malformed(t, func() ssztest.Object { return codec("phase0") })
+		})
+	}
+}
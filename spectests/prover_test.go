@@ -0,0 +1,170 @@
+package spectests
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	ssz "github.com/NilFoundation/fastssz"
+	"github.com/NilFoundation/fastssz/fuzz"
+)
+
+func TestProver_BeaconBlockBody(t *testing.T) {
+	body := new(BeaconBlockBodyPhase0)
+	fuzz.NewWithSeed(1).Fuzz(body)
+
+	// the seed fills the lists of the paths
+	if len(body.Attestations) == 0 || len(body.AttesterSlashings) == 0 || len(body.Deposits) == 0 {
+		t.Fatal("the random body has empty lists")
+	}
+	testProver(t, body, 100, []string{
+		"eth1_data.deposit_root",
+		"graffiti",
+		// lists of containers, with the length mixin and the padding
+		"attestations.__len__",
+		"attestations[0]",
+		"attestations[127]",
+		"deposits[0].Proof[32]",
+		// bitlists in a list of containers
+		"attestations[0].aggregation_bits",
+		"attestations[0].aggregation_bits.__len__",
+		"attestations[0].aggregation_bits[0]",
+		// packed basic lists in nested containers
+		"attester_slashings[0].attestation_1.attesting_indices[0]",
+		"attester_slashings[0].attestation_1.attesting_indices[2047]",
+		"attester_slashings[0].attestation_2.attesting_indices.__len__",
+		"attester_slashings[0].attestation_2.data.target.root",
+		"voluntary_exits.__len__",
+	})
+}
+
+func TestProver_BeaconState(t *testing.T) {
+	state := new(BeaconState)
+	fuzz.NewWithSeed(1).Fuzz(state)
+
+	if len(state.Validators) == 0 || len(state.Balances) == 0 || len(state.PreviousEpochAttestations) == 0 {
+		t.Fatal("the random state has empty lists")
+	}
+	// the state is hashed again for each proof
+	testProver(t, state, 20, []string{
+		"latest_block_header.body_root",
+		"fork.epoch",
+		"justification_bits",
+		"finalized_checkpoint.root",
+		// vectors of roots and of packed uint64 values
+		"block_roots[8191]",
+		"randao_mixes[0]",
+		"slashings[8191]",
+		// lists of containers and packed basic lists with deep limits
+		"validators[0].pubkey",
+		"validators[0].effective_balance",
+		"validators.__len__",
+		"balances[0]",
+		"balances.__len__",
+		"historical_roots.__len__",
+		"eth1_data_votes[0].block_hash",
+		// bitlists in a list of containers
+		"previous_epoch_attestations[0].aggregation_bits",
+		"previous_epoch_attestations[0].aggregation_bits.__len__",
+		"current_epoch_attestations.__len__",
+	})
+}
+
+// testProver checks that the proofs of the prover are the proofs of the tree of the
+// object for the nodes of the paths and for a number of random nodes of the tree,
+// alone and with multiproofs that share their branches
+func testProver(t *testing.T, obj interface {
+	ssz.HashRoot
+	ssz.SchemaProvider
+}, random int, paths []string) {
+	t.Helper()
+
+	tree, err := obj.GetTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := tree.Hash()
+
+	indices := []int{}
+	for _, path := range paths {
+		indx, err := ssz.GeneralizedIndex(obj, path)
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		indices = append(indices, indx)
+	}
+	// the random nodes down to the leaves of the tree
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < random; i++ {
+		indx := 1
+		for {
+			child := 2*indx + r.Intn(2)
+			if _, err := tree.Get(child); err != nil {
+				break
+			}
+			indx = child
+		}
+		indices = append(indices, indx)
+	}
+
+	for _, indx := range indices {
+		expected, err := tree.Prove(indx)
+		if err != nil {
+			t.Fatalf("index %d: %v", indx, err)
+		}
+		proof, err := ssz.Prove(obj, indx)
+		if err != nil {
+			t.Fatalf("index %d: %v", indx, err)
+		}
+		if !reflect.DeepEqual(expected, proof) {
+			t.Fatalf("proof mismatch for index %d", indx)
+		}
+	}
+
+	multi := [][]int{indices[:len(paths)]}
+	for i := 0; i < 20; i++ {
+		targets := []int{}
+		for j := 0; j < 1+r.Intn(8); j++ {
+			targets = append(targets, indices[r.Intn(len(indices))])
+		}
+		multi = append(multi, targets)
+	}
+	for _, targets := range multi {
+		targets = uniqueIndices(targets)
+		expected, err := tree.ProveMulti(targets)
+		if err != nil {
+			t.Fatalf("indices %v: %v", targets, err)
+		}
+		proof, err := ssz.ProveMulti(obj, targets)
+		if err != nil {
+			t.Fatalf("indices %v: %v", targets, err)
+		}
+		if !reflect.DeepEqual(expected, proof) {
+			t.Fatalf("multiproof mismatch for indices %v", targets)
+		}
+		if ok, err := ssz.VerifyMultiproof(root, proof.Hashes, proof.Leaves, proof.Indices); err != nil || !ok {
+			t.Fatalf("failed to verify multiproof for indices %v: %v", targets, err)
+		}
+	}
+}
+
+// uniqueIndices removes the repeated indices and the indices
+// whose ancestor is in the indices, which cannot be proved together
+func uniqueIndices(indices []int) []int {
+	res := []int{}
+	for _, indx := range indices {
+		dup := false
+		for _, other := range indices {
+			for anc := indx; anc > 1 && !dup; anc /= 2 {
+				dup = anc/2 == other
+			}
+		}
+		for _, other := range res {
+			dup = dup || other == indx
+		}
+		if !dup {
+			res = append(res, indx)
+		}
+	}
+	return res
+}
@@ -53,7 +53,7 @@ func (a *AggregateAndProof) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o1 < 108 {
+	if o1 != 108 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -117,6 +117,62 @@ func (a *AggregateAndProof) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(a)
 }
 
+// SSZSchema returns the ssz schema of the AggregateAndProof object
+func (a *AggregateAndProof) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Index", Tag: "aggregator_index", Schema: ssz.UintSchema(8)},
+			{Name: "Aggregate", Tag: "aggregate", Schema: ssz.SchemaOf(new(Attestation))},
+			{Name: "SelectionProof", Tag: "selection_proof", Schema: ssz.BytesSchema(96)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the AggregateAndProof object
+const (
+	AggregateAndProof_IndexGIndex          = 4
+	AggregateAndProof_AggregateGIndex      = 5
+	AggregateAndProof_SelectionProofGIndex = 6
+)
+
+// FromTree decodes the AggregateAndProof object from its tree
+func (a *AggregateAndProof) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 3)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Index'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		a.Index = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'Aggregate'
+	{
+		if a.Aggregate == nil {
+			a.Aggregate = new(Attestation)
+		}
+		if err := a.Aggregate.FromTree(nodes[1]); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'SelectionProof'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 96)
+		if err != nil {
+			return err
+		}
+		copy(a.SelectionProof[:], buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Checkpoint object
 func (c *Checkpoint) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -193,6 +249,53 @@ func (c *Checkpoint) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
 
+// SSZSchema returns the ssz schema of the Checkpoint object
+func (c *Checkpoint) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Epoch", Tag: "epoch", Schema: ssz.UintSchema(8)},
+			{Name: "Root", Tag: "root", Schema: ssz.BytesSchema(32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Checkpoint object
+const (
+	Checkpoint_EpochGIndex = 2
+	Checkpoint_RootGIndex  = 3
+)
+
+// FromTree decodes the Checkpoint object from its tree
+func (c *Checkpoint) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Epoch'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		c.Epoch = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'Root'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 32)
+		if err != nil {
+			return err
+		}
+		if cap(c.Root) == 0 {
+			c.Root = make([]byte, 0, len(buf))
+		}
+		c.Root = append(c.Root, buf...)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the AttestationData object
 func (a *AttestationData) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(a)
@@ -315,6 +418,85 @@ func (a *AttestationData) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(a)
 }
 
+// SSZSchema returns the ssz schema of the AttestationData object
+func (a *AttestationData) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Slot", Tag: "slot", Schema: ssz.UintSchema(8)},
+			{Name: "Index", Tag: "index", Schema: ssz.UintSchema(8)},
+			{Name: "BeaconBlockHash", Tag: "beacon_block_root", Schema: ssz.BytesSchema(32)},
+			{Name: "Source", Tag: "source", Schema: ssz.SchemaOf(new(Checkpoint))},
+			{Name: "Target", Tag: "target", Schema: ssz.SchemaOf(new(Checkpoint))},
+		},
+	}
+}
+
+// Generalized indices of the fields of the AttestationData object
+const (
+	AttestationData_SlotGIndex            = 8
+	AttestationData_IndexGIndex           = 9
+	AttestationData_BeaconBlockHashGIndex = 10
+	AttestationData_SourceGIndex          = 11
+	AttestationData_TargetGIndex          = 12
+)
+
+// FromTree decodes the AttestationData object from its tree
+func (a *AttestationData) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 5)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Slot'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		a.Slot = Slot(ssz.UnmarshallUint64(buf))
+	}
+
+	// Field (1) 'Index'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 8)
+		if err != nil {
+			return err
+		}
+		a.Index = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (2) 'BeaconBlockHash'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 32)
+		if err != nil {
+			return err
+		}
+		copy(a.BeaconBlockHash[:], buf)
+	}
+
+	// Field (3) 'Source'
+	{
+		if a.Source == nil {
+			a.Source = new(Checkpoint)
+		}
+		if err := a.Source.FromTree(nodes[3]); err != nil {
+			return err
+		}
+	}
+
+	// Field (4) 'Target'
+	{
+		if a.Target == nil {
+			a.Target = new(Checkpoint)
+		}
+		if err := a.Target.FromTree(nodes[4]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Attestation object
 func (a *Attestation) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(a)
@@ -340,8 +522,8 @@ func (a *Attestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = append(dst, a.Signature[:]...)
 
 	// Field (0) 'AggregationBits'
-	if size := len(a.AggregationBits); size > 2048 {
-		err = ssz.ErrBytesLengthFn("Attestation.AggregationBits", size, 2048)
+	if size := len(a.AggregationBits); size > 257 {
+		err = ssz.ErrBytesLengthFn("Attestation.AggregationBits", size, 257)
 		return
 	}
 	dst = append(dst, a.AggregationBits...)
@@ -365,7 +547,7 @@ func (a *Attestation) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 228 {
+	if o0 != 228 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -442,6 +624,68 @@ func (a *Attestation) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(a)
 }
 
+// SSZSchema returns the ssz schema of the Attestation object
+func (a *Attestation) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "AggregationBits", Tag: "aggregation_bits", Schema: ssz.BitlistSchema(2048)},
+			{Name: "Data", Tag: "data", Schema: ssz.SchemaOf(new(AttestationData))},
+			{Name: "Signature", Tag: "signature", Schema: ssz.BytesSchema(96)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Attestation object
+const (
+	Attestation_AggregationBitsGIndex = 4
+	Attestation_DataGIndex            = 5
+	Attestation_SignatureGIndex       = 6
+)
+
+// FromTree decodes the Attestation object from its tree
+func (a *Attestation) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 3)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'AggregationBits'
+	{
+		buf, err := ssz.TreeBitlist(nodes[0], 2048)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		if cap(a.AggregationBits) == 0 {
+			a.AggregationBits = make([]byte, 0, len(buf))
+		}
+		a.AggregationBits = append(a.AggregationBits, buf...)
+	}
+
+	// Field (1) 'Data'
+	{
+		if a.Data == nil {
+			a.Data = new(AttestationData)
+		}
+		if err := a.Data.FromTree(nodes[1]); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'Signature'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 96)
+		if err != nil {
+			return err
+		}
+		copy(a.Signature[:], buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the DepositData object
 func (d *DepositData) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(d)
@@ -536,6 +780,75 @@ func (d *DepositData) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(d)
 }
 
+// SSZSchema returns the ssz schema of the DepositData object
+func (d *DepositData) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Pubkey", Tag: "pubkey", Schema: ssz.BytesSchema(48)},
+			{Name: "WithdrawalCredentials", Tag: "withdrawal_credentials", Schema: ssz.BytesSchema(32)},
+			{Name: "Amount", Tag: "amount", Schema: ssz.UintSchema(8)},
+			{Name: "Signature", Tag: "signature", Schema: ssz.BytesSchema(96)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the DepositData object
+const (
+	DepositData_PubkeyGIndex                = 4
+	DepositData_WithdrawalCredentialsGIndex = 5
+	DepositData_AmountGIndex                = 6
+	DepositData_SignatureGIndex             = 7
+)
+
+// FromTree decodes the DepositData object from its tree
+func (d *DepositData) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 4)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Pubkey'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 48)
+		if err != nil {
+			return err
+		}
+		copy(d.Pubkey[:], buf)
+	}
+
+	// Field (1) 'WithdrawalCredentials'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 32)
+		if err != nil {
+			return err
+		}
+		copy(d.WithdrawalCredentials[:], buf)
+	}
+
+	// Field (2) 'Amount'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 8)
+		if err != nil {
+			return err
+		}
+		d.Amount = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (3) 'Signature'
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 96)
+		if err != nil {
+			return err
+		}
+		if cap(d.Signature) == 0 {
+			d.Signature = make([]byte, 0, len(buf))
+		}
+		d.Signature = append(d.Signature, buf...)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Deposit object
 func (d *Deposit) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(d)
@@ -646,6 +959,57 @@ func (d *Deposit) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(d)
 }
 
+// SSZSchema returns the ssz schema of the Deposit object
+func (d *Deposit) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Proof", Tag: "", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 33)},
+			{Name: "Data", Tag: "", Schema: ssz.SchemaOf(new(DepositData))},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Deposit object
+const (
+	Deposit_ProofGIndex = 2
+	Deposit_DataGIndex  = 3
+)
+
+// FromTree decodes the Deposit object from its tree
+func (d *Deposit) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Proof'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[0], 33, 32)
+		if err != nil {
+			return err
+		}
+		d.Proof = make([][]byte, 33)
+		for ii := 0; ii < 33; ii++ {
+			if cap(d.Proof[ii]) == 0 {
+				d.Proof[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			d.Proof[ii] = append(d.Proof[ii], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+
+	// Field (1) 'Data'
+	{
+		if d.Data == nil {
+			d.Data = new(DepositData)
+		}
+		if err := d.Data.FromTree(nodes[1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the DepositMessage object
 func (d *DepositMessage) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(d)
@@ -742,6 +1106,67 @@ func (d *DepositMessage) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(d)
 }
 
+// SSZSchema returns the ssz schema of the DepositMessage object
+func (d *DepositMessage) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Pubkey", Tag: "pubkey", Schema: ssz.BytesSchema(48)},
+			{Name: "WithdrawalCredentials", Tag: "withdrawal_credentials", Schema: ssz.BytesSchema(32)},
+			{Name: "Amount", Tag: "amount", Schema: ssz.UintSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the DepositMessage object
+const (
+	DepositMessage_PubkeyGIndex                = 4
+	DepositMessage_WithdrawalCredentialsGIndex = 5
+	DepositMessage_AmountGIndex                = 6
+)
+
+// FromTree decodes the DepositMessage object from its tree
+func (d *DepositMessage) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 3)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Pubkey'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 48)
+		if err != nil {
+			return err
+		}
+		if cap(d.Pubkey) == 0 {
+			d.Pubkey = make([]byte, 0, len(buf))
+		}
+		d.Pubkey = append(d.Pubkey, buf...)
+	}
+
+	// Field (1) 'WithdrawalCredentials'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 32)
+		if err != nil {
+			return err
+		}
+		if cap(d.WithdrawalCredentials) == 0 {
+			d.WithdrawalCredentials = make([]byte, 0, len(buf))
+		}
+		d.WithdrawalCredentials = append(d.WithdrawalCredentials, buf...)
+	}
+
+	// Field (2) 'Amount'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 8)
+		if err != nil {
+			return err
+		}
+		d.Amount = ssz.UnmarshallUint64(buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the IndexedAttestation object
 func (i *IndexedAttestation) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(i)
@@ -798,7 +1223,7 @@ func (i *IndexedAttestation) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 228 {
+	if o0 != 228 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -891,36 +1316,102 @@ func (i *IndexedAttestation) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
 
-// MarshalSSZ ssz marshals the PendingAttestation object
-func (p *PendingAttestation) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(p)
+// SSZSchema returns the ssz schema of the IndexedAttestation object
+func (i *IndexedAttestation) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "AttestationIndices", Tag: "attesting_indices", Schema: ssz.ListSchema(ssz.UintSchema(8), 2048)},
+			{Name: "Data", Tag: "data", Schema: ssz.SchemaOf(new(AttestationData))},
+			{Name: "Signature", Tag: "signature", Schema: ssz.BytesSchema(96)},
+		},
+	}
 }
 
-// MarshalSSZTo ssz marshals the PendingAttestation object to a target array
-func (p *PendingAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(148)
-
-	// Offset (0) 'AggregationBits'
-	dst = ssz.WriteOffset(dst, offset)
+// Generalized indices of the fields of the IndexedAttestation object
+const (
+	IndexedAttestation_AttestationIndicesGIndex = 4
+	IndexedAttestation_DataGIndex               = 5
+	IndexedAttestation_SignatureGIndex          = 6
+)
 
-	// Field (1) 'Data'
-	if p.Data == nil {
-		p.Data = new(AttestationData)
-	}
-	if dst, err = p.Data.MarshalSSZTo(dst); err != nil {
-		return
+// FromTree decodes the IndexedAttestation object from its tree
+func (i *IndexedAttestation) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 3)
+	if err != nil {
+		return err
 	}
-
-	// Field (2) 'InclusionDelay'
-	dst = ssz.MarshalUint64(dst, p.InclusionDelay)
-
-	// Field (3) 'ProposerIndex'
+	// Field (0) 'AttestationIndices'
+	{
+		buf, err := ssz.TreeListPacked(nodes[0], 2048, 8)
+		if err != nil {
+			return err
+		}
+		num, err := ssz.DivideInt2(len(buf), 8, 2048)
+		if err != nil {
+			return err
+		}
+		i.AttestationIndices = ssz.ExtendUint64(i.AttestationIndices, num)
+		for ii := 0; ii < num; ii++ {
+			i.AttestationIndices[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (1) 'Data'
+	{
+		if i.Data == nil {
+			i.Data = new(AttestationData)
+		}
+		if err := i.Data.FromTree(nodes[1]); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'Signature'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 96)
+		if err != nil {
+			return err
+		}
+		if cap(i.Signature) == 0 {
+			i.Signature = make([]byte, 0, len(buf))
+		}
+		i.Signature = append(i.Signature, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the PendingAttestation object
+func (p *PendingAttestation) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the PendingAttestation object to a target array
+func (p *PendingAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(148)
+
+	// Offset (0) 'AggregationBits'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Data'
+	if p.Data == nil {
+		p.Data = new(AttestationData)
+	}
+	if dst, err = p.Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'InclusionDelay'
+	dst = ssz.MarshalUint64(dst, p.InclusionDelay)
+
+	// Field (3) 'ProposerIndex'
 	dst = ssz.MarshalUint64(dst, p.ProposerIndex)
 
 	// Field (0) 'AggregationBits'
-	if size := len(p.AggregationBits); size > 2048 {
-		err = ssz.ErrBytesLengthFn("PendingAttestation.AggregationBits", size, 2048)
+	if size := len(p.AggregationBits); size > 257 {
+		err = ssz.ErrBytesLengthFn("PendingAttestation.AggregationBits", size, 257)
 		return
 	}
 	dst = append(dst, p.AggregationBits...)
@@ -944,7 +1435,7 @@ func (p *PendingAttestation) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 148 {
+	if o0 != 148 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -1027,6 +1518,79 @@ func (p *PendingAttestation) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(p)
 }
 
+// SSZSchema returns the ssz schema of the PendingAttestation object
+func (p *PendingAttestation) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "AggregationBits", Tag: "aggregation_bits", Schema: ssz.BitlistSchema(2048)},
+			{Name: "Data", Tag: "data", Schema: ssz.SchemaOf(new(AttestationData))},
+			{Name: "InclusionDelay", Tag: "inclusion_delay", Schema: ssz.UintSchema(8)},
+			{Name: "ProposerIndex", Tag: "proposer_index", Schema: ssz.UintSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the PendingAttestation object
+const (
+	PendingAttestation_AggregationBitsGIndex = 4
+	PendingAttestation_DataGIndex            = 5
+	PendingAttestation_InclusionDelayGIndex  = 6
+	PendingAttestation_ProposerIndexGIndex   = 7
+)
+
+// FromTree decodes the PendingAttestation object from its tree
+func (p *PendingAttestation) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 4)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'AggregationBits'
+	{
+		buf, err := ssz.TreeBitlist(nodes[0], 2048)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		if cap(p.AggregationBits) == 0 {
+			p.AggregationBits = make([]byte, 0, len(buf))
+		}
+		p.AggregationBits = append(p.AggregationBits, buf...)
+	}
+
+	// Field (1) 'Data'
+	{
+		if p.Data == nil {
+			p.Data = new(AttestationData)
+		}
+		if err := p.Data.FromTree(nodes[1]); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'InclusionDelay'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 8)
+		if err != nil {
+			return err
+		}
+		p.InclusionDelay = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (3) 'ProposerIndex'
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 8)
+		if err != nil {
+			return err
+		}
+		p.ProposerIndex = ssz.UnmarshallUint64(buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Fork object
 func (f *Fork) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(f)
@@ -1123,6 +1687,67 @@ func (f *Fork) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(f)
 }
 
+// SSZSchema returns the ssz schema of the Fork object
+func (f *Fork) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "PreviousVersion", Tag: "previous_version", Schema: ssz.BytesSchema(4)},
+			{Name: "CurrentVersion", Tag: "current_version", Schema: ssz.BytesSchema(4)},
+			{Name: "Epoch", Tag: "epoch", Schema: ssz.UintSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Fork object
+const (
+	Fork_PreviousVersionGIndex = 4
+	Fork_CurrentVersionGIndex  = 5
+	Fork_EpochGIndex           = 6
+)
+
+// FromTree decodes the Fork object from its tree
+func (f *Fork) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 3)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'PreviousVersion'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 4)
+		if err != nil {
+			return err
+		}
+		if cap(f.PreviousVersion) == 0 {
+			f.PreviousVersion = make([]byte, 0, len(buf))
+		}
+		f.PreviousVersion = append(f.PreviousVersion, buf...)
+	}
+
+	// Field (1) 'CurrentVersion'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 4)
+		if err != nil {
+			return err
+		}
+		if cap(f.CurrentVersion) == 0 {
+			f.CurrentVersion = make([]byte, 0, len(buf))
+		}
+		f.CurrentVersion = append(f.CurrentVersion, buf...)
+	}
+
+	// Field (2) 'Epoch'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 8)
+		if err != nil {
+			return err
+		}
+		f.Epoch = ssz.UnmarshallUint64(buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Validator object
 func (v *Validator) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(v)
@@ -1191,6 +1816,9 @@ func (v *Validator) UnmarshalSSZ(buf []byte) error {
 	v.EffectiveBalance = ssz.UnmarshallUint64(buf[80:88])
 
 	// Field (3) 'Slashed'
+	if err = ssz.ValidateBool(buf[88:89]); err != nil {
+		return err
+	}
 	v.Slashed = ssz.UnmarshalBool(buf[88:89])
 
 	// Field (4) 'ActivationEligibilityEpoch'
@@ -1264,6 +1892,125 @@ func (v *Validator) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(v)
 }
 
+// SSZSchema returns the ssz schema of the Validator object
+func (v *Validator) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Pubkey", Tag: "pubkey", Schema: ssz.BytesSchema(48)},
+			{Name: "WithdrawalCredentials", Tag: "withdrawal_credentials", Schema: ssz.BytesSchema(32)},
+			{Name: "EffectiveBalance", Tag: "effective_balance", Schema: ssz.UintSchema(8)},
+			{Name: "Slashed", Tag: "slashed", Schema: ssz.BoolSchema()},
+			{Name: "ActivationEligibilityEpoch", Tag: "activation_eligibility_epoch", Schema: ssz.UintSchema(8)},
+			{Name: "ActivationEpoch", Tag: "activation_epoch", Schema: ssz.UintSchema(8)},
+			{Name: "ExitEpoch", Tag: "exit_epoch", Schema: ssz.UintSchema(8)},
+			{Name: "WithdrawableEpoch", Tag: "withdrawable_epoch", Schema: ssz.UintSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Validator object
+const (
+	Validator_PubkeyGIndex                     = 8
+	Validator_WithdrawalCredentialsGIndex      = 9
+	Validator_EffectiveBalanceGIndex           = 10
+	Validator_SlashedGIndex                    = 11
+	Validator_ActivationEligibilityEpochGIndex = 12
+	Validator_ActivationEpochGIndex            = 13
+	Validator_ExitEpochGIndex                  = 14
+	Validator_WithdrawableEpochGIndex          = 15
+)
+
+// FromTree decodes the Validator object from its tree
+func (v *Validator) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 8)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Pubkey'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 48)
+		if err != nil {
+			return err
+		}
+		if cap(v.Pubkey) == 0 {
+			v.Pubkey = make([]byte, 0, len(buf))
+		}
+		v.Pubkey = append(v.Pubkey, buf...)
+	}
+
+	// Field (1) 'WithdrawalCredentials'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 32)
+		if err != nil {
+			return err
+		}
+		if cap(v.WithdrawalCredentials) == 0 {
+			v.WithdrawalCredentials = make([]byte, 0, len(buf))
+		}
+		v.WithdrawalCredentials = append(v.WithdrawalCredentials, buf...)
+	}
+
+	// Field (2) 'EffectiveBalance'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 8)
+		if err != nil {
+			return err
+		}
+		v.EffectiveBalance = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (3) 'Slashed'
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 1)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBool(buf); err != nil {
+			return err
+		}
+		v.Slashed = ssz.UnmarshalBool(buf)
+	}
+
+	// Field (4) 'ActivationEligibilityEpoch'
+	{
+		buf, err := ssz.TreeBytes(nodes[4], 8)
+		if err != nil {
+			return err
+		}
+		v.ActivationEligibilityEpoch = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (5) 'ActivationEpoch'
+	{
+		buf, err := ssz.TreeBytes(nodes[5], 8)
+		if err != nil {
+			return err
+		}
+		v.ActivationEpoch = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (6) 'ExitEpoch'
+	{
+		buf, err := ssz.TreeBytes(nodes[6], 8)
+		if err != nil {
+			return err
+		}
+		v.ExitEpoch = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (7) 'WithdrawableEpoch'
+	{
+		buf, err := ssz.TreeBytes(nodes[7], 8)
+		if err != nil {
+			return err
+		}
+		v.WithdrawableEpoch = ssz.UnmarshallUint64(buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the VoluntaryExit object
 func (v *VoluntaryExit) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(v)
@@ -1329,6 +2076,50 @@ func (v *VoluntaryExit) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(v)
 }
 
+// SSZSchema returns the ssz schema of the VoluntaryExit object
+func (v *VoluntaryExit) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Epoch", Tag: "epoch", Schema: ssz.UintSchema(8)},
+			{Name: "ValidatorIndex", Tag: "validator_index", Schema: ssz.UintSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the VoluntaryExit object
+const (
+	VoluntaryExit_EpochGIndex          = 2
+	VoluntaryExit_ValidatorIndexGIndex = 3
+)
+
+// FromTree decodes the VoluntaryExit object from its tree
+func (v *VoluntaryExit) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Epoch'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		v.Epoch = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'ValidatorIndex'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 8)
+		if err != nil {
+			return err
+		}
+		v.ValidatorIndex = ssz.UnmarshallUint64(buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the SignedVoluntaryExit object
 func (s *SignedVoluntaryExit) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
@@ -1409,6 +2200,51 @@ func (s *SignedVoluntaryExit) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
 
+// SSZSchema returns the ssz schema of the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Exit", Tag: "message", Schema: ssz.SchemaOf(new(VoluntaryExit))},
+			{Name: "Signature", Tag: "signature", Schema: ssz.BytesSchema(96)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the SignedVoluntaryExit object
+const (
+	SignedVoluntaryExit_ExitGIndex      = 2
+	SignedVoluntaryExit_SignatureGIndex = 3
+)
+
+// FromTree decodes the SignedVoluntaryExit object from its tree
+func (s *SignedVoluntaryExit) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Exit'
+	{
+		if s.Exit == nil {
+			s.Exit = new(VoluntaryExit)
+		}
+		if err := s.Exit.FromTree(nodes[0]); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Signature'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 96)
+		if err != nil {
+			return err
+		}
+		copy(s.Signature[:], buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Eth1Block object
 func (e *Eth1Block) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(e)
@@ -1494,16 +2330,74 @@ func (e *Eth1Block) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(e)
 }
 
-// MarshalSSZ ssz marshals the Eth1Data object
-func (e *Eth1Data) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
+// SSZSchema returns the ssz schema of the Eth1Block object
+func (e *Eth1Block) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Timestamp", Tag: "timestamp", Schema: ssz.UintSchema(8)},
+			{Name: "DepositRoot", Tag: "deposit_root", Schema: ssz.BytesSchema(32)},
+			{Name: "DepositCount", Tag: "deposit_count", Schema: ssz.UintSchema(8)},
+		},
+	}
 }
 
-// MarshalSSZTo ssz marshals the Eth1Data object to a target array
-func (e *Eth1Data) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// Generalized indices of the fields of the Eth1Block object
+const (
+	Eth1Block_TimestampGIndex    = 4
+	Eth1Block_DepositRootGIndex  = 5
+	Eth1Block_DepositCountGIndex = 6
+)
 
-	// Field (0) 'DepositRoot'
+// FromTree decodes the Eth1Block object from its tree
+func (e *Eth1Block) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 3)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Timestamp'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		e.Timestamp = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'DepositRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 32)
+		if err != nil {
+			return err
+		}
+		if cap(e.DepositRoot) == 0 {
+			e.DepositRoot = make([]byte, 0, len(buf))
+		}
+		e.DepositRoot = append(e.DepositRoot, buf...)
+	}
+
+	// Field (2) 'DepositCount'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 8)
+		if err != nil {
+			return err
+		}
+		e.DepositCount = ssz.UnmarshallUint64(buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Eth1Data object
+func (e *Eth1Data) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the Eth1Data object to a target array
+func (e *Eth1Data) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'DepositRoot'
 	if size := len(e.DepositRoot); size != 32 {
 		err = ssz.ErrBytesLengthFn("Eth1Data.DepositRoot", size, 32)
 		return
@@ -1590,6 +2484,67 @@ func (e *Eth1Data) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(e)
 }
 
+// SSZSchema returns the ssz schema of the Eth1Data object
+func (e *Eth1Data) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "DepositRoot", Tag: "deposit_root", Schema: ssz.BytesSchema(32)},
+			{Name: "DepositCount", Tag: "deposit_count", Schema: ssz.UintSchema(8)},
+			{Name: "BlockHash", Tag: "block_hash", Schema: ssz.BytesSchema(32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Eth1Data object
+const (
+	Eth1Data_DepositRootGIndex  = 4
+	Eth1Data_DepositCountGIndex = 5
+	Eth1Data_BlockHashGIndex    = 6
+)
+
+// FromTree decodes the Eth1Data object from its tree
+func (e *Eth1Data) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 3)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'DepositRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+		if cap(e.DepositRoot) == 0 {
+			e.DepositRoot = make([]byte, 0, len(buf))
+		}
+		e.DepositRoot = append(e.DepositRoot, buf...)
+	}
+
+	// Field (1) 'DepositCount'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 8)
+		if err != nil {
+			return err
+		}
+		e.DepositCount = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (2) 'BlockHash'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 32)
+		if err != nil {
+			return err
+		}
+		if cap(e.BlockHash) == 0 {
+			e.BlockHash = make([]byte, 0, len(buf))
+		}
+		e.BlockHash = append(e.BlockHash, buf...)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the SigningRoot object
 func (s *SigningRoot) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
@@ -1677,6 +2632,56 @@ func (s *SigningRoot) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
 
+// SSZSchema returns the ssz schema of the SigningRoot object
+func (s *SigningRoot) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "ObjectRoot", Tag: "object_root", Schema: ssz.BytesSchema(32)},
+			{Name: "Domain", Tag: "domain", Schema: ssz.BytesSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the SigningRoot object
+const (
+	SigningRoot_ObjectRootGIndex = 2
+	SigningRoot_DomainGIndex     = 3
+)
+
+// FromTree decodes the SigningRoot object from its tree
+func (s *SigningRoot) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'ObjectRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+		if cap(s.ObjectRoot) == 0 {
+			s.ObjectRoot = make([]byte, 0, len(buf))
+		}
+		s.ObjectRoot = append(s.ObjectRoot, buf...)
+	}
+
+	// Field (1) 'Domain'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 8)
+		if err != nil {
+			return err
+		}
+		if cap(s.Domain) == 0 {
+			s.Domain = make([]byte, 0, len(buf))
+		}
+		s.Domain = append(s.Domain, buf...)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the HistoricalBatch object
 func (h *HistoricalBatch) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(h)
@@ -1780,6 +2785,56 @@ func (h *HistoricalBatch) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(h)
 }
 
+// SSZSchema returns the ssz schema of the HistoricalBatch object
+func (h *HistoricalBatch) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "BlockRoots", Tag: "block_roots", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 8192)},
+			{Name: "StateRoots", Tag: "state_roots", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 8192)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the HistoricalBatch object
+const (
+	HistoricalBatch_BlockRootsGIndex = 2
+	HistoricalBatch_StateRootsGIndex = 3
+)
+
+// FromTree decodes the HistoricalBatch object from its tree
+func (h *HistoricalBatch) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'BlockRoots'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[0], 8192, 32)
+		if err != nil {
+			return err
+		}
+		h.BlockRoots = make([][32]byte, 8192)
+		for ii := 0; ii < 8192; ii++ {
+			copy(h.BlockRoots[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+
+	// Field (1) 'StateRoots'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[1], 8192, 32)
+		if err != nil {
+			return err
+		}
+		h.StateRoots = make([][32]byte, 8192)
+		for ii := 0; ii < 8192; ii++ {
+			copy(h.StateRoots[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the ProposerSlashing object
 func (p *ProposerSlashing) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(p)
@@ -1875,6 +2930,52 @@ func (p *ProposerSlashing) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(p)
 }
 
+// SSZSchema returns the ssz schema of the ProposerSlashing object
+func (p *ProposerSlashing) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Header1", Tag: "signed_header_1", Schema: ssz.SchemaOf(new(SignedBeaconBlockHeader))},
+			{Name: "Header2", Tag: "signed_header_2", Schema: ssz.SchemaOf(new(SignedBeaconBlockHeader))},
+		},
+	}
+}
+
+// Generalized indices of the fields of the ProposerSlashing object
+const (
+	ProposerSlashing_Header1GIndex = 2
+	ProposerSlashing_Header2GIndex = 3
+)
+
+// FromTree decodes the ProposerSlashing object from its tree
+func (p *ProposerSlashing) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Header1'
+	{
+		if p.Header1 == nil {
+			p.Header1 = new(SignedBeaconBlockHeader)
+		}
+		if err := p.Header1.FromTree(nodes[0]); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Header2'
+	{
+		if p.Header2 == nil {
+			p.Header2 = new(SignedBeaconBlockHeader)
+		}
+		if err := p.Header2.FromTree(nodes[1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the AttesterSlashing object
 func (a *AttesterSlashing) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(a)
@@ -1924,7 +3025,7 @@ func (a *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 8 {
+	if o0 != 8 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -2007,6 +3108,52 @@ func (a *AttesterSlashing) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(a)
 }
 
+// SSZSchema returns the ssz schema of the AttesterSlashing object
+func (a *AttesterSlashing) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Attestation1", Tag: "attestation_1", Schema: ssz.SchemaOf(new(IndexedAttestation))},
+			{Name: "Attestation2", Tag: "attestation_2", Schema: ssz.SchemaOf(new(IndexedAttestation))},
+		},
+	}
+}
+
+// Generalized indices of the fields of the AttesterSlashing object
+const (
+	AttesterSlashing_Attestation1GIndex = 2
+	AttesterSlashing_Attestation2GIndex = 3
+)
+
+// FromTree decodes the AttesterSlashing object from its tree
+func (a *AttesterSlashing) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Attestation1'
+	{
+		if a.Attestation1 == nil {
+			a.Attestation1 = new(IndexedAttestation)
+		}
+		if err := a.Attestation1.FromTree(nodes[0]); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Attestation2'
+	{
+		if a.Attestation2 == nil {
+			a.Attestation2 = new(IndexedAttestation)
+		}
+		if err := a.Attestation2.FromTree(nodes[1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the BeaconBlock object
 func (b *BeaconBlock) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
@@ -2082,7 +3229,7 @@ func (b *BeaconBlock) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o4 < 84 {
+	if o4 != 84 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -2157,6 +3304,90 @@ func (b *BeaconBlock) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
+// SSZSchema returns the ssz schema of the BeaconBlock object
+func (b *BeaconBlock) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Slot", Tag: "slot", Schema: ssz.UintSchema(8)},
+			{Name: "ProposerIndex", Tag: "proposer_index", Schema: ssz.UintSchema(8)},
+			{Name: "ParentRoot", Tag: "parent_root", Schema: ssz.BytesSchema(32)},
+			{Name: "StateRoot", Tag: "state_root", Schema: ssz.BytesSchema(32)},
+			{Name: "Body", Tag: "body", Schema: ssz.SchemaOf(new(BeaconBlockBodyPhase0))},
+		},
+	}
+}
+
+// Generalized indices of the fields of the BeaconBlock object
+const (
+	BeaconBlock_SlotGIndex          = 8
+	BeaconBlock_ProposerIndexGIndex = 9
+	BeaconBlock_ParentRootGIndex    = 10
+	BeaconBlock_StateRootGIndex     = 11
+	BeaconBlock_BodyGIndex          = 12
+)
+
+// FromTree decodes the BeaconBlock object from its tree
+func (b *BeaconBlock) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 5)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Slot'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		b.Slot = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'ProposerIndex'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 8)
+		if err != nil {
+			return err
+		}
+		b.ProposerIndex = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (2) 'ParentRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 32)
+		if err != nil {
+			return err
+		}
+		if cap(b.ParentRoot) == 0 {
+			b.ParentRoot = make([]byte, 0, len(buf))
+		}
+		b.ParentRoot = append(b.ParentRoot, buf...)
+	}
+
+	// Field (3) 'StateRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 32)
+		if err != nil {
+			return err
+		}
+		if cap(b.StateRoot) == 0 {
+			b.StateRoot = make([]byte, 0, len(buf))
+		}
+		b.StateRoot = append(b.StateRoot, buf...)
+	}
+
+	// Field (4) 'Body'
+	{
+		if b.Body == nil {
+			b.Body = new(BeaconBlockBodyPhase0)
+		}
+		if err := b.Body.FromTree(nodes[4]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the SignedBeaconBlock object
 func (s *SignedBeaconBlock) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
@@ -2201,7 +3432,7 @@ func (s *SignedBeaconBlock) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 100 {
+	if o0 != 100 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -2269,6 +3500,54 @@ func (s *SignedBeaconBlock) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
 
+// SSZSchema returns the ssz schema of the SignedBeaconBlock object
+func (s *SignedBeaconBlock) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Block", Tag: "message", Schema: ssz.SchemaOf(new(BeaconBlock))},
+			{Name: "Signature", Tag: "signature", Schema: ssz.BytesSchema(96)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the SignedBeaconBlock object
+const (
+	SignedBeaconBlock_BlockGIndex     = 2
+	SignedBeaconBlock_SignatureGIndex = 3
+)
+
+// FromTree decodes the SignedBeaconBlock object from its tree
+func (s *SignedBeaconBlock) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Block'
+	{
+		if s.Block == nil {
+			s.Block = new(BeaconBlock)
+		}
+		if err := s.Block.FromTree(nodes[0]); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Signature'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 96)
+		if err != nil {
+			return err
+		}
+		if cap(s.Signature) == 0 {
+			s.Signature = make([]byte, 0, len(buf))
+		}
+		s.Signature = append(s.Signature, buf...)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Transfer object
 func (t *Transfer) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(t)
@@ -2401,20 +3680,125 @@ func (t *Transfer) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(t)
 }
 
-// MarshalSSZ ssz marshals the BeaconState object
-func (b *BeaconState) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
-}
-
-// MarshalSSZTo ssz marshals the BeaconState object to a target array
-func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(2687377)
-
-	// Field (0) 'GenesisTime'
-	dst = ssz.MarshalUint64(dst, b.GenesisTime)
+// SSZSchema returns the ssz schema of the Transfer object
+func (t *Transfer) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Sender", Tag: "sender", Schema: ssz.UintSchema(8)},
+			{Name: "Recipient", Tag: "recipient", Schema: ssz.UintSchema(8)},
+			{Name: "Amount", Tag: "amount", Schema: ssz.UintSchema(8)},
+			{Name: "Fee", Tag: "fee", Schema: ssz.UintSchema(8)},
+			{Name: "Slot", Tag: "slot", Schema: ssz.UintSchema(8)},
+			{Name: "Pubkey", Tag: "pubkey", Schema: ssz.BytesSchema(48)},
+			{Name: "Signature", Tag: "signature", Schema: ssz.BytesSchema(96)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Transfer object
+const (
+	Transfer_SenderGIndex    = 8
+	Transfer_RecipientGIndex = 9
+	Transfer_AmountGIndex    = 10
+	Transfer_FeeGIndex       = 11
+	Transfer_SlotGIndex      = 12
+	Transfer_PubkeyGIndex    = 13
+	Transfer_SignatureGIndex = 14
+)
 
-	// Field (1) 'GenesisValidatorsRoot'
+// FromTree decodes the Transfer object from its tree
+func (t *Transfer) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 7)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Sender'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		t.Sender = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'Recipient'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 8)
+		if err != nil {
+			return err
+		}
+		t.Recipient = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (2) 'Amount'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 8)
+		if err != nil {
+			return err
+		}
+		t.Amount = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (3) 'Fee'
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 8)
+		if err != nil {
+			return err
+		}
+		t.Fee = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (4) 'Slot'
+	{
+		buf, err := ssz.TreeBytes(nodes[4], 8)
+		if err != nil {
+			return err
+		}
+		t.Slot = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (5) 'Pubkey'
+	{
+		buf, err := ssz.TreeBytes(nodes[5], 48)
+		if err != nil {
+			return err
+		}
+		if cap(t.Pubkey) == 0 {
+			t.Pubkey = make([]byte, 0, len(buf))
+		}
+		t.Pubkey = append(t.Pubkey, buf...)
+	}
+
+	// Field (6) 'Signature'
+	{
+		buf, err := ssz.TreeBytes(nodes[6], 96)
+		if err != nil {
+			return err
+		}
+		if cap(t.Signature) == 0 {
+			t.Signature = make([]byte, 0, len(buf))
+		}
+		t.Signature = append(t.Signature, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the BeaconState object
+func (b *BeaconState) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconState object to a target array
+func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(2687377)
+
+	// Field (0) 'GenesisTime'
+	dst = ssz.MarshalUint64(dst, b.GenesisTime)
+
+	// Field (1) 'GenesisValidatorsRoot'
 	if size := len(b.GenesisValidatorsRoot); size != 32 {
 		err = ssz.ErrBytesLengthFn("BeaconState.GenesisValidatorsRoot", size, 32)
 		return
@@ -2701,7 +4085,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o7 < 2687377 {
+	if o7 != 2687377 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -3110,6 +4494,7 @@ func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		for _, i := range b.Slashings {
 			hh.AppendUint64(i)
 		}
+		hh.FillUpTo32()
 		hh.Merkleize(subIndx)
 	}
 
@@ -3185,154 +4570,493 @@ func (b *BeaconState) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockBodyPhase0 object
-func (b *BeaconBlockBodyPhase0) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
-}
-
-// MarshalSSZTo ssz marshals the BeaconBlockBodyPhase0 object to a target array
-func (b *BeaconBlockBodyPhase0) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(220)
-
-	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockBodyPhase0.RandaoReveal", size, 96)
-		return
-	}
-	dst = append(dst, b.RandaoReveal...)
+// SSZSchema returns the ssz schema of the BeaconState object
+func (b *BeaconState) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "GenesisTime", Tag: "genesis_time", Schema: ssz.UintSchema(8)},
+			{Name: "GenesisValidatorsRoot", Tag: "genesis_validators_root", Schema: ssz.BytesSchema(32)},
+			{Name: "Slot", Tag: "slot", Schema: ssz.UintSchema(8)},
+			{Name: "Fork", Tag: "fork", Schema: ssz.SchemaOf(new(Fork))},
+			{Name: "LatestBlockHeader", Tag: "latest_block_header", Schema: ssz.SchemaOf(new(BeaconBlockHeader))},
+			{Name: "BlockRoots", Tag: "block_roots", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 8192)},
+			{Name: "StateRoots", Tag: "state_roots", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 8192)},
+			{Name: "HistoricalRoots", Tag: "historical_roots", Schema: ssz.ListSchema(ssz.BytesSchema(32), 16777216)},
+			{Name: "Eth1Data", Tag: "eth1_data", Schema: ssz.SchemaOf(new(Eth1Data))},
+			{Name: "Eth1DataVotes", Tag: "eth1_data_votes", Schema: ssz.ListSchema(ssz.SchemaOf(new(Eth1Data)), 2048)},
+			{Name: "Eth1DepositIndex", Tag: "eth1_deposit_index", Schema: ssz.UintSchema(8)},
+			{Name: "Validators", Tag: "validators", Schema: ssz.ListSchema(ssz.SchemaOf(new(Validator)), 1099511627776)},
+			{Name: "Balances", Tag: "balances", Schema: ssz.ListSchema(ssz.UintSchema(8), 1099511627776)},
+			{Name: "RandaoMixes", Tag: "randao_mixes", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 65536)},
+			{Name: "Slashings", Tag: "slashings", Schema: ssz.VectorSchema(ssz.UintSchema(8), 8192)},
+			{Name: "PreviousEpochAttestations", Tag: "previous_epoch_attestations", Schema: ssz.ListSchema(ssz.SchemaOf(new(PendingAttestation)), 4096)},
+			{Name: "CurrentEpochAttestations", Tag: "current_epoch_attestations", Schema: ssz.ListSchema(ssz.SchemaOf(new(PendingAttestation)), 4096)},
+			{Name: "JustificationBits", Tag: "justification_bits", Schema: ssz.BytesSchema(1)},
+			{Name: "PreviousJustifiedCheckpoint", Tag: "previous_justified_checkpoint", Schema: ssz.SchemaOf(new(Checkpoint))},
+			{Name: "CurrentJustifiedCheckpoint", Tag: "current_justified_checkpoint", Schema: ssz.SchemaOf(new(Checkpoint))},
+			{Name: "FinalizedCheckpoint", Tag: "finalized_checkpoint", Schema: ssz.SchemaOf(new(Checkpoint))},
+		},
+	}
+}
+
+// Generalized indices of the fields of the BeaconState object
+const (
+	BeaconState_GenesisTimeGIndex                 = 32
+	BeaconState_GenesisValidatorsRootGIndex       = 33
+	BeaconState_SlotGIndex                        = 34
+	BeaconState_ForkGIndex                        = 35
+	BeaconState_LatestBlockHeaderGIndex           = 36
+	BeaconState_BlockRootsGIndex                  = 37
+	BeaconState_StateRootsGIndex                  = 38
+	BeaconState_HistoricalRootsGIndex             = 39
+	BeaconState_Eth1DataGIndex                    = 40
+	BeaconState_Eth1DataVotesGIndex               = 41
+	BeaconState_Eth1DepositIndexGIndex            = 42
+	BeaconState_ValidatorsGIndex                  = 43
+	BeaconState_BalancesGIndex                    = 44
+	BeaconState_RandaoMixesGIndex                 = 45
+	BeaconState_SlashingsGIndex                   = 46
+	BeaconState_PreviousEpochAttestationsGIndex   = 47
+	BeaconState_CurrentEpochAttestationsGIndex    = 48
+	BeaconState_JustificationBitsGIndex           = 49
+	BeaconState_PreviousJustifiedCheckpointGIndex = 50
+	BeaconState_CurrentJustifiedCheckpointGIndex  = 51
+	BeaconState_FinalizedCheckpointGIndex         = 52
+)
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
+// FromTree decodes the BeaconState object from its tree
+func (b *BeaconState) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 21)
+	if err != nil {
+		return err
 	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
-		return
+	// Field (0) 'GenesisTime'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		b.GenesisTime = ssz.UnmarshallUint64(buf)
 	}
 
-	// Field (2) 'Graffiti'
-	dst = append(dst, b.Graffiti[:]...)
-
-	// Offset (3) 'ProposerSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.ProposerSlashings) * 416
-
-	// Offset (4) 'AttesterSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		offset += 4
-		offset += b.AttesterSlashings[ii].SizeSSZ()
+	// Field (1) 'GenesisValidatorsRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 32)
+		if err != nil {
+			return err
+		}
+		if cap(b.GenesisValidatorsRoot) == 0 {
+			b.GenesisValidatorsRoot = make([]byte, 0, len(buf))
+		}
+		b.GenesisValidatorsRoot = append(b.GenesisValidatorsRoot, buf...)
 	}
 
-	// Offset (5) 'Attestations'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		offset += 4
-		offset += b.Attestations[ii].SizeSSZ()
+	// Field (2) 'Slot'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 8)
+		if err != nil {
+			return err
+		}
+		b.Slot = ssz.UnmarshallUint64(buf)
 	}
 
-	// Offset (6) 'Deposits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Deposits) * 1240
-
-	// Offset (7) 'VoluntaryExits'
-	dst = ssz.WriteOffset(dst, offset)
-
-	// Field (3) 'ProposerSlashings'
-	if size := len(b.ProposerSlashings); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.ProposerSlashings", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
-		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
+	// Field (3) 'Fork'
+	{
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if err := b.Fork.FromTree(nodes[3]); err != nil {
+			return err
 		}
 	}
 
-	// Field (4) 'AttesterSlashings'
-	if size := len(b.AttesterSlashings); size > 2 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.AttesterSlashings", size, 2)
-		return
-	}
+	// Field (4) 'LatestBlockHeader'
 	{
-		offset = 4 * len(b.AttesterSlashings)
-		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.AttesterSlashings[ii].SizeSSZ()
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
 		}
-	}
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
+		if err := b.LatestBlockHeader.FromTree(nodes[4]); err != nil {
+			return err
 		}
 	}
 
-	// Field (5) 'Attestations'
-	if size := len(b.Attestations); size > 128 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.Attestations", size, 128)
-		return
-	}
+	// Field (5) 'BlockRoots'
 	{
-		offset = 4 * len(b.Attestations)
-		for ii := 0; ii < len(b.Attestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.Attestations[ii].SizeSSZ()
+		buf, err := ssz.TreeVectorBytes(nodes[5], 8192, 32)
+		if err != nil {
+			return err
 		}
-	}
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
-			return
+		b.BlockRoots = make([][]byte, 8192)
+		for ii := 0; ii < 8192; ii++ {
+			if cap(b.BlockRoots[ii]) == 0 {
+				b.BlockRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			b.BlockRoots[ii] = append(b.BlockRoots[ii], buf[ii*32:(ii+1)*32]...)
 		}
 	}
 
-	// Field (6) 'Deposits'
-	if size := len(b.Deposits); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.Deposits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.Deposits); ii++ {
-		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
-			return
+	// Field (6) 'StateRoots'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[6], 8192, 32)
+		if err != nil {
+			return err
+		}
+		b.StateRoots = make([][]byte, 8192)
+		for ii := 0; ii < 8192; ii++ {
+			if cap(b.StateRoots[ii]) == 0 {
+				b.StateRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			b.StateRoots[ii] = append(b.StateRoots[ii], buf[ii*32:(ii+1)*32]...)
 		}
 	}
 
-	// Field (7) 'VoluntaryExits'
-	if size := len(b.VoluntaryExits); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.VoluntaryExits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
-		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
-			return
+	// Field (7) 'HistoricalRoots'
+	{
+		buf, err := ssz.TreeListBytes(nodes[7], 16777216, 32)
+		if err != nil {
+			return err
+		}
+		num, err := ssz.DivideInt2(len(buf), 32, 16777216)
+		if err != nil {
+			return err
+		}
+		b.HistoricalRoots = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(b.HistoricalRoots[ii]) == 0 {
+				b.HistoricalRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii], buf[ii*32:(ii+1)*32]...)
 		}
 	}
 
-	return
-}
-
-// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyPhase0 object
-func (b *BeaconBlockBodyPhase0) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 220 {
-		return ssz.ErrSize
+	// Field (8) 'Eth1Data'
+	{
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err := b.Eth1Data.FromTree(nodes[8]); err != nil {
+			return err
+		}
 	}
 
-	tail := buf
-	var o3, o4, o5, o6, o7 uint64
-
-	// Field (0) 'RandaoReveal'
-	if cap(b.RandaoReveal) == 0 {
-		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+	// Field (9) 'Eth1DataVotes'
+	{
+		elems, err := ssz.TreeList(nodes[9], 2048)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.Eth1DataVotes = make([]*Eth1Data, num)
+		for indx, elem := range elems {
+			if b.Eth1DataVotes[indx] == nil {
+				b.Eth1DataVotes[indx] = new(Eth1Data)
+			}
+			if err := b.Eth1DataVotes[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
 	}
-	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
-		return err
+	// Field (10) 'Eth1DepositIndex'
+	{
+		buf, err := ssz.TreeBytes(nodes[10], 8)
+		if err != nil {
+			return err
+		}
+		b.Eth1DepositIndex = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (11) 'Validators'
+	{
+		elems, err := ssz.TreeList(nodes[11], 1099511627776)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.Validators = make([]*Validator, num)
+		for indx, elem := range elems {
+			if b.Validators[indx] == nil {
+				b.Validators[indx] = new(Validator)
+			}
+			if err := b.Validators[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (12) 'Balances'
+	{
+		buf, err := ssz.TreeListPacked(nodes[12], 1099511627776, 8)
+		if err != nil {
+			return err
+		}
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.Balances = ssz.ExtendUint64(b.Balances, num)
+		for ii := 0; ii < num; ii++ {
+			b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (13) 'RandaoMixes'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[13], 65536, 32)
+		if err != nil {
+			return err
+		}
+		b.RandaoMixes = make([][]byte, 65536)
+		for ii := 0; ii < 65536; ii++ {
+			if cap(b.RandaoMixes[ii]) == 0 {
+				b.RandaoMixes[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			b.RandaoMixes[ii] = append(b.RandaoMixes[ii], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+
+	// Field (14) 'Slashings'
+	{
+		buf, err := ssz.TreePacked(nodes[14], 65536)
+		if err != nil {
+			return err
+		}
+		b.Slashings = ssz.ExtendUint64(b.Slashings, 8192)
+		for ii := 0; ii < 8192; ii++ {
+			b.Slashings[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (15) 'PreviousEpochAttestations'
+	{
+		elems, err := ssz.TreeList(nodes[15], 4096)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.PreviousEpochAttestations = make([]*PendingAttestation, num)
+		for indx, elem := range elems {
+			if b.PreviousEpochAttestations[indx] == nil {
+				b.PreviousEpochAttestations[indx] = new(PendingAttestation)
+			}
+			if err := b.PreviousEpochAttestations[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (16) 'CurrentEpochAttestations'
+	{
+		elems, err := ssz.TreeList(nodes[16], 4096)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.CurrentEpochAttestations = make([]*PendingAttestation, num)
+		for indx, elem := range elems {
+			if b.CurrentEpochAttestations[indx] == nil {
+				b.CurrentEpochAttestations[indx] = new(PendingAttestation)
+			}
+			if err := b.CurrentEpochAttestations[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (17) 'JustificationBits'
+	{
+		buf, err := ssz.TreeBytes(nodes[17], 1)
+		if err != nil {
+			return err
+		}
+		if cap(b.JustificationBits) == 0 {
+			b.JustificationBits = make([]byte, 0, len(buf))
+		}
+		b.JustificationBits = append(b.JustificationBits, buf...)
+	}
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	{
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err := b.PreviousJustifiedCheckpoint.FromTree(nodes[18]); err != nil {
+			return err
+		}
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	{
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err := b.CurrentJustifiedCheckpoint.FromTree(nodes[19]); err != nil {
+			return err
+		}
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	{
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if err := b.FinalizedCheckpoint.FromTree(nodes[20]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockBodyPhase0 object
+func (b *BeaconBlockBodyPhase0) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockBodyPhase0 object to a target array
+func (b *BeaconBlockBodyPhase0) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(220)
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockBodyPhase0.RandaoReveal", size, 96)
+		return
+	}
+	dst = append(dst, b.RandaoReveal...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	dst = append(dst, b.Graffiti[:]...)
+
+	// Offset (3) 'ProposerSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ProposerSlashings) * 416
+
+	// Offset (4) 'AttesterSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Offset (5) 'Attestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Offset (6) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1240
+
+	// Offset (7) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (3) 'ProposerSlashings'
+	if size := len(b.ProposerSlashings); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if size := len(b.AttesterSlashings); size > 2 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.AttesterSlashings", size, 2)
+		return
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if size := len(b.Attestations); size > 128 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.Attestations", size, 128)
+		return
+	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if size := len(b.Deposits); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.Deposits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if size := len(b.VoluntaryExits); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyPhase0 object
+func (b *BeaconBlockBodyPhase0) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 220 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o3, o4, o5, o6, o7 uint64
+
+	// Field (0) 'RandaoReveal'
+	if cap(b.RandaoReveal) == 0 {
+		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+	}
+	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
+		return err
 	}
 
 	// Field (2) 'Graffiti'
@@ -3343,7 +5067,7 @@ func (b *BeaconBlockBodyPhase0) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 220 {
+	if o3 != 220 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -3617,9 +5341,168 @@ func (b *BeaconBlockBodyPhase0) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockBodyAltair object
-func (b *BeaconBlockBodyAltair) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+// SSZSchema returns the ssz schema of the BeaconBlockBodyPhase0 object
+func (b *BeaconBlockBodyPhase0) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "RandaoReveal", Tag: "randao_reveal", Schema: ssz.BytesSchema(96)},
+			{Name: "Eth1Data", Tag: "eth1_data", Schema: ssz.SchemaOf(new(Eth1Data))},
+			{Name: "Graffiti", Tag: "graffiti", Schema: ssz.BytesSchema(32)},
+			{Name: "ProposerSlashings", Tag: "proposer_slashings", Schema: ssz.ListSchema(ssz.SchemaOf(new(ProposerSlashing)), 16)},
+			{Name: "AttesterSlashings", Tag: "attester_slashings", Schema: ssz.ListSchema(ssz.SchemaOf(new(AttesterSlashing)), 2)},
+			{Name: "Attestations", Tag: "attestations", Schema: ssz.ListSchema(ssz.SchemaOf(new(Attestation)), 128)},
+			{Name: "Deposits", Tag: "deposits", Schema: ssz.ListSchema(ssz.SchemaOf(new(Deposit)), 16)},
+			{Name: "VoluntaryExits", Tag: "voluntary_exits", Schema: ssz.ListSchema(ssz.SchemaOf(new(SignedVoluntaryExit)), 16)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the BeaconBlockBodyPhase0 object
+const (
+	BeaconBlockBodyPhase0_RandaoRevealGIndex      = 8
+	BeaconBlockBodyPhase0_Eth1DataGIndex          = 9
+	BeaconBlockBodyPhase0_GraffitiGIndex          = 10
+	BeaconBlockBodyPhase0_ProposerSlashingsGIndex = 11
+	BeaconBlockBodyPhase0_AttesterSlashingsGIndex = 12
+	BeaconBlockBodyPhase0_AttestationsGIndex      = 13
+	BeaconBlockBodyPhase0_DepositsGIndex          = 14
+	BeaconBlockBodyPhase0_VoluntaryExitsGIndex    = 15
+)
+
+// FromTree decodes the BeaconBlockBodyPhase0 object from its tree
+func (b *BeaconBlockBodyPhase0) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 8)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'RandaoReveal'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 96)
+		if err != nil {
+			return err
+		}
+		if cap(b.RandaoReveal) == 0 {
+			b.RandaoReveal = make([]byte, 0, len(buf))
+		}
+		b.RandaoReveal = append(b.RandaoReveal, buf...)
+	}
+
+	// Field (1) 'Eth1Data'
+	{
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err := b.Eth1Data.FromTree(nodes[1]); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'Graffiti'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 32)
+		if err != nil {
+			return err
+		}
+		copy(b.Graffiti[:], buf)
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		elems, err := ssz.TreeList(nodes[3], 16)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.ProposerSlashings = make([]*ProposerSlashing, num)
+		for indx, elem := range elems {
+			if b.ProposerSlashings[indx] == nil {
+				b.ProposerSlashings[indx] = new(ProposerSlashing)
+			}
+			if err := b.ProposerSlashings[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		elems, err := ssz.TreeList(nodes[4], 2)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.AttesterSlashings = make([]*AttesterSlashing, num)
+		for indx, elem := range elems {
+			if b.AttesterSlashings[indx] == nil {
+				b.AttesterSlashings[indx] = new(AttesterSlashing)
+			}
+			if err := b.AttesterSlashings[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		elems, err := ssz.TreeList(nodes[5], 128)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.Attestations = make([]*Attestation, num)
+		for indx, elem := range elems {
+			if b.Attestations[indx] == nil {
+				b.Attestations[indx] = new(Attestation)
+			}
+			if err := b.Attestations[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		elems, err := ssz.TreeList(nodes[6], 16)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.Deposits = make([]*Deposit, num)
+		for indx, elem := range elems {
+			if b.Deposits[indx] == nil {
+				b.Deposits[indx] = new(Deposit)
+			}
+			if err := b.Deposits[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		elems, err := ssz.TreeList(nodes[7], 16)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+		for indx, elem := range elems {
+			if b.VoluntaryExits[indx] == nil {
+				b.VoluntaryExits[indx] = new(SignedVoluntaryExit)
+			}
+			if err := b.VoluntaryExits[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockBodyAltair object
+func (b *BeaconBlockBodyAltair) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
 }
 
 // MarshalSSZTo ssz marshals the BeaconBlockBodyAltair object to a target array
@@ -3783,7 +5666,7 @@ func (b *BeaconBlockBodyAltair) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 380 {
+	if o3 != 380 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -4073,6 +5956,177 @@ func (b *BeaconBlockBodyAltair) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
+// SSZSchema returns the ssz schema of the BeaconBlockBodyAltair object
+func (b *BeaconBlockBodyAltair) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "RandaoReveal", Tag: "randao_reveal", Schema: ssz.BytesSchema(96)},
+			{Name: "Eth1Data", Tag: "eth1_data", Schema: ssz.SchemaOf(new(Eth1Data))},
+			{Name: "Graffiti", Tag: "graffiti", Schema: ssz.BytesSchema(32)},
+			{Name: "ProposerSlashings", Tag: "proposer_slashings", Schema: ssz.ListSchema(ssz.SchemaOf(new(ProposerSlashing)), 16)},
+			{Name: "AttesterSlashings", Tag: "attester_slashings", Schema: ssz.ListSchema(ssz.SchemaOf(new(AttesterSlashing)), 2)},
+			{Name: "Attestations", Tag: "attestations", Schema: ssz.ListSchema(ssz.SchemaOf(new(Attestation)), 128)},
+			{Name: "Deposits", Tag: "deposits", Schema: ssz.ListSchema(ssz.SchemaOf(new(Deposit)), 16)},
+			{Name: "VoluntaryExits", Tag: "voluntary_exits", Schema: ssz.ListSchema(ssz.SchemaOf(new(SignedVoluntaryExit)), 16)},
+			{Name: "SyncAggregate", Tag: "sync_aggregate", Schema: ssz.SchemaOf(new(SyncAggregate))},
+		},
+	}
+}
+
+// Generalized indices of the fields of the BeaconBlockBodyAltair object
+const (
+	BeaconBlockBodyAltair_RandaoRevealGIndex      = 16
+	BeaconBlockBodyAltair_Eth1DataGIndex          = 17
+	BeaconBlockBodyAltair_GraffitiGIndex          = 18
+	BeaconBlockBodyAltair_ProposerSlashingsGIndex = 19
+	BeaconBlockBodyAltair_AttesterSlashingsGIndex = 20
+	BeaconBlockBodyAltair_AttestationsGIndex      = 21
+	BeaconBlockBodyAltair_DepositsGIndex          = 22
+	BeaconBlockBodyAltair_VoluntaryExitsGIndex    = 23
+	BeaconBlockBodyAltair_SyncAggregateGIndex     = 24
+)
+
+// FromTree decodes the BeaconBlockBodyAltair object from its tree
+func (b *BeaconBlockBodyAltair) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 9)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'RandaoReveal'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 96)
+		if err != nil {
+			return err
+		}
+		if cap(b.RandaoReveal) == 0 {
+			b.RandaoReveal = make([]byte, 0, len(buf))
+		}
+		b.RandaoReveal = append(b.RandaoReveal, buf...)
+	}
+
+	// Field (1) 'Eth1Data'
+	{
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err := b.Eth1Data.FromTree(nodes[1]); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'Graffiti'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 32)
+		if err != nil {
+			return err
+		}
+		copy(b.Graffiti[:], buf)
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		elems, err := ssz.TreeList(nodes[3], 16)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.ProposerSlashings = make([]*ProposerSlashing, num)
+		for indx, elem := range elems {
+			if b.ProposerSlashings[indx] == nil {
+				b.ProposerSlashings[indx] = new(ProposerSlashing)
+			}
+			if err := b.ProposerSlashings[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		elems, err := ssz.TreeList(nodes[4], 2)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.AttesterSlashings = make([]*AttesterSlashing, num)
+		for indx, elem := range elems {
+			if b.AttesterSlashings[indx] == nil {
+				b.AttesterSlashings[indx] = new(AttesterSlashing)
+			}
+			if err := b.AttesterSlashings[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		elems, err := ssz.TreeList(nodes[5], 128)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.Attestations = make([]*Attestation, num)
+		for indx, elem := range elems {
+			if b.Attestations[indx] == nil {
+				b.Attestations[indx] = new(Attestation)
+			}
+			if err := b.Attestations[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		elems, err := ssz.TreeList(nodes[6], 16)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.Deposits = make([]*Deposit, num)
+		for indx, elem := range elems {
+			if b.Deposits[indx] == nil {
+				b.Deposits[indx] = new(Deposit)
+			}
+			if err := b.Deposits[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		elems, err := ssz.TreeList(nodes[7], 16)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+		for indx, elem := range elems {
+			if b.VoluntaryExits[indx] == nil {
+				b.VoluntaryExits[indx] = new(SignedVoluntaryExit)
+			}
+			if err := b.VoluntaryExits[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (8) 'SyncAggregate'
+	{
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if err := b.SyncAggregate.FromTree(nodes[8]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the BeaconBlockBodyBellatrix object
 func (b *BeaconBlockBodyBellatrix) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
@@ -4248,7 +6302,7 @@ func (b *BeaconBlockBodyBellatrix) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 384 {
+	if o3 != 384 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -4566,60 +6620,243 @@ func (b *BeaconBlockBodyBellatrix) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
-// MarshalSSZ ssz marshals the BeaconStateAltair object
-func (b *BeaconStateAltair) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
-}
-
-// MarshalSSZTo ssz marshals the BeaconStateAltair object to a target array
-func (b *BeaconStateAltair) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(2736629)
-
-	// Field (0) 'GenesisTime'
-	dst = ssz.MarshalUint64(dst, b.GenesisTime)
-
-	// Field (1) 'GenesisValidatorsRoot'
-	if size := len(b.GenesisValidatorsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconStateAltair.GenesisValidatorsRoot", size, 32)
-		return
-	}
-	dst = append(dst, b.GenesisValidatorsRoot...)
-
-	// Field (2) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
+// SSZSchema returns the ssz schema of the BeaconBlockBodyBellatrix object
+func (b *BeaconBlockBodyBellatrix) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "RandaoReveal", Tag: "randao_reveal", Schema: ssz.BytesSchema(96)},
+			{Name: "Eth1Data", Tag: "eth1_data", Schema: ssz.SchemaOf(new(Eth1Data))},
+			{Name: "Graffiti", Tag: "graffiti", Schema: ssz.BytesSchema(32)},
+			{Name: "ProposerSlashings", Tag: "proposer_slashings", Schema: ssz.ListSchema(ssz.SchemaOf(new(ProposerSlashing)), 16)},
+			{Name: "AttesterSlashings", Tag: "attester_slashings", Schema: ssz.ListSchema(ssz.SchemaOf(new(AttesterSlashing)), 2)},
+			{Name: "Attestations", Tag: "attestations", Schema: ssz.ListSchema(ssz.SchemaOf(new(Attestation)), 128)},
+			{Name: "Deposits", Tag: "deposits", Schema: ssz.ListSchema(ssz.SchemaOf(new(Deposit)), 16)},
+			{Name: "VoluntaryExits", Tag: "voluntary_exits", Schema: ssz.ListSchema(ssz.SchemaOf(new(SignedVoluntaryExit)), 16)},
+			{Name: "SyncAggregate", Tag: "sync_aggregate", Schema: ssz.SchemaOf(new(SyncAggregate))},
+			{Name: "ExecutionPayload", Tag: "execution_payload", Schema: ssz.SchemaOf(new(ExecutionPayload))},
+		},
+	}
+}
+
+// Generalized indices of the fields of the BeaconBlockBodyBellatrix object
+const (
+	BeaconBlockBodyBellatrix_RandaoRevealGIndex      = 16
+	BeaconBlockBodyBellatrix_Eth1DataGIndex          = 17
+	BeaconBlockBodyBellatrix_GraffitiGIndex          = 18
+	BeaconBlockBodyBellatrix_ProposerSlashingsGIndex = 19
+	BeaconBlockBodyBellatrix_AttesterSlashingsGIndex = 20
+	BeaconBlockBodyBellatrix_AttestationsGIndex      = 21
+	BeaconBlockBodyBellatrix_DepositsGIndex          = 22
+	BeaconBlockBodyBellatrix_VoluntaryExitsGIndex    = 23
+	BeaconBlockBodyBellatrix_SyncAggregateGIndex     = 24
+	BeaconBlockBodyBellatrix_ExecutionPayloadGIndex  = 25
+)
 
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
+// FromTree decodes the BeaconBlockBodyBellatrix object from its tree
+func (b *BeaconBlockBodyBellatrix) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 10)
+	if err != nil {
+		return err
 	}
-	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
-		return
+	// Field (0) 'RandaoReveal'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 96)
+		if err != nil {
+			return err
+		}
+		if cap(b.RandaoReveal) == 0 {
+			b.RandaoReveal = make([]byte, 0, len(buf))
+		}
+		b.RandaoReveal = append(b.RandaoReveal, buf...)
 	}
 
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
-		return
+	// Field (1) 'Eth1Data'
+	{
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err := b.Eth1Data.FromTree(nodes[1]); err != nil {
+			return err
+		}
 	}
 
-	// Field (5) 'BlockRoots'
-	if size := len(b.BlockRoots); size != 8192 {
-		err = ssz.ErrVectorLengthFn("BeaconStateAltair.BlockRoots", size, 8192)
-		return
-	}
-	for ii := 0; ii < 8192; ii++ {
-		if size := len(b.BlockRoots[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateAltair.BlockRoots[ii]", size, 32)
-			return
+	// Field (2) 'Graffiti'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 32)
+		if err != nil {
+			return err
 		}
-		dst = append(dst, b.BlockRoots[ii]...)
+		copy(b.Graffiti[:], buf)
 	}
 
-	// Field (6) 'StateRoots'
-	if size := len(b.StateRoots); size != 8192 {
+	// Field (3) 'ProposerSlashings'
+	{
+		elems, err := ssz.TreeList(nodes[3], 16)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.ProposerSlashings = make([]*ProposerSlashing, num)
+		for indx, elem := range elems {
+			if b.ProposerSlashings[indx] == nil {
+				b.ProposerSlashings[indx] = new(ProposerSlashing)
+			}
+			if err := b.ProposerSlashings[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		elems, err := ssz.TreeList(nodes[4], 2)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.AttesterSlashings = make([]*AttesterSlashing, num)
+		for indx, elem := range elems {
+			if b.AttesterSlashings[indx] == nil {
+				b.AttesterSlashings[indx] = new(AttesterSlashing)
+			}
+			if err := b.AttesterSlashings[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		elems, err := ssz.TreeList(nodes[5], 128)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.Attestations = make([]*Attestation, num)
+		for indx, elem := range elems {
+			if b.Attestations[indx] == nil {
+				b.Attestations[indx] = new(Attestation)
+			}
+			if err := b.Attestations[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		elems, err := ssz.TreeList(nodes[6], 16)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.Deposits = make([]*Deposit, num)
+		for indx, elem := range elems {
+			if b.Deposits[indx] == nil {
+				b.Deposits[indx] = new(Deposit)
+			}
+			if err := b.Deposits[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		elems, err := ssz.TreeList(nodes[7], 16)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+		for indx, elem := range elems {
+			if b.VoluntaryExits[indx] == nil {
+				b.VoluntaryExits[indx] = new(SignedVoluntaryExit)
+			}
+			if err := b.VoluntaryExits[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (8) 'SyncAggregate'
+	{
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if err := b.SyncAggregate.FromTree(nodes[8]); err != nil {
+			return err
+		}
+	}
+
+	// Field (9) 'ExecutionPayload'
+	{
+		if b.ExecutionPayload == nil {
+			b.ExecutionPayload = new(ExecutionPayload)
+		}
+		if err := b.ExecutionPayload.FromTree(nodes[9]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the BeaconStateAltair object
+func (b *BeaconStateAltair) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconStateAltair object to a target array
+func (b *BeaconStateAltair) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(2736629)
+
+	// Field (0) 'GenesisTime'
+	dst = ssz.MarshalUint64(dst, b.GenesisTime)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if size := len(b.GenesisValidatorsRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconStateAltair.GenesisValidatorsRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.GenesisValidatorsRoot...)
+
+	// Field (2) 'Slot'
+	dst = ssz.MarshalUint64(dst, b.Slot)
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'BlockRoots'
+	if size := len(b.BlockRoots); size != 8192 {
+		err = ssz.ErrVectorLengthFn("BeaconStateAltair.BlockRoots", size, 8192)
+		return
+	}
+	for ii := 0; ii < 8192; ii++ {
+		if size := len(b.BlockRoots[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateAltair.BlockRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.BlockRoots[ii]...)
+	}
+
+	// Field (6) 'StateRoots'
+	if size := len(b.StateRoots); size != 8192 {
 		err = ssz.ErrVectorLengthFn("BeaconStateAltair.StateRoots", size, 8192)
 		return
 	}
@@ -4870,7 +7107,7 @@ func (b *BeaconStateAltair) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o7 < 2736629 {
+	if o7 != 2736629 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -4926,6 +7163,9 @@ func (b *BeaconStateAltair) UnmarshalSSZ(buf []byte) error {
 	}
 
 	// Field (17) 'JustificationBits'
+	if err = ssz.ValidateBitvector(buf[2687256:2687257], 4); err != nil {
+		return err
+	}
 	if cap(b.JustificationBits) == 0 {
 		b.JustificationBits = make([]byte, 0, len(buf[2687256:2687257]))
 	}
@@ -5291,6 +7531,7 @@ func (b *BeaconStateAltair) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		for _, i := range b.Slashings {
 			hh.AppendUint64(i)
 		}
+		hh.FillUpTo32()
 		hh.Merkleize(subIndx)
 	}
 
@@ -5389,92 +7630,470 @@ func (b *BeaconStateAltair) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
-// MarshalSSZ ssz marshals the BeaconStateBellatrix object
-func (b *BeaconStateBellatrix) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
-}
-
-// MarshalSSZTo ssz marshals the BeaconStateBellatrix object to a target array
-func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(2736633)
+// SSZSchema returns the ssz schema of the BeaconStateAltair object
+func (b *BeaconStateAltair) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "GenesisTime", Tag: "genesis_time", Schema: ssz.UintSchema(8)},
+			{Name: "GenesisValidatorsRoot", Tag: "genesis_validators_root", Schema: ssz.BytesSchema(32)},
+			{Name: "Slot", Tag: "slot", Schema: ssz.UintSchema(8)},
+			{Name: "Fork", Tag: "fork", Schema: ssz.SchemaOf(new(Fork))},
+			{Name: "LatestBlockHeader", Tag: "latest_block_header", Schema: ssz.SchemaOf(new(BeaconBlockHeader))},
+			{Name: "BlockRoots", Tag: "block_roots", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 8192)},
+			{Name: "StateRoots", Tag: "state_roots", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 8192)},
+			{Name: "HistoricalRoots", Tag: "historical_roots", Schema: ssz.ListSchema(ssz.BytesSchema(32), 16777216)},
+			{Name: "Eth1Data", Tag: "eth1_data", Schema: ssz.SchemaOf(new(Eth1Data))},
+			{Name: "Eth1DataVotes", Tag: "eth1_data_votes", Schema: ssz.ListSchema(ssz.SchemaOf(new(Eth1Data)), 2048)},
+			{Name: "Eth1DepositIndex", Tag: "eth1_deposit_index", Schema: ssz.UintSchema(8)},
+			{Name: "Validators", Tag: "validators", Schema: ssz.ListSchema(ssz.SchemaOf(new(Validator)), 1099511627776)},
+			{Name: "Balances", Tag: "balances", Schema: ssz.ListSchema(ssz.UintSchema(8), 1099511627776)},
+			{Name: "RandaoMixes", Tag: "randao_mixes", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 65536)},
+			{Name: "Slashings", Tag: "slashings", Schema: ssz.VectorSchema(ssz.UintSchema(8), 8192)},
+			{Name: "PreviousEpochParticipation", Tag: "previous_epoch_participation", Schema: ssz.ByteListSchema(1099511627776)},
+			{Name: "CurrentEpochParticipation", Tag: "current_epoch_participation", Schema: ssz.ByteListSchema(1099511627776)},
+			{Name: "JustificationBits", Tag: "justification_bits", Schema: ssz.BytesSchema(1)},
+			{Name: "PreviousJustifiedCheckpoint", Tag: "previous_justified_checkpoint", Schema: ssz.SchemaOf(new(Checkpoint))},
+			{Name: "CurrentJustifiedCheckpoint", Tag: "current_justified_checkpoint", Schema: ssz.SchemaOf(new(Checkpoint))},
+			{Name: "FinalizedCheckpoint", Tag: "finalized_checkpoint", Schema: ssz.SchemaOf(new(Checkpoint))},
+			{Name: "InactivityScores", Tag: "inactivity_scores", Schema: ssz.ListSchema(ssz.UintSchema(8), 1099511627776)},
+			{Name: "CurrentSyncCommittee", Tag: "current_sync_committee", Schema: ssz.SchemaOf(new(SyncCommittee))},
+			{Name: "NextSyncCommittee", Tag: "next_sync_committee", Schema: ssz.SchemaOf(new(SyncCommittee))},
+		},
+	}
+}
+
+// Generalized indices of the fields of the BeaconStateAltair object
+const (
+	BeaconStateAltair_GenesisTimeGIndex                 = 32
+	BeaconStateAltair_GenesisValidatorsRootGIndex       = 33
+	BeaconStateAltair_SlotGIndex                        = 34
+	BeaconStateAltair_ForkGIndex                        = 35
+	BeaconStateAltair_LatestBlockHeaderGIndex           = 36
+	BeaconStateAltair_BlockRootsGIndex                  = 37
+	BeaconStateAltair_StateRootsGIndex                  = 38
+	BeaconStateAltair_HistoricalRootsGIndex             = 39
+	BeaconStateAltair_Eth1DataGIndex                    = 40
+	BeaconStateAltair_Eth1DataVotesGIndex               = 41
+	BeaconStateAltair_Eth1DepositIndexGIndex            = 42
+	BeaconStateAltair_ValidatorsGIndex                  = 43
+	BeaconStateAltair_BalancesGIndex                    = 44
+	BeaconStateAltair_RandaoMixesGIndex                 = 45
+	BeaconStateAltair_SlashingsGIndex                   = 46
+	BeaconStateAltair_PreviousEpochParticipationGIndex  = 47
+	BeaconStateAltair_CurrentEpochParticipationGIndex   = 48
+	BeaconStateAltair_JustificationBitsGIndex           = 49
+	BeaconStateAltair_PreviousJustifiedCheckpointGIndex = 50
+	BeaconStateAltair_CurrentJustifiedCheckpointGIndex  = 51
+	BeaconStateAltair_FinalizedCheckpointGIndex         = 52
+	BeaconStateAltair_InactivityScoresGIndex            = 53
+	BeaconStateAltair_CurrentSyncCommitteeGIndex        = 54
+	BeaconStateAltair_NextSyncCommitteeGIndex           = 55
+)
 
+// FromTree decodes the BeaconStateAltair object from its tree
+func (b *BeaconStateAltair) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 24)
+	if err != nil {
+		return err
+	}
 	// Field (0) 'GenesisTime'
-	dst = ssz.MarshalUint64(dst, b.GenesisTime)
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		b.GenesisTime = ssz.UnmarshallUint64(buf)
+	}
 
 	// Field (1) 'GenesisValidatorsRoot'
-	if size := len(b.GenesisValidatorsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.GenesisValidatorsRoot", size, 32)
-		return
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 32)
+		if err != nil {
+			return err
+		}
+		if cap(b.GenesisValidatorsRoot) == 0 {
+			b.GenesisValidatorsRoot = make([]byte, 0, len(buf))
+		}
+		b.GenesisValidatorsRoot = append(b.GenesisValidatorsRoot, buf...)
 	}
-	dst = append(dst, b.GenesisValidatorsRoot...)
 
 	// Field (2) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 8)
+		if err != nil {
+			return err
+		}
+		b.Slot = ssz.UnmarshallUint64(buf)
+	}
 
 	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
-	}
-	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
-		return
+	{
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if err := b.Fork.FromTree(nodes[3]); err != nil {
+			return err
+		}
 	}
 
 	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
-		return
+	{
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if err := b.LatestBlockHeader.FromTree(nodes[4]); err != nil {
+			return err
+		}
 	}
 
 	// Field (5) 'BlockRoots'
-	if size := len(b.BlockRoots); size != 8192 {
-		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.BlockRoots", size, 8192)
-		return
-	}
-	for ii := 0; ii < 8192; ii++ {
-		if size := len(b.BlockRoots[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.BlockRoots[ii]", size, 32)
-			return
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[5], 8192, 32)
+		if err != nil {
+			return err
+		}
+		b.BlockRoots = make([][]byte, 8192)
+		for ii := 0; ii < 8192; ii++ {
+			if cap(b.BlockRoots[ii]) == 0 {
+				b.BlockRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			b.BlockRoots[ii] = append(b.BlockRoots[ii], buf[ii*32:(ii+1)*32]...)
 		}
-		dst = append(dst, b.BlockRoots[ii]...)
 	}
 
 	// Field (6) 'StateRoots'
-	if size := len(b.StateRoots); size != 8192 {
-		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.StateRoots", size, 8192)
-		return
-	}
-	for ii := 0; ii < 8192; ii++ {
-		if size := len(b.StateRoots[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.StateRoots[ii]", size, 32)
-			return
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[6], 8192, 32)
+		if err != nil {
+			return err
+		}
+		b.StateRoots = make([][]byte, 8192)
+		for ii := 0; ii < 8192; ii++ {
+			if cap(b.StateRoots[ii]) == 0 {
+				b.StateRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			b.StateRoots[ii] = append(b.StateRoots[ii], buf[ii*32:(ii+1)*32]...)
 		}
-		dst = append(dst, b.StateRoots[ii]...)
 	}
 
-	// Offset (7) 'HistoricalRoots'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.HistoricalRoots) * 32
+	// Field (7) 'HistoricalRoots'
+	{
+		buf, err := ssz.TreeListBytes(nodes[7], 16777216, 32)
+		if err != nil {
+			return err
+		}
+		num, err := ssz.DivideInt2(len(buf), 32, 16777216)
+		if err != nil {
+			return err
+		}
+		b.HistoricalRoots = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(b.HistoricalRoots[ii]) == 0 {
+				b.HistoricalRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii], buf[ii*32:(ii+1)*32]...)
+		}
+	}
 
 	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
-		return
+	{
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err := b.Eth1Data.FromTree(nodes[8]); err != nil {
+			return err
+		}
 	}
 
-	// Offset (9) 'Eth1DataVotes'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Eth1DataVotes) * 72
-
-	// Field (10) 'Eth1DepositIndex'
-	dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
-
-	// Offset (11) 'Validators'
-	dst = ssz.WriteOffset(dst, offset)
+	// Field (9) 'Eth1DataVotes'
+	{
+		elems, err := ssz.TreeList(nodes[9], 2048)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.Eth1DataVotes = make([]*Eth1Data, num)
+		for indx, elem := range elems {
+			if b.Eth1DataVotes[indx] == nil {
+				b.Eth1DataVotes[indx] = new(Eth1Data)
+			}
+			if err := b.Eth1DataVotes[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (10) 'Eth1DepositIndex'
+	{
+		buf, err := ssz.TreeBytes(nodes[10], 8)
+		if err != nil {
+			return err
+		}
+		b.Eth1DepositIndex = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (11) 'Validators'
+	{
+		elems, err := ssz.TreeList(nodes[11], 1099511627776)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.Validators = make([]*Validator, num)
+		for indx, elem := range elems {
+			if b.Validators[indx] == nil {
+				b.Validators[indx] = new(Validator)
+			}
+			if err := b.Validators[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (12) 'Balances'
+	{
+		buf, err := ssz.TreeListPacked(nodes[12], 1099511627776, 8)
+		if err != nil {
+			return err
+		}
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.Balances = ssz.ExtendUint64(b.Balances, num)
+		for ii := 0; ii < num; ii++ {
+			b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (13) 'RandaoMixes'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[13], 65536, 32)
+		if err != nil {
+			return err
+		}
+		b.RandaoMixes = make([][]byte, 65536)
+		for ii := 0; ii < 65536; ii++ {
+			if cap(b.RandaoMixes[ii]) == 0 {
+				b.RandaoMixes[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			b.RandaoMixes[ii] = append(b.RandaoMixes[ii], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+
+	// Field (14) 'Slashings'
+	{
+		buf, err := ssz.TreePacked(nodes[14], 65536)
+		if err != nil {
+			return err
+		}
+		b.Slashings = ssz.ExtendUint64(b.Slashings, 8192)
+		for ii := 0; ii < 8192; ii++ {
+			b.Slashings[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	{
+		buf, err := ssz.TreeListPacked(nodes[15], 1099511627776, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 1099511627776 {
+			return ssz.ErrBytesLength
+		}
+		if cap(b.PreviousEpochParticipation) == 0 {
+			b.PreviousEpochParticipation = make([]byte, 0, len(buf))
+		}
+		b.PreviousEpochParticipation = append(b.PreviousEpochParticipation, buf...)
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	{
+		buf, err := ssz.TreeListPacked(nodes[16], 1099511627776, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 1099511627776 {
+			return ssz.ErrBytesLength
+		}
+		if cap(b.CurrentEpochParticipation) == 0 {
+			b.CurrentEpochParticipation = make([]byte, 0, len(buf))
+		}
+		b.CurrentEpochParticipation = append(b.CurrentEpochParticipation, buf...)
+	}
+
+	// Field (17) 'JustificationBits'
+	{
+		buf, err := ssz.TreeBytes(nodes[17], 1)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitvector(buf, 4); err != nil {
+			return err
+		}
+		if cap(b.JustificationBits) == 0 {
+			b.JustificationBits = make([]byte, 0, len(buf))
+		}
+		b.JustificationBits = append(b.JustificationBits, buf...)
+	}
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	{
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err := b.PreviousJustifiedCheckpoint.FromTree(nodes[18]); err != nil {
+			return err
+		}
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	{
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err := b.CurrentJustifiedCheckpoint.FromTree(nodes[19]); err != nil {
+			return err
+		}
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	{
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if err := b.FinalizedCheckpoint.FromTree(nodes[20]); err != nil {
+			return err
+		}
+	}
+
+	// Field (21) 'InactivityScores'
+	{
+		buf, err := ssz.TreeListPacked(nodes[21], 1099511627776, 8)
+		if err != nil {
+			return err
+		}
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.InactivityScores = ssz.ExtendUint64(b.InactivityScores, num)
+		for ii := 0; ii < num; ii++ {
+			b.InactivityScores[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (22) 'CurrentSyncCommittee'
+	{
+		if b.CurrentSyncCommittee == nil {
+			b.CurrentSyncCommittee = new(SyncCommittee)
+		}
+		if err := b.CurrentSyncCommittee.FromTree(nodes[22]); err != nil {
+			return err
+		}
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	{
+		if b.NextSyncCommittee == nil {
+			b.NextSyncCommittee = new(SyncCommittee)
+		}
+		if err := b.NextSyncCommittee.FromTree(nodes[23]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the BeaconStateBellatrix object
+func (b *BeaconStateBellatrix) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconStateBellatrix object to a target array
+func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(2736633)
+
+	// Field (0) 'GenesisTime'
+	dst = ssz.MarshalUint64(dst, b.GenesisTime)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if size := len(b.GenesisValidatorsRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.GenesisValidatorsRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.GenesisValidatorsRoot...)
+
+	// Field (2) 'Slot'
+	dst = ssz.MarshalUint64(dst, b.Slot)
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'BlockRoots'
+	if size := len(b.BlockRoots); size != 8192 {
+		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.BlockRoots", size, 8192)
+		return
+	}
+	for ii := 0; ii < 8192; ii++ {
+		if size := len(b.BlockRoots[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.BlockRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.BlockRoots[ii]...)
+	}
+
+	// Field (6) 'StateRoots'
+	if size := len(b.StateRoots); size != 8192 {
+		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.StateRoots", size, 8192)
+		return
+	}
+	for ii := 0; ii < 8192; ii++ {
+		if size := len(b.StateRoots[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.StateRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.StateRoots[ii]...)
+	}
+
+	// Offset (7) 'HistoricalRoots'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.HistoricalRoots) * 32
+
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (9) 'Eth1DataVotes'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Eth1DataVotes) * 72
+
+	// Field (10) 'Eth1DepositIndex'
+	dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+
+	// Offset (11) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
 	offset += len(b.Validators) * 121
 
 	// Offset (12) 'Balances'
@@ -5702,7 +8321,7 @@ func (b *BeaconStateBellatrix) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o7 < 2736633 {
+	if o7 != 2736633 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -5758,6 +8377,9 @@ func (b *BeaconStateBellatrix) UnmarshalSSZ(buf []byte) error {
 	}
 
 	// Field (17) 'JustificationBits'
+	if err = ssz.ValidateBitvector(buf[2687256:2687257], 4); err != nil {
+		return err
+	}
 	if cap(b.JustificationBits) == 0 {
 		b.JustificationBits = make([]byte, 0, len(buf[2687256:2687257]))
 	}
@@ -6146,6 +8768,7 @@ func (b *BeaconStateBellatrix) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		for _, i := range b.Slashings {
 			hh.AppendUint64(i)
 		}
+		hh.FillUpTo32()
 		hh.Merkleize(subIndx)
 	}
 
@@ -6249,20 +8872,410 @@ func (b *BeaconStateBellatrix) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
-// MarshalSSZ ssz marshals the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
-}
-
-// MarshalSSZTo ssz marshals the SignedBeaconBlockHeader object to a target array
-func (s *SignedBeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// SSZSchema returns the ssz schema of the BeaconStateBellatrix object
+func (b *BeaconStateBellatrix) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "GenesisTime", Tag: "genesis_time", Schema: ssz.UintSchema(8)},
+			{Name: "GenesisValidatorsRoot", Tag: "genesis_validators_root", Schema: ssz.BytesSchema(32)},
+			{Name: "Slot", Tag: "slot", Schema: ssz.UintSchema(8)},
+			{Name: "Fork", Tag: "fork", Schema: ssz.SchemaOf(new(Fork))},
+			{Name: "LatestBlockHeader", Tag: "latest_block_header", Schema: ssz.SchemaOf(new(BeaconBlockHeader))},
+			{Name: "BlockRoots", Tag: "block_roots", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 8192)},
+			{Name: "StateRoots", Tag: "state_roots", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 8192)},
+			{Name: "HistoricalRoots", Tag: "historical_roots", Schema: ssz.ListSchema(ssz.BytesSchema(32), 16777216)},
+			{Name: "Eth1Data", Tag: "eth1_data", Schema: ssz.SchemaOf(new(Eth1Data))},
+			{Name: "Eth1DataVotes", Tag: "eth1_data_votes", Schema: ssz.ListSchema(ssz.SchemaOf(new(Eth1Data)), 2048)},
+			{Name: "Eth1DepositIndex", Tag: "eth1_deposit_index", Schema: ssz.UintSchema(8)},
+			{Name: "Validators", Tag: "validators", Schema: ssz.ListSchema(ssz.SchemaOf(new(Validator)), 1099511627776)},
+			{Name: "Balances", Tag: "balances", Schema: ssz.ListSchema(ssz.UintSchema(8), 1099511627776)},
+			{Name: "RandaoMixes", Tag: "randao_mixes", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 65536)},
+			{Name: "Slashings", Tag: "slashings", Schema: ssz.VectorSchema(ssz.UintSchema(8), 8192)},
+			{Name: "PreviousEpochParticipation", Tag: "previous_epoch_participation", Schema: ssz.ByteListSchema(1099511627776)},
+			{Name: "CurrentEpochParticipation", Tag: "current_epoch_participation", Schema: ssz.ByteListSchema(1099511627776)},
+			{Name: "JustificationBits", Tag: "justification_bits", Schema: ssz.BytesSchema(1)},
+			{Name: "PreviousJustifiedCheckpoint", Tag: "previous_justified_checkpoint", Schema: ssz.SchemaOf(new(Checkpoint))},
+			{Name: "CurrentJustifiedCheckpoint", Tag: "current_justified_checkpoint", Schema: ssz.SchemaOf(new(Checkpoint))},
+			{Name: "FinalizedCheckpoint", Tag: "finalized_checkpoint", Schema: ssz.SchemaOf(new(Checkpoint))},
+			{Name: "InactivityScores", Tag: "inactivity_scores", Schema: ssz.ListSchema(ssz.UintSchema(8), 1099511627776)},
+			{Name: "CurrentSyncCommittee", Tag: "current_sync_committee", Schema: ssz.SchemaOf(new(SyncCommittee))},
+			{Name: "NextSyncCommittee", Tag: "next_sync_committee", Schema: ssz.SchemaOf(new(SyncCommittee))},
+			{Name: "LatestExecutionPayloadHeader", Tag: "latest_execution_payload_header", Schema: ssz.SchemaOf(new(ExecutionPayloadHeader))},
+		},
+	}
+}
+
+// Generalized indices of the fields of the BeaconStateBellatrix object
+const (
+	BeaconStateBellatrix_GenesisTimeGIndex                  = 32
+	BeaconStateBellatrix_GenesisValidatorsRootGIndex        = 33
+	BeaconStateBellatrix_SlotGIndex                         = 34
+	BeaconStateBellatrix_ForkGIndex                         = 35
+	BeaconStateBellatrix_LatestBlockHeaderGIndex            = 36
+	BeaconStateBellatrix_BlockRootsGIndex                   = 37
+	BeaconStateBellatrix_StateRootsGIndex                   = 38
+	BeaconStateBellatrix_HistoricalRootsGIndex              = 39
+	BeaconStateBellatrix_Eth1DataGIndex                     = 40
+	BeaconStateBellatrix_Eth1DataVotesGIndex                = 41
+	BeaconStateBellatrix_Eth1DepositIndexGIndex             = 42
+	BeaconStateBellatrix_ValidatorsGIndex                   = 43
+	BeaconStateBellatrix_BalancesGIndex                     = 44
+	BeaconStateBellatrix_RandaoMixesGIndex                  = 45
+	BeaconStateBellatrix_SlashingsGIndex                    = 46
+	BeaconStateBellatrix_PreviousEpochParticipationGIndex   = 47
+	BeaconStateBellatrix_CurrentEpochParticipationGIndex    = 48
+	BeaconStateBellatrix_JustificationBitsGIndex            = 49
+	BeaconStateBellatrix_PreviousJustifiedCheckpointGIndex  = 50
+	BeaconStateBellatrix_CurrentJustifiedCheckpointGIndex   = 51
+	BeaconStateBellatrix_FinalizedCheckpointGIndex          = 52
+	BeaconStateBellatrix_InactivityScoresGIndex             = 53
+	BeaconStateBellatrix_CurrentSyncCommitteeGIndex         = 54
+	BeaconStateBellatrix_NextSyncCommitteeGIndex            = 55
+	BeaconStateBellatrix_LatestExecutionPayloadHeaderGIndex = 56
+)
 
-	// Field (0) 'Header'
-	if s.Header == nil {
-		s.Header = new(BeaconBlockHeader)
+// FromTree decodes the BeaconStateBellatrix object from its tree
+func (b *BeaconStateBellatrix) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 25)
+	if err != nil {
+		return err
 	}
-	if dst, err = s.Header.MarshalSSZTo(dst); err != nil {
+	// Field (0) 'GenesisTime'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		b.GenesisTime = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'GenesisValidatorsRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 32)
+		if err != nil {
+			return err
+		}
+		if cap(b.GenesisValidatorsRoot) == 0 {
+			b.GenesisValidatorsRoot = make([]byte, 0, len(buf))
+		}
+		b.GenesisValidatorsRoot = append(b.GenesisValidatorsRoot, buf...)
+	}
+
+	// Field (2) 'Slot'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 8)
+		if err != nil {
+			return err
+		}
+		b.Slot = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (3) 'Fork'
+	{
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if err := b.Fork.FromTree(nodes[3]); err != nil {
+			return err
+		}
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	{
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if err := b.LatestBlockHeader.FromTree(nodes[4]); err != nil {
+			return err
+		}
+	}
+
+	// Field (5) 'BlockRoots'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[5], 8192, 32)
+		if err != nil {
+			return err
+		}
+		b.BlockRoots = make([][]byte, 8192)
+		for ii := 0; ii < 8192; ii++ {
+			if cap(b.BlockRoots[ii]) == 0 {
+				b.BlockRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			b.BlockRoots[ii] = append(b.BlockRoots[ii], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+
+	// Field (6) 'StateRoots'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[6], 8192, 32)
+		if err != nil {
+			return err
+		}
+		b.StateRoots = make([][]byte, 8192)
+		for ii := 0; ii < 8192; ii++ {
+			if cap(b.StateRoots[ii]) == 0 {
+				b.StateRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			b.StateRoots[ii] = append(b.StateRoots[ii], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+
+	// Field (7) 'HistoricalRoots'
+	{
+		buf, err := ssz.TreeListBytes(nodes[7], 16777216, 32)
+		if err != nil {
+			return err
+		}
+		num, err := ssz.DivideInt2(len(buf), 32, 16777216)
+		if err != nil {
+			return err
+		}
+		b.HistoricalRoots = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(b.HistoricalRoots[ii]) == 0 {
+				b.HistoricalRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+
+	// Field (8) 'Eth1Data'
+	{
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err := b.Eth1Data.FromTree(nodes[8]); err != nil {
+			return err
+		}
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	{
+		elems, err := ssz.TreeList(nodes[9], 2048)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.Eth1DataVotes = make([]*Eth1Data, num)
+		for indx, elem := range elems {
+			if b.Eth1DataVotes[indx] == nil {
+				b.Eth1DataVotes[indx] = new(Eth1Data)
+			}
+			if err := b.Eth1DataVotes[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (10) 'Eth1DepositIndex'
+	{
+		buf, err := ssz.TreeBytes(nodes[10], 8)
+		if err != nil {
+			return err
+		}
+		b.Eth1DepositIndex = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (11) 'Validators'
+	{
+		elems, err := ssz.TreeList(nodes[11], 1099511627776)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.Validators = make([]*Validator, num)
+		for indx, elem := range elems {
+			if b.Validators[indx] == nil {
+				b.Validators[indx] = new(Validator)
+			}
+			if err := b.Validators[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (12) 'Balances'
+	{
+		buf, err := ssz.TreeListPacked(nodes[12], 1099511627776, 8)
+		if err != nil {
+			return err
+		}
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.Balances = ssz.ExtendUint64(b.Balances, num)
+		for ii := 0; ii < num; ii++ {
+			b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (13) 'RandaoMixes'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[13], 65536, 32)
+		if err != nil {
+			return err
+		}
+		b.RandaoMixes = make([][]byte, 65536)
+		for ii := 0; ii < 65536; ii++ {
+			if cap(b.RandaoMixes[ii]) == 0 {
+				b.RandaoMixes[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			b.RandaoMixes[ii] = append(b.RandaoMixes[ii], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+
+	// Field (14) 'Slashings'
+	{
+		buf, err := ssz.TreePacked(nodes[14], 65536)
+		if err != nil {
+			return err
+		}
+		b.Slashings = ssz.ExtendUint64(b.Slashings, 8192)
+		for ii := 0; ii < 8192; ii++ {
+			b.Slashings[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	{
+		buf, err := ssz.TreeListPacked(nodes[15], 1099511627776, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 1099511627776 {
+			return ssz.ErrBytesLength
+		}
+		if cap(b.PreviousEpochParticipation) == 0 {
+			b.PreviousEpochParticipation = make([]byte, 0, len(buf))
+		}
+		b.PreviousEpochParticipation = append(b.PreviousEpochParticipation, buf...)
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	{
+		buf, err := ssz.TreeListPacked(nodes[16], 1099511627776, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 1099511627776 {
+			return ssz.ErrBytesLength
+		}
+		if cap(b.CurrentEpochParticipation) == 0 {
+			b.CurrentEpochParticipation = make([]byte, 0, len(buf))
+		}
+		b.CurrentEpochParticipation = append(b.CurrentEpochParticipation, buf...)
+	}
+
+	// Field (17) 'JustificationBits'
+	{
+		buf, err := ssz.TreeBytes(nodes[17], 1)
+		if err != nil {
+			return err
+		}
+		if err = ssz.ValidateBitvector(buf, 4); err != nil {
+			return err
+		}
+		if cap(b.JustificationBits) == 0 {
+			b.JustificationBits = make([]byte, 0, len(buf))
+		}
+		b.JustificationBits = append(b.JustificationBits, buf...)
+	}
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	{
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err := b.PreviousJustifiedCheckpoint.FromTree(nodes[18]); err != nil {
+			return err
+		}
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	{
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err := b.CurrentJustifiedCheckpoint.FromTree(nodes[19]); err != nil {
+			return err
+		}
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	{
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if err := b.FinalizedCheckpoint.FromTree(nodes[20]); err != nil {
+			return err
+		}
+	}
+
+	// Field (21) 'InactivityScores'
+	{
+		buf, err := ssz.TreeListPacked(nodes[21], 1099511627776, 8)
+		if err != nil {
+			return err
+		}
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.InactivityScores = ssz.ExtendUint64(b.InactivityScores, num)
+		for ii := 0; ii < num; ii++ {
+			b.InactivityScores[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (22) 'CurrentSyncCommittee'
+	{
+		if b.CurrentSyncCommittee == nil {
+			b.CurrentSyncCommittee = new(SyncCommittee)
+		}
+		if err := b.CurrentSyncCommittee.FromTree(nodes[22]); err != nil {
+			return err
+		}
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	{
+		if b.NextSyncCommittee == nil {
+			b.NextSyncCommittee = new(SyncCommittee)
+		}
+		if err := b.NextSyncCommittee.FromTree(nodes[23]); err != nil {
+			return err
+		}
+	}
+
+	// Field (24) 'LatestExecutionPayloadHeader'
+	{
+		if b.LatestExecutionPayloadHeader == nil {
+			b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeader)
+		}
+		if err := b.LatestExecutionPayloadHeader.FromTree(nodes[24]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBeaconBlockHeader object to a target array
+func (s *SignedBeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		s.Header = new(BeaconBlockHeader)
+	}
+	if dst, err = s.Header.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
@@ -6340,7 +9353,55 @@ func (s *SignedBeaconBlockHeader) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockHeader object
+// SSZSchema returns the ssz schema of the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Header", Tag: "message", Schema: ssz.SchemaOf(new(BeaconBlockHeader))},
+			{Name: "Signature", Tag: "signature", Schema: ssz.BytesSchema(96)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the SignedBeaconBlockHeader object
+const (
+	SignedBeaconBlockHeader_HeaderGIndex    = 2
+	SignedBeaconBlockHeader_SignatureGIndex = 3
+)
+
+// FromTree decodes the SignedBeaconBlockHeader object from its tree
+func (s *SignedBeaconBlockHeader) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Header'
+	{
+		if s.Header == nil {
+			s.Header = new(BeaconBlockHeader)
+		}
+		if err := s.Header.FromTree(nodes[0]); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Signature'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 96)
+		if err != nil {
+			return err
+		}
+		if cap(s.Signature) == 0 {
+			s.Signature = make([]byte, 0, len(buf))
+		}
+		s.Signature = append(s.Signature, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockHeader object
 func (b *BeaconBlockHeader) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
@@ -6465,6 +9526,92 @@ func (b *BeaconBlockHeader) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
+// SSZSchema returns the ssz schema of the BeaconBlockHeader object
+func (b *BeaconBlockHeader) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Slot", Tag: "slot", Schema: ssz.UintSchema(8)},
+			{Name: "ProposerIndex", Tag: "proposer_index", Schema: ssz.UintSchema(8)},
+			{Name: "ParentRoot", Tag: "parent_root", Schema: ssz.BytesSchema(32)},
+			{Name: "StateRoot", Tag: "state_root", Schema: ssz.BytesSchema(32)},
+			{Name: "BodyRoot", Tag: "body_root", Schema: ssz.BytesSchema(32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the BeaconBlockHeader object
+const (
+	BeaconBlockHeader_SlotGIndex          = 8
+	BeaconBlockHeader_ProposerIndexGIndex = 9
+	BeaconBlockHeader_ParentRootGIndex    = 10
+	BeaconBlockHeader_StateRootGIndex     = 11
+	BeaconBlockHeader_BodyRootGIndex      = 12
+)
+
+// FromTree decodes the BeaconBlockHeader object from its tree
+func (b *BeaconBlockHeader) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 5)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Slot'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		b.Slot = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'ProposerIndex'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 8)
+		if err != nil {
+			return err
+		}
+		b.ProposerIndex = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (2) 'ParentRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 32)
+		if err != nil {
+			return err
+		}
+		if cap(b.ParentRoot) == 0 {
+			b.ParentRoot = make([]byte, 0, len(buf))
+		}
+		b.ParentRoot = append(b.ParentRoot, buf...)
+	}
+
+	// Field (3) 'StateRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 32)
+		if err != nil {
+			return err
+		}
+		if cap(b.StateRoot) == 0 {
+			b.StateRoot = make([]byte, 0, len(buf))
+		}
+		b.StateRoot = append(b.StateRoot, buf...)
+	}
+
+	// Field (4) 'BodyRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[4], 32)
+		if err != nil {
+			return err
+		}
+		if cap(b.BodyRoot) == 0 {
+			b.BodyRoot = make([]byte, 0, len(buf))
+		}
+		b.BodyRoot = append(b.BodyRoot, buf...)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the ErrorResponse object
 func (e *ErrorResponse) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(e)
@@ -6504,7 +9651,7 @@ func (e *ErrorResponse) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
+	if o0 != 4 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -6564,6 +9711,45 @@ func (e *ErrorResponse) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(e)
 }
 
+// SSZSchema returns the ssz schema of the ErrorResponse object
+func (e *ErrorResponse) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Message", Tag: "", Schema: ssz.ByteListSchema(256)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the ErrorResponse object
+const (
+	ErrorResponse_MessageGIndex = 1
+)
+
+// FromTree decodes the ErrorResponse object from its tree
+func (e *ErrorResponse) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Message'
+	{
+		buf, err := ssz.TreeListPacked(nodes[0], 256, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		if cap(e.Message) == 0 {
+			e.Message = make([]byte, 0, len(buf))
+		}
+		e.Message = append(e.Message, buf...)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Dummy object
 func (d *Dummy) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(d)
@@ -6611,6 +9797,20 @@ func (d *Dummy) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(d)
 }
 
+// SSZSchema returns the ssz schema of the Dummy object
+func (d *Dummy) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind:   ssz.KindContainer,
+		Fields: []*ssz.Field{},
+	}
+}
+
+// FromTree decodes the Dummy object from its tree
+func (d *Dummy) FromTree(n *ssz.Node) error {
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the SyncCommittee object
 func (s *SyncCommittee) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
@@ -6706,6 +9906,56 @@ func (s *SyncCommittee) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
 
+// SSZSchema returns the ssz schema of the SyncCommittee object
+func (s *SyncCommittee) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "PubKeys", Tag: "pubkeys", Schema: ssz.VectorSchema(ssz.BytesSchema(48), 512)},
+			{Name: "AggregatePubKey", Tag: "aggregate_pubkey", Schema: ssz.BytesSchema(48)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the SyncCommittee object
+const (
+	SyncCommittee_PubKeysGIndex         = 2
+	SyncCommittee_AggregatePubKeyGIndex = 3
+)
+
+// FromTree decodes the SyncCommittee object from its tree
+func (s *SyncCommittee) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'PubKeys'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[0], 512, 48)
+		if err != nil {
+			return err
+		}
+		s.PubKeys = make([][]byte, 512)
+		for ii := 0; ii < 512; ii++ {
+			if cap(s.PubKeys[ii]) == 0 {
+				s.PubKeys[ii] = make([]byte, 0, len(buf[ii*48:(ii+1)*48]))
+			}
+			s.PubKeys[ii] = append(s.PubKeys[ii], buf[ii*48:(ii+1)*48]...)
+		}
+	}
+
+	// Field (1) 'AggregatePubKey'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 48)
+		if err != nil {
+			return err
+		}
+		copy(s.AggregatePubKey[:], buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the SyncAggregate object
 func (s *SyncAggregate) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
@@ -6782,6 +10032,53 @@ func (s *SyncAggregate) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
 
+// SSZSchema returns the ssz schema of the SyncAggregate object
+func (s *SyncAggregate) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "SyncCommiteeBits", Tag: "sync_committee_bits", Schema: ssz.BytesSchema(64)},
+			{Name: "SyncCommiteeSignature", Tag: "sync_committee_signature", Schema: ssz.BytesSchema(96)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the SyncAggregate object
+const (
+	SyncAggregate_SyncCommiteeBitsGIndex      = 2
+	SyncAggregate_SyncCommiteeSignatureGIndex = 3
+)
+
+// FromTree decodes the SyncAggregate object from its tree
+func (s *SyncAggregate) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'SyncCommiteeBits'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 64)
+		if err != nil {
+			return err
+		}
+		if cap(s.SyncCommiteeBits) == 0 {
+			s.SyncCommiteeBits = make([]byte, 0, len(buf))
+		}
+		s.SyncCommiteeBits = append(s.SyncCommiteeBits, buf...)
+	}
+
+	// Field (1) 'SyncCommiteeSignature'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 96)
+		if err != nil {
+			return err
+		}
+		copy(s.SyncCommiteeSignature[:], buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the ExecutionPayload object
 func (e *ExecutionPayload) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(e)
@@ -6911,7 +10208,7 @@ func (e *ExecutionPayload) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o10 < 508 {
+	if o10 != 508 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -7071,77 +10368,273 @@ func (e *ExecutionPayload) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(e)
 }
 
-// MarshalSSZ ssz marshals the ExecutionPayloadHeader object
-func (e *ExecutionPayloadHeader) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
-}
-
-// MarshalSSZTo ssz marshals the ExecutionPayloadHeader object to a target array
-func (e *ExecutionPayloadHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(536)
+// SSZSchema returns the ssz schema of the ExecutionPayload object
+func (e *ExecutionPayload) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "ParentHash", Tag: "parent_hash", Schema: ssz.BytesSchema(32)},
+			{Name: "FeeRecipient", Tag: "fee_recipient", Schema: ssz.BytesSchema(20)},
+			{Name: "StateRoot", Tag: "state_root", Schema: ssz.BytesSchema(32)},
+			{Name: "ReceiptsRoot", Tag: "receipts_root", Schema: ssz.BytesSchema(32)},
+			{Name: "LogsBloom", Tag: "logs_bloom", Schema: ssz.BytesSchema(256)},
+			{Name: "PrevRandao", Tag: "prev_randao", Schema: ssz.BytesSchema(32)},
+			{Name: "BlockNumber", Tag: "block_number", Schema: ssz.UintSchema(8)},
+			{Name: "GasLimit", Tag: "gas_limit", Schema: ssz.UintSchema(8)},
+			{Name: "GasUsed", Tag: "gas_used", Schema: ssz.UintSchema(8)},
+			{Name: "Timestamp", Tag: "timestamp", Schema: ssz.UintSchema(8)},
+			{Name: "ExtraData", Tag: "extra_data", Schema: ssz.ByteListSchema(32)},
+			{Name: "BaseFeePerGas", Tag: "base_fee_per_gas", Schema: ssz.BytesSchema(32)},
+			{Name: "BlockHash", Tag: "block_hash", Schema: ssz.BytesSchema(32)},
+			{Name: "Transactions", Tag: "transactions", Schema: ssz.ListSchema(ssz.ByteListSchema(1073741824), 1048576)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the ExecutionPayload object
+const (
+	ExecutionPayload_ParentHashGIndex    = 16
+	ExecutionPayload_FeeRecipientGIndex  = 17
+	ExecutionPayload_StateRootGIndex     = 18
+	ExecutionPayload_ReceiptsRootGIndex  = 19
+	ExecutionPayload_LogsBloomGIndex     = 20
+	ExecutionPayload_PrevRandaoGIndex    = 21
+	ExecutionPayload_BlockNumberGIndex   = 22
+	ExecutionPayload_GasLimitGIndex      = 23
+	ExecutionPayload_GasUsedGIndex       = 24
+	ExecutionPayload_TimestampGIndex     = 25
+	ExecutionPayload_ExtraDataGIndex     = 26
+	ExecutionPayload_BaseFeePerGasGIndex = 27
+	ExecutionPayload_BlockHashGIndex     = 28
+	ExecutionPayload_TransactionsGIndex  = 29
+)
 
+// FromTree decodes the ExecutionPayload object from its tree
+func (e *ExecutionPayload) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 14)
+	if err != nil {
+		return err
+	}
 	// Field (0) 'ParentHash'
-	if size := len(e.ParentHash); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ParentHash", size, 32)
-		return
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.ParentHash[:], buf)
 	}
-	dst = append(dst, e.ParentHash...)
 
 	// Field (1) 'FeeRecipient'
-	if size := len(e.FeeRecipient); size != 20 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.FeeRecipient", size, 20)
-		return
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 20)
+		if err != nil {
+			return err
+		}
+		copy(e.FeeRecipient[:], buf)
 	}
-	dst = append(dst, e.FeeRecipient...)
 
 	// Field (2) 'StateRoot'
-	if size := len(e.StateRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.StateRoot", size, 32)
-		return
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.StateRoot[:], buf)
 	}
-	dst = append(dst, e.StateRoot...)
 
 	// Field (3) 'ReceiptsRoot'
-	if size := len(e.ReceiptsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ReceiptsRoot", size, 32)
-		return
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.ReceiptsRoot[:], buf)
 	}
-	dst = append(dst, e.ReceiptsRoot...)
 
 	// Field (4) 'LogsBloom'
-	if size := len(e.LogsBloom); size != 256 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.LogsBloom", size, 256)
-		return
+	{
+		buf, err := ssz.TreeBytes(nodes[4], 256)
+		if err != nil {
+			return err
+		}
+		copy(e.LogsBloom[:], buf)
 	}
-	dst = append(dst, e.LogsBloom...)
 
 	// Field (5) 'PrevRandao'
-	if size := len(e.PrevRandao); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.PrevRandao", size, 32)
-		return
+	{
+		buf, err := ssz.TreeBytes(nodes[5], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.PrevRandao[:], buf)
 	}
-	dst = append(dst, e.PrevRandao...)
 
 	// Field (6) 'BlockNumber'
-	dst = ssz.MarshalUint64(dst, e.BlockNumber)
+	{
+		buf, err := ssz.TreeBytes(nodes[6], 8)
+		if err != nil {
+			return err
+		}
+		e.BlockNumber = ssz.UnmarshallUint64(buf)
+	}
 
 	// Field (7) 'GasLimit'
-	dst = ssz.MarshalUint64(dst, e.GasLimit)
+	{
+		buf, err := ssz.TreeBytes(nodes[7], 8)
+		if err != nil {
+			return err
+		}
+		e.GasLimit = ssz.UnmarshallUint64(buf)
+	}
 
 	// Field (8) 'GasUsed'
-	dst = ssz.MarshalUint64(dst, e.GasUsed)
+	{
+		buf, err := ssz.TreeBytes(nodes[8], 8)
+		if err != nil {
+			return err
+		}
+		e.GasUsed = ssz.UnmarshallUint64(buf)
+	}
 
 	// Field (9) 'Timestamp'
-	dst = ssz.MarshalUint64(dst, e.Timestamp)
+	{
+		buf, err := ssz.TreeBytes(nodes[9], 8)
+		if err != nil {
+			return err
+		}
+		e.Timestamp = ssz.UnmarshallUint64(buf)
+	}
 
-	// Offset (10) 'ExtraData'
-	dst = ssz.WriteOffset(dst, offset)
+	// Field (10) 'ExtraData'
+	{
+		buf, err := ssz.TreeListPacked(nodes[10], 32, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(e.ExtraData) == 0 {
+			e.ExtraData = make([]byte, 0, len(buf))
+		}
+		e.ExtraData = append(e.ExtraData, buf...)
+	}
 
 	// Field (11) 'BaseFeePerGas'
-	if size := len(e.BaseFeePerGas); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.BaseFeePerGas", size, 32)
-		return
+	{
+		buf, err := ssz.TreeBytes(nodes[11], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.BaseFeePerGas[:], buf)
+	}
+
+	// Field (12) 'BlockHash'
+	{
+		buf, err := ssz.TreeBytes(nodes[12], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.BlockHash[:], buf)
+	}
+
+	// Field (13) 'Transactions'
+	{
+		elems, err := ssz.TreeList(nodes[13], 1048576)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		e.Transactions = make([][]byte, num)
+		for indx, elem := range elems {
+			buf, err := ssz.TreeListPacked(elem, 1073741824, 1)
+			if err != nil {
+				return err
+			}
+			if len(buf) > 1073741824 {
+				return ssz.ErrBytesLength
+			}
+			if cap(e.Transactions[indx]) == 0 {
+				e.Transactions[indx] = make([]byte, 0, len(buf))
+			}
+			e.Transactions[indx] = append(e.Transactions[indx], buf...)
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ExecutionPayloadHeader object to a target array
+func (e *ExecutionPayloadHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(536)
+
+	// Field (0) 'ParentHash'
+	if size := len(e.ParentHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ParentHash", size, 32)
+		return
+	}
+	dst = append(dst, e.ParentHash...)
+
+	// Field (1) 'FeeRecipient'
+	if size := len(e.FeeRecipient); size != 20 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.FeeRecipient", size, 20)
+		return
+	}
+	dst = append(dst, e.FeeRecipient...)
+
+	// Field (2) 'StateRoot'
+	if size := len(e.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.StateRoot", size, 32)
+		return
+	}
+	dst = append(dst, e.StateRoot...)
+
+	// Field (3) 'ReceiptsRoot'
+	if size := len(e.ReceiptsRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ReceiptsRoot", size, 32)
+		return
+	}
+	dst = append(dst, e.ReceiptsRoot...)
+
+	// Field (4) 'LogsBloom'
+	if size := len(e.LogsBloom); size != 256 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.LogsBloom", size, 256)
+		return
+	}
+	dst = append(dst, e.LogsBloom...)
+
+	// Field (5) 'PrevRandao'
+	if size := len(e.PrevRandao); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.PrevRandao", size, 32)
+		return
+	}
+	dst = append(dst, e.PrevRandao...)
+
+	// Field (6) 'BlockNumber'
+	dst = ssz.MarshalUint64(dst, e.BlockNumber)
+
+	// Field (7) 'GasLimit'
+	dst = ssz.MarshalUint64(dst, e.GasLimit)
+
+	// Field (8) 'GasUsed'
+	dst = ssz.MarshalUint64(dst, e.GasUsed)
+
+	// Field (9) 'Timestamp'
+	dst = ssz.MarshalUint64(dst, e.Timestamp)
+
+	// Offset (10) 'ExtraData'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (11) 'BaseFeePerGas'
+	if size := len(e.BaseFeePerGas); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.BaseFeePerGas", size, 32)
+		return
 	}
 	dst = append(dst, e.BaseFeePerGas...)
 
@@ -7233,7 +10726,7 @@ func (e *ExecutionPayloadHeader) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o10 < 536 {
+	if o10 != 536 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -7386,6 +10879,215 @@ func (e *ExecutionPayloadHeader) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(e)
 }
 
+// SSZSchema returns the ssz schema of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "ParentHash", Tag: "parent_hash", Schema: ssz.BytesSchema(32)},
+			{Name: "FeeRecipient", Tag: "fee_recipient", Schema: ssz.BytesSchema(20)},
+			{Name: "StateRoot", Tag: "state_root", Schema: ssz.BytesSchema(32)},
+			{Name: "ReceiptsRoot", Tag: "receipts_root", Schema: ssz.BytesSchema(32)},
+			{Name: "LogsBloom", Tag: "logs_bloom", Schema: ssz.BytesSchema(256)},
+			{Name: "PrevRandao", Tag: "prev_randao", Schema: ssz.BytesSchema(32)},
+			{Name: "BlockNumber", Tag: "block_number", Schema: ssz.UintSchema(8)},
+			{Name: "GasLimit", Tag: "gas_limit", Schema: ssz.UintSchema(8)},
+			{Name: "GasUsed", Tag: "gas_used", Schema: ssz.UintSchema(8)},
+			{Name: "Timestamp", Tag: "timestamp", Schema: ssz.UintSchema(8)},
+			{Name: "ExtraData", Tag: "extra_data", Schema: ssz.ByteListSchema(32)},
+			{Name: "BaseFeePerGas", Tag: "base_fee_per_gas", Schema: ssz.BytesSchema(32)},
+			{Name: "BlockHash", Tag: "block_hash", Schema: ssz.BytesSchema(32)},
+			{Name: "TransactionsRoot", Tag: "transactions_root", Schema: ssz.BytesSchema(32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the ExecutionPayloadHeader object
+const (
+	ExecutionPayloadHeader_ParentHashGIndex       = 16
+	ExecutionPayloadHeader_FeeRecipientGIndex     = 17
+	ExecutionPayloadHeader_StateRootGIndex        = 18
+	ExecutionPayloadHeader_ReceiptsRootGIndex     = 19
+	ExecutionPayloadHeader_LogsBloomGIndex        = 20
+	ExecutionPayloadHeader_PrevRandaoGIndex       = 21
+	ExecutionPayloadHeader_BlockNumberGIndex      = 22
+	ExecutionPayloadHeader_GasLimitGIndex         = 23
+	ExecutionPayloadHeader_GasUsedGIndex          = 24
+	ExecutionPayloadHeader_TimestampGIndex        = 25
+	ExecutionPayloadHeader_ExtraDataGIndex        = 26
+	ExecutionPayloadHeader_BaseFeePerGasGIndex    = 27
+	ExecutionPayloadHeader_BlockHashGIndex        = 28
+	ExecutionPayloadHeader_TransactionsRootGIndex = 29
+)
+
+// FromTree decodes the ExecutionPayloadHeader object from its tree
+func (e *ExecutionPayloadHeader) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 14)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'ParentHash'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+		if cap(e.ParentHash) == 0 {
+			e.ParentHash = make([]byte, 0, len(buf))
+		}
+		e.ParentHash = append(e.ParentHash, buf...)
+	}
+
+	// Field (1) 'FeeRecipient'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 20)
+		if err != nil {
+			return err
+		}
+		if cap(e.FeeRecipient) == 0 {
+			e.FeeRecipient = make([]byte, 0, len(buf))
+		}
+		e.FeeRecipient = append(e.FeeRecipient, buf...)
+	}
+
+	// Field (2) 'StateRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 32)
+		if err != nil {
+			return err
+		}
+		if cap(e.StateRoot) == 0 {
+			e.StateRoot = make([]byte, 0, len(buf))
+		}
+		e.StateRoot = append(e.StateRoot, buf...)
+	}
+
+	// Field (3) 'ReceiptsRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 32)
+		if err != nil {
+			return err
+		}
+		if cap(e.ReceiptsRoot) == 0 {
+			e.ReceiptsRoot = make([]byte, 0, len(buf))
+		}
+		e.ReceiptsRoot = append(e.ReceiptsRoot, buf...)
+	}
+
+	// Field (4) 'LogsBloom'
+	{
+		buf, err := ssz.TreeBytes(nodes[4], 256)
+		if err != nil {
+			return err
+		}
+		if cap(e.LogsBloom) == 0 {
+			e.LogsBloom = make([]byte, 0, len(buf))
+		}
+		e.LogsBloom = append(e.LogsBloom, buf...)
+	}
+
+	// Field (5) 'PrevRandao'
+	{
+		buf, err := ssz.TreeBytes(nodes[5], 32)
+		if err != nil {
+			return err
+		}
+		if cap(e.PrevRandao) == 0 {
+			e.PrevRandao = make([]byte, 0, len(buf))
+		}
+		e.PrevRandao = append(e.PrevRandao, buf...)
+	}
+
+	// Field (6) 'BlockNumber'
+	{
+		buf, err := ssz.TreeBytes(nodes[6], 8)
+		if err != nil {
+			return err
+		}
+		e.BlockNumber = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (7) 'GasLimit'
+	{
+		buf, err := ssz.TreeBytes(nodes[7], 8)
+		if err != nil {
+			return err
+		}
+		e.GasLimit = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (8) 'GasUsed'
+	{
+		buf, err := ssz.TreeBytes(nodes[8], 8)
+		if err != nil {
+			return err
+		}
+		e.GasUsed = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (9) 'Timestamp'
+	{
+		buf, err := ssz.TreeBytes(nodes[9], 8)
+		if err != nil {
+			return err
+		}
+		e.Timestamp = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (10) 'ExtraData'
+	{
+		buf, err := ssz.TreeListPacked(nodes[10], 32, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(e.ExtraData) == 0 {
+			e.ExtraData = make([]byte, 0, len(buf))
+		}
+		e.ExtraData = append(e.ExtraData, buf...)
+	}
+
+	// Field (11) 'BaseFeePerGas'
+	{
+		buf, err := ssz.TreeBytes(nodes[11], 32)
+		if err != nil {
+			return err
+		}
+		if cap(e.BaseFeePerGas) == 0 {
+			e.BaseFeePerGas = make([]byte, 0, len(buf))
+		}
+		e.BaseFeePerGas = append(e.BaseFeePerGas, buf...)
+	}
+
+	// Field (12) 'BlockHash'
+	{
+		buf, err := ssz.TreeBytes(nodes[12], 32)
+		if err != nil {
+			return err
+		}
+		if cap(e.BlockHash) == 0 {
+			e.BlockHash = make([]byte, 0, len(buf))
+		}
+		e.BlockHash = append(e.BlockHash, buf...)
+	}
+
+	// Field (13) 'TransactionsRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[13], 32)
+		if err != nil {
+			return err
+		}
+		if cap(e.TransactionsRoot) == 0 {
+			e.TransactionsRoot = make([]byte, 0, len(buf))
+		}
+		e.TransactionsRoot = append(e.TransactionsRoot, buf...)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the ExecutionPayloadCapella object
 func (e *ExecutionPayloadCapella) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(e)
@@ -7533,7 +11235,7 @@ func (e *ExecutionPayloadCapella) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o10 < 512 {
+	if o10 != 512 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -7736,27 +11438,243 @@ func (e *ExecutionPayloadCapella) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(e)
 }
 
-// MarshalSSZ ssz marshals the ExecutionPayloadHeaderCapella object
-func (e *ExecutionPayloadHeaderCapella) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
-}
-
-// MarshalSSZTo ssz marshals the ExecutionPayloadHeaderCapella object to a target array
-func (e *ExecutionPayloadHeaderCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(568)
+// SSZSchema returns the ssz schema of the ExecutionPayloadCapella object
+func (e *ExecutionPayloadCapella) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "ParentHash", Tag: "parent_hash", Schema: ssz.BytesSchema(32)},
+			{Name: "FeeRecipient", Tag: "fee_recipient", Schema: ssz.BytesSchema(20)},
+			{Name: "StateRoot", Tag: "state_root", Schema: ssz.BytesSchema(32)},
+			{Name: "ReceiptsRoot", Tag: "receipts_root", Schema: ssz.BytesSchema(32)},
+			{Name: "LogsBloom", Tag: "logs_bloom", Schema: ssz.BytesSchema(256)},
+			{Name: "PrevRandao", Tag: "prev_randao", Schema: ssz.BytesSchema(32)},
+			{Name: "BlockNumber", Tag: "block_number", Schema: ssz.UintSchema(8)},
+			{Name: "GasLimit", Tag: "gas_limit", Schema: ssz.UintSchema(8)},
+			{Name: "GasUsed", Tag: "gas_used", Schema: ssz.UintSchema(8)},
+			{Name: "Timestamp", Tag: "timestamp", Schema: ssz.UintSchema(8)},
+			{Name: "ExtraData", Tag: "extra_data", Schema: ssz.ByteListSchema(32)},
+			{Name: "BaseFeePerGas", Tag: "base_fee_per_gas", Schema: ssz.BytesSchema(32)},
+			{Name: "BlockHash", Tag: "block_hash", Schema: ssz.BytesSchema(32)},
+			{Name: "Transactions", Tag: "transactions", Schema: ssz.ListSchema(ssz.ByteListSchema(1073741824), 1048576)},
+			{Name: "Withdrawals", Tag: "withdrawals", Schema: ssz.ListSchema(ssz.SchemaOf(new(Withdrawal)), 16)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the ExecutionPayloadCapella object
+const (
+	ExecutionPayloadCapella_ParentHashGIndex    = 16
+	ExecutionPayloadCapella_FeeRecipientGIndex  = 17
+	ExecutionPayloadCapella_StateRootGIndex     = 18
+	ExecutionPayloadCapella_ReceiptsRootGIndex  = 19
+	ExecutionPayloadCapella_LogsBloomGIndex     = 20
+	ExecutionPayloadCapella_PrevRandaoGIndex    = 21
+	ExecutionPayloadCapella_BlockNumberGIndex   = 22
+	ExecutionPayloadCapella_GasLimitGIndex      = 23
+	ExecutionPayloadCapella_GasUsedGIndex       = 24
+	ExecutionPayloadCapella_TimestampGIndex     = 25
+	ExecutionPayloadCapella_ExtraDataGIndex     = 26
+	ExecutionPayloadCapella_BaseFeePerGasGIndex = 27
+	ExecutionPayloadCapella_BlockHashGIndex     = 28
+	ExecutionPayloadCapella_TransactionsGIndex  = 29
+	ExecutionPayloadCapella_WithdrawalsGIndex   = 30
+)
 
+// FromTree decodes the ExecutionPayloadCapella object from its tree
+func (e *ExecutionPayloadCapella) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 15)
+	if err != nil {
+		return err
+	}
 	// Field (0) 'ParentHash'
-	dst = append(dst, e.ParentHash[:]...)
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.ParentHash[:], buf)
+	}
 
 	// Field (1) 'FeeRecipient'
-	dst = append(dst, e.FeeRecipient[:]...)
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 20)
+		if err != nil {
+			return err
+		}
+		copy(e.FeeRecipient[:], buf)
+	}
 
 	// Field (2) 'StateRoot'
-	dst = append(dst, e.StateRoot[:]...)
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.StateRoot[:], buf)
+	}
 
 	// Field (3) 'ReceiptsRoot'
-	dst = append(dst, e.ReceiptsRoot[:]...)
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.ReceiptsRoot[:], buf)
+	}
+
+	// Field (4) 'LogsBloom'
+	{
+		buf, err := ssz.TreeBytes(nodes[4], 256)
+		if err != nil {
+			return err
+		}
+		copy(e.LogsBloom[:], buf)
+	}
+
+	// Field (5) 'PrevRandao'
+	{
+		buf, err := ssz.TreeBytes(nodes[5], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.PrevRandao[:], buf)
+	}
+
+	// Field (6) 'BlockNumber'
+	{
+		buf, err := ssz.TreeBytes(nodes[6], 8)
+		if err != nil {
+			return err
+		}
+		e.BlockNumber = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (7) 'GasLimit'
+	{
+		buf, err := ssz.TreeBytes(nodes[7], 8)
+		if err != nil {
+			return err
+		}
+		e.GasLimit = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (8) 'GasUsed'
+	{
+		buf, err := ssz.TreeBytes(nodes[8], 8)
+		if err != nil {
+			return err
+		}
+		e.GasUsed = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (9) 'Timestamp'
+	{
+		buf, err := ssz.TreeBytes(nodes[9], 8)
+		if err != nil {
+			return err
+		}
+		e.Timestamp = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (10) 'ExtraData'
+	{
+		buf, err := ssz.TreeListPacked(nodes[10], 32, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(e.ExtraData) == 0 {
+			e.ExtraData = make([]byte, 0, len(buf))
+		}
+		e.ExtraData = append(e.ExtraData, buf...)
+	}
+
+	// Field (11) 'BaseFeePerGas'
+	{
+		buf, err := ssz.TreeBytes(nodes[11], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.BaseFeePerGas[:], buf)
+	}
+
+	// Field (12) 'BlockHash'
+	{
+		buf, err := ssz.TreeBytes(nodes[12], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.BlockHash[:], buf)
+	}
+
+	// Field (13) 'Transactions'
+	{
+		elems, err := ssz.TreeList(nodes[13], 1048576)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		e.Transactions = make([][]byte, num)
+		for indx, elem := range elems {
+			buf, err := ssz.TreeListPacked(elem, 1073741824, 1)
+			if err != nil {
+				return err
+			}
+			if len(buf) > 1073741824 {
+				return ssz.ErrBytesLength
+			}
+			if cap(e.Transactions[indx]) == 0 {
+				e.Transactions[indx] = make([]byte, 0, len(buf))
+			}
+			e.Transactions[indx] = append(e.Transactions[indx], buf...)
+		}
+	}
+
+	// Field (14) 'Withdrawals'
+	{
+		elems, err := ssz.TreeList(nodes[14], 16)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		e.Withdrawals = make([]*Withdrawal, num)
+		for indx, elem := range elems {
+			if e.Withdrawals[indx] == nil {
+				e.Withdrawals[indx] = new(Withdrawal)
+			}
+			if err := e.Withdrawals[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the ExecutionPayloadHeaderCapella object
+func (e *ExecutionPayloadHeaderCapella) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ExecutionPayloadHeaderCapella object to a target array
+func (e *ExecutionPayloadHeaderCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(568)
+
+	// Field (0) 'ParentHash'
+	dst = append(dst, e.ParentHash[:]...)
+
+	// Field (1) 'FeeRecipient'
+	dst = append(dst, e.FeeRecipient[:]...)
+
+	// Field (2) 'StateRoot'
+	dst = append(dst, e.StateRoot[:]...)
+
+	// Field (3) 'ReceiptsRoot'
+	dst = append(dst, e.ReceiptsRoot[:]...)
 
 	// Field (4) 'LogsBloom'
 	dst = append(dst, e.LogsBloom[:]...)
@@ -7847,7 +11765,7 @@ func (e *ExecutionPayloadHeaderCapella) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o10 < 568 {
+	if o10 != 568 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -7961,6 +11879,199 @@ func (e *ExecutionPayloadHeaderCapella) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(e)
 }
 
+// SSZSchema returns the ssz schema of the ExecutionPayloadHeaderCapella object
+func (e *ExecutionPayloadHeaderCapella) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "ParentHash", Tag: "parent_hash", Schema: ssz.BytesSchema(32)},
+			{Name: "FeeRecipient", Tag: "fee_recipient", Schema: ssz.BytesSchema(20)},
+			{Name: "StateRoot", Tag: "state_root", Schema: ssz.BytesSchema(32)},
+			{Name: "ReceiptsRoot", Tag: "receipts_root", Schema: ssz.BytesSchema(32)},
+			{Name: "LogsBloom", Tag: "logs_bloom", Schema: ssz.BytesSchema(256)},
+			{Name: "PrevRandao", Tag: "prev_randao", Schema: ssz.BytesSchema(32)},
+			{Name: "BlockNumber", Tag: "block_number", Schema: ssz.UintSchema(8)},
+			{Name: "GasLimit", Tag: "gas_limit", Schema: ssz.UintSchema(8)},
+			{Name: "GasUsed", Tag: "gas_used", Schema: ssz.UintSchema(8)},
+			{Name: "Timestamp", Tag: "timestamp", Schema: ssz.UintSchema(8)},
+			{Name: "ExtraData", Tag: "extra_data", Schema: ssz.ByteListSchema(32)},
+			{Name: "BaseFeePerGas", Tag: "base_fee_per_gas", Schema: ssz.BytesSchema(32)},
+			{Name: "BlockHash", Tag: "block_hash", Schema: ssz.BytesSchema(32)},
+			{Name: "TransactionsRoot", Tag: "transactions_root", Schema: ssz.BytesSchema(32)},
+			{Name: "WithdrawalRoot", Tag: "withdrawals_root", Schema: ssz.BytesSchema(32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the ExecutionPayloadHeaderCapella object
+const (
+	ExecutionPayloadHeaderCapella_ParentHashGIndex       = 16
+	ExecutionPayloadHeaderCapella_FeeRecipientGIndex     = 17
+	ExecutionPayloadHeaderCapella_StateRootGIndex        = 18
+	ExecutionPayloadHeaderCapella_ReceiptsRootGIndex     = 19
+	ExecutionPayloadHeaderCapella_LogsBloomGIndex        = 20
+	ExecutionPayloadHeaderCapella_PrevRandaoGIndex       = 21
+	ExecutionPayloadHeaderCapella_BlockNumberGIndex      = 22
+	ExecutionPayloadHeaderCapella_GasLimitGIndex         = 23
+	ExecutionPayloadHeaderCapella_GasUsedGIndex          = 24
+	ExecutionPayloadHeaderCapella_TimestampGIndex        = 25
+	ExecutionPayloadHeaderCapella_ExtraDataGIndex        = 26
+	ExecutionPayloadHeaderCapella_BaseFeePerGasGIndex    = 27
+	ExecutionPayloadHeaderCapella_BlockHashGIndex        = 28
+	ExecutionPayloadHeaderCapella_TransactionsRootGIndex = 29
+	ExecutionPayloadHeaderCapella_WithdrawalRootGIndex   = 30
+)
+
+// FromTree decodes the ExecutionPayloadHeaderCapella object from its tree
+func (e *ExecutionPayloadHeaderCapella) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 15)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'ParentHash'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.ParentHash[:], buf)
+	}
+
+	// Field (1) 'FeeRecipient'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 20)
+		if err != nil {
+			return err
+		}
+		copy(e.FeeRecipient[:], buf)
+	}
+
+	// Field (2) 'StateRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.StateRoot[:], buf)
+	}
+
+	// Field (3) 'ReceiptsRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.ReceiptsRoot[:], buf)
+	}
+
+	// Field (4) 'LogsBloom'
+	{
+		buf, err := ssz.TreeBytes(nodes[4], 256)
+		if err != nil {
+			return err
+		}
+		copy(e.LogsBloom[:], buf)
+	}
+
+	// Field (5) 'PrevRandao'
+	{
+		buf, err := ssz.TreeBytes(nodes[5], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.PrevRandao[:], buf)
+	}
+
+	// Field (6) 'BlockNumber'
+	{
+		buf, err := ssz.TreeBytes(nodes[6], 8)
+		if err != nil {
+			return err
+		}
+		e.BlockNumber = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (7) 'GasLimit'
+	{
+		buf, err := ssz.TreeBytes(nodes[7], 8)
+		if err != nil {
+			return err
+		}
+		e.GasLimit = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (8) 'GasUsed'
+	{
+		buf, err := ssz.TreeBytes(nodes[8], 8)
+		if err != nil {
+			return err
+		}
+		e.GasUsed = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (9) 'Timestamp'
+	{
+		buf, err := ssz.TreeBytes(nodes[9], 8)
+		if err != nil {
+			return err
+		}
+		e.Timestamp = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (10) 'ExtraData'
+	{
+		buf, err := ssz.TreeListPacked(nodes[10], 32, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(e.ExtraData) == 0 {
+			e.ExtraData = make([]byte, 0, len(buf))
+		}
+		e.ExtraData = append(e.ExtraData, buf...)
+	}
+
+	// Field (11) 'BaseFeePerGas'
+	{
+		buf, err := ssz.TreeBytes(nodes[11], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.BaseFeePerGas[:], buf)
+	}
+
+	// Field (12) 'BlockHash'
+	{
+		buf, err := ssz.TreeBytes(nodes[12], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.BlockHash[:], buf)
+	}
+
+	// Field (13) 'TransactionsRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[13], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.TransactionsRoot[:], buf)
+	}
+
+	// Field (14) 'WithdrawalRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[14], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.WithdrawalRoot[:], buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the BLSToExecutionChange object
 func (b *BLSToExecutionChange) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
@@ -8035,20 +12146,75 @@ func (b *BLSToExecutionChange) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
-// MarshalSSZ ssz marshals the HistoricalSummary object
-func (h *HistoricalSummary) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(h)
+// SSZSchema returns the ssz schema of the BLSToExecutionChange object
+func (b *BLSToExecutionChange) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "ValidatorIndex", Tag: "validator_index", Schema: ssz.UintSchema(8)},
+			{Name: "FromBLSPubKey", Tag: "from_bls_pubkey", Schema: ssz.BytesSchema(48)},
+			{Name: "ToExecutionAddress", Tag: "to_execution_address", Schema: ssz.BytesSchema(20)},
+		},
+	}
 }
 
-// MarshalSSZTo ssz marshals the HistoricalSummary object to a target array
-func (h *HistoricalSummary) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-
-	// Field (0) 'BlockSummaryRoot'
-	dst = append(dst, h.BlockSummaryRoot[:]...)
-
-	// Field (1) 'StateSummaryRoot'
-	dst = append(dst, h.StateSummaryRoot[:]...)
+// Generalized indices of the fields of the BLSToExecutionChange object
+const (
+	BLSToExecutionChange_ValidatorIndexGIndex     = 4
+	BLSToExecutionChange_FromBLSPubKeyGIndex      = 5
+	BLSToExecutionChange_ToExecutionAddressGIndex = 6
+)
+
+// FromTree decodes the BLSToExecutionChange object from its tree
+func (b *BLSToExecutionChange) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 3)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'ValidatorIndex'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		b.ValidatorIndex = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'FromBLSPubKey'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 48)
+		if err != nil {
+			return err
+		}
+		copy(b.FromBLSPubKey[:], buf)
+	}
+
+	// Field (2) 'ToExecutionAddress'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 20)
+		if err != nil {
+			return err
+		}
+		copy(b.ToExecutionAddress[:], buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the HistoricalSummary object
+func (h *HistoricalSummary) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(h)
+}
+
+// MarshalSSZTo ssz marshals the HistoricalSummary object to a target array
+func (h *HistoricalSummary) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'BlockSummaryRoot'
+	dst = append(dst, h.BlockSummaryRoot[:]...)
+
+	// Field (1) 'StateSummaryRoot'
+	dst = append(dst, h.StateSummaryRoot[:]...)
 
 	return
 }
@@ -8100,6 +12266,50 @@ func (h *HistoricalSummary) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(h)
 }
 
+// SSZSchema returns the ssz schema of the HistoricalSummary object
+func (h *HistoricalSummary) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "BlockSummaryRoot", Tag: "block_summary_root", Schema: ssz.BytesSchema(32)},
+			{Name: "StateSummaryRoot", Tag: "state_summary_root", Schema: ssz.BytesSchema(32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the HistoricalSummary object
+const (
+	HistoricalSummary_BlockSummaryRootGIndex = 2
+	HistoricalSummary_StateSummaryRootGIndex = 3
+)
+
+// FromTree decodes the HistoricalSummary object from its tree
+func (h *HistoricalSummary) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'BlockSummaryRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+		copy(h.BlockSummaryRoot[:], buf)
+	}
+
+	// Field (1) 'StateSummaryRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 32)
+		if err != nil {
+			return err
+		}
+		copy(h.StateSummaryRoot[:], buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the SignedBLSToExecutionChange object
 func (s *SignedBLSToExecutionChange) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
@@ -8180,6 +12390,51 @@ func (s *SignedBLSToExecutionChange) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
 
+// SSZSchema returns the ssz schema of the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Message", Tag: "message", Schema: ssz.SchemaOf(new(BLSToExecutionChange))},
+			{Name: "Signature", Tag: "signature", Schema: ssz.BytesSchema(96)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the SignedBLSToExecutionChange object
+const (
+	SignedBLSToExecutionChange_MessageGIndex   = 2
+	SignedBLSToExecutionChange_SignatureGIndex = 3
+)
+
+// FromTree decodes the SignedBLSToExecutionChange object from its tree
+func (s *SignedBLSToExecutionChange) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Message'
+	{
+		if s.Message == nil {
+			s.Message = new(BLSToExecutionChange)
+		}
+		if err := s.Message.FromTree(nodes[0]); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Signature'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 96)
+		if err != nil {
+			return err
+		}
+		copy(s.Signature[:], buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Withdrawal object
 func (w *Withdrawal) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(w)
@@ -8263,6 +12518,72 @@ func (w *Withdrawal) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(w)
 }
 
+// SSZSchema returns the ssz schema of the Withdrawal object
+func (w *Withdrawal) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Index", Tag: "index", Schema: ssz.UintSchema(8)},
+			{Name: "ValidatorIndex", Tag: "validator_index", Schema: ssz.UintSchema(8)},
+			{Name: "Address", Tag: "address", Schema: ssz.BytesSchema(20)},
+			{Name: "Amount", Tag: "amount", Schema: ssz.UintSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Withdrawal object
+const (
+	Withdrawal_IndexGIndex          = 4
+	Withdrawal_ValidatorIndexGIndex = 5
+	Withdrawal_AddressGIndex        = 6
+	Withdrawal_AmountGIndex         = 7
+)
+
+// FromTree decodes the Withdrawal object from its tree
+func (w *Withdrawal) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 4)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Index'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		w.Index = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'ValidatorIndex'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 8)
+		if err != nil {
+			return err
+		}
+		w.ValidatorIndex = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (2) 'Address'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 20)
+		if err != nil {
+			return err
+		}
+		copy(w.Address[:], buf)
+	}
+
+	// Field (3) 'Amount'
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 8)
+		if err != nil {
+			return err
+		}
+		w.Amount = ssz.UnmarshallUint64(buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the BeaconStateCapella object
 func (b *BeaconStateCapella) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
@@ -8559,7 +12880,7 @@ func (b *BeaconStateCapella) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o7 < 2736653 {
+	if o7 != 2736653 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -8960,167 +13281,582 @@ func (b *BeaconStateCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.Validators {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
+		for _, elem := range b.Validators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+	}
+
+	// Field (12) 'Balances'
+	{
+		if size := len(b.Balances); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconStateCapella.Balances", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Balances {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(b.Balances))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	}
+
+	// Field (13) 'RandaoMixes'
+	{
+		subIndx := hh.Index()
+		for _, i := range b.RandaoMixes {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (14) 'Slashings'
+	{
+		if size := len(b.Slashings); size != 8192 {
+			err = ssz.ErrVectorLengthFn("BeaconStateCapella.Slashings", size, 8192)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Slashings {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.PreviousEpochParticipation))
+		if byteLen > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(b.PreviousEpochParticipation)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.CurrentEpochParticipation))
+		if byteLen > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(b.CurrentEpochParticipation)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+	}
+
+	// Field (17) 'JustificationBits'
+	hh.PutBytes(b.JustificationBits[:])
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (21) 'InactivityScores'
+	{
+		if size := len(b.InactivityScores); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconStateCapella.InactivityScores", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.InactivityScores {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(b.InactivityScores))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	}
+
+	// Field (22) 'CurrentSyncCommittee'
+	if b.CurrentSyncCommittee == nil {
+		b.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommittee)
+	}
+	if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (24) 'LatestExecutionPayloadHeader'
+	if err = b.LatestExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (25) 'NextWithdrawalIndex'
+	hh.PutUint64(b.NextWithdrawalIndex)
+
+	// Field (26) 'NextWithdrawalValidatorIndex'
+	hh.PutUint64(b.NextWithdrawalValidatorIndex)
+
+	// Field (27) 'HistoricalSummaries'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.HistoricalSummaries))
+		if num > 16777216 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.HistoricalSummaries {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16777216)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BeaconStateCapella object
+func (b *BeaconStateCapella) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the BeaconStateCapella object
+func (b *BeaconStateCapella) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "GenesisTime", Tag: "genesis_time", Schema: ssz.UintSchema(8)},
+			{Name: "GenesisValidatorsRoot", Tag: "genesis_validators_root", Schema: ssz.BytesSchema(32)},
+			{Name: "Slot", Tag: "slot", Schema: ssz.UintSchema(8)},
+			{Name: "Fork", Tag: "fork", Schema: ssz.SchemaOf(new(Fork))},
+			{Name: "LatestBlockHeader", Tag: "latest_block_header", Schema: ssz.SchemaOf(new(BeaconBlockHeader))},
+			{Name: "BlockRoots", Tag: "block_roots", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 8192)},
+			{Name: "StateRoots", Tag: "state_roots", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 8192)},
+			{Name: "HistoricalRoots", Tag: "historical_roots", Schema: ssz.ListSchema(ssz.BytesSchema(32), 16777216)},
+			{Name: "Eth1Data", Tag: "eth1_data", Schema: ssz.SchemaOf(new(Eth1Data))},
+			{Name: "Eth1DataVotes", Tag: "eth1_data_votes", Schema: ssz.ListSchema(ssz.SchemaOf(new(Eth1Data)), 2048)},
+			{Name: "Eth1DepositIndex", Tag: "eth1_deposit_index", Schema: ssz.UintSchema(8)},
+			{Name: "Validators", Tag: "validators", Schema: ssz.ListSchema(ssz.SchemaOf(new(Validator)), 1099511627776)},
+			{Name: "Balances", Tag: "balances", Schema: ssz.ListSchema(ssz.UintSchema(8), 1099511627776)},
+			{Name: "RandaoMixes", Tag: "randao_mixes", Schema: ssz.VectorSchema(ssz.BytesSchema(32), 65536)},
+			{Name: "Slashings", Tag: "slashings", Schema: ssz.VectorSchema(ssz.UintSchema(8), 8192)},
+			{Name: "PreviousEpochParticipation", Tag: "previous_epoch_participation", Schema: ssz.ByteListSchema(1099511627776)},
+			{Name: "CurrentEpochParticipation", Tag: "current_epoch_participation", Schema: ssz.ByteListSchema(1099511627776)},
+			{Name: "JustificationBits", Tag: "justification_bits", Schema: ssz.BytesSchema(1)},
+			{Name: "PreviousJustifiedCheckpoint", Tag: "previous_justified_checkpoint", Schema: ssz.SchemaOf(new(Checkpoint))},
+			{Name: "CurrentJustifiedCheckpoint", Tag: "current_justified_checkpoint", Schema: ssz.SchemaOf(new(Checkpoint))},
+			{Name: "FinalizedCheckpoint", Tag: "finalized_checkpoint", Schema: ssz.SchemaOf(new(Checkpoint))},
+			{Name: "InactivityScores", Tag: "inactivity_scores", Schema: ssz.ListSchema(ssz.UintSchema(8), 1099511627776)},
+			{Name: "CurrentSyncCommittee", Tag: "current_sync_committee", Schema: ssz.SchemaOf(new(SyncCommittee))},
+			{Name: "NextSyncCommittee", Tag: "next_sync_committee", Schema: ssz.SchemaOf(new(SyncCommittee))},
+			{Name: "LatestExecutionPayloadHeader", Tag: "latest_execution_payload_header", Schema: ssz.SchemaOf(new(ExecutionPayloadHeaderCapella))},
+			{Name: "NextWithdrawalIndex", Tag: "next_withdrawal_index", Schema: ssz.UintSchema(8)},
+			{Name: "NextWithdrawalValidatorIndex", Tag: "next_withdrawal_validator_index", Schema: ssz.UintSchema(8)},
+			{Name: "HistoricalSummaries", Tag: "historical_summaries", Schema: ssz.ListSchema(ssz.SchemaOf(new(HistoricalSummary)), 16777216)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the BeaconStateCapella object
+const (
+	BeaconStateCapella_GenesisTimeGIndex                  = 32
+	BeaconStateCapella_GenesisValidatorsRootGIndex        = 33
+	BeaconStateCapella_SlotGIndex                         = 34
+	BeaconStateCapella_ForkGIndex                         = 35
+	BeaconStateCapella_LatestBlockHeaderGIndex            = 36
+	BeaconStateCapella_BlockRootsGIndex                   = 37
+	BeaconStateCapella_StateRootsGIndex                   = 38
+	BeaconStateCapella_HistoricalRootsGIndex              = 39
+	BeaconStateCapella_Eth1DataGIndex                     = 40
+	BeaconStateCapella_Eth1DataVotesGIndex                = 41
+	BeaconStateCapella_Eth1DepositIndexGIndex             = 42
+	BeaconStateCapella_ValidatorsGIndex                   = 43
+	BeaconStateCapella_BalancesGIndex                     = 44
+	BeaconStateCapella_RandaoMixesGIndex                  = 45
+	BeaconStateCapella_SlashingsGIndex                    = 46
+	BeaconStateCapella_PreviousEpochParticipationGIndex   = 47
+	BeaconStateCapella_CurrentEpochParticipationGIndex    = 48
+	BeaconStateCapella_JustificationBitsGIndex            = 49
+	BeaconStateCapella_PreviousJustifiedCheckpointGIndex  = 50
+	BeaconStateCapella_CurrentJustifiedCheckpointGIndex   = 51
+	BeaconStateCapella_FinalizedCheckpointGIndex          = 52
+	BeaconStateCapella_InactivityScoresGIndex             = 53
+	BeaconStateCapella_CurrentSyncCommitteeGIndex         = 54
+	BeaconStateCapella_NextSyncCommitteeGIndex            = 55
+	BeaconStateCapella_LatestExecutionPayloadHeaderGIndex = 56
+	BeaconStateCapella_NextWithdrawalIndexGIndex          = 57
+	BeaconStateCapella_NextWithdrawalValidatorIndexGIndex = 58
+	BeaconStateCapella_HistoricalSummariesGIndex          = 59
+)
+
+// FromTree decodes the BeaconStateCapella object from its tree
+func (b *BeaconStateCapella) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 28)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'GenesisTime'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		b.GenesisTime = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'GenesisValidatorsRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 32)
+		if err != nil {
+			return err
+		}
+		copy(b.GenesisValidatorsRoot[:], buf)
+	}
+
+	// Field (2) 'Slot'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 8)
+		if err != nil {
+			return err
+		}
+		b.Slot = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (3) 'Fork'
+	{
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if err := b.Fork.FromTree(nodes[3]); err != nil {
+			return err
+		}
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	{
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if err := b.LatestBlockHeader.FromTree(nodes[4]); err != nil {
+			return err
+		}
+	}
+
+	// Field (5) 'BlockRoots'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[5], 8192, 32)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 8192; ii++ {
+			copy(b.BlockRoots[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+
+	// Field (6) 'StateRoots'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[6], 8192, 32)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 8192; ii++ {
+			copy(b.StateRoots[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+
+	// Field (7) 'HistoricalRoots'
+	{
+		buf, err := ssz.TreeListBytes(nodes[7], 16777216, 32)
+		if err != nil {
+			return err
+		}
+		num, err := ssz.DivideInt2(len(buf), 32, 16777216)
+		if err != nil {
+			return err
+		}
+		b.HistoricalRoots = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(b.HistoricalRoots[ii]) == 0 {
+				b.HistoricalRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+
+	// Field (8) 'Eth1Data'
+	{
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err := b.Eth1Data.FromTree(nodes[8]); err != nil {
+			return err
+		}
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	{
+		elems, err := ssz.TreeList(nodes[9], 2048)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.Eth1DataVotes = make([]*Eth1Data, num)
+		for indx, elem := range elems {
+			if b.Eth1DataVotes[indx] == nil {
+				b.Eth1DataVotes[indx] = new(Eth1Data)
+			}
+			if err := b.Eth1DataVotes[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (10) 'Eth1DepositIndex'
+	{
+		buf, err := ssz.TreeBytes(nodes[10], 8)
+		if err != nil {
+			return err
+		}
+		b.Eth1DepositIndex = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (11) 'Validators'
+	{
+		elems, err := ssz.TreeList(nodes[11], 1099511627776)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.Validators = make([]*Validator, num)
+		for indx, elem := range elems {
+			if b.Validators[indx] == nil {
+				b.Validators[indx] = new(Validator)
+			}
+			if err := b.Validators[indx].FromTree(elem); err != nil {
+				return err
 			}
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
 	}
 
 	// Field (12) 'Balances'
 	{
-		if size := len(b.Balances); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconStateCapella.Balances", size, 1099511627776)
-			return
+		buf, err := ssz.TreeListPacked(nodes[12], 1099511627776, 8)
+		if err != nil {
+			return err
 		}
-		subIndx := hh.Index()
-		for _, i := range b.Balances {
-			hh.AppendUint64(i)
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.Balances = ssz.ExtendUint64(b.Balances, num)
+		for ii := 0; ii < num; ii++ {
+			b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
 		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.Balances))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
 	}
 
 	// Field (13) 'RandaoMixes'
 	{
-		subIndx := hh.Index()
-		for _, i := range b.RandaoMixes {
-			hh.Append(i[:])
+		buf, err := ssz.TreeVectorBytes(nodes[13], 65536, 32)
+		if err != nil {
+			return err
+		}
+
+		for ii := 0; ii < 65536; ii++ {
+			copy(b.RandaoMixes[ii][:], buf[ii*32:(ii+1)*32])
 		}
-		hh.Merkleize(subIndx)
 	}
 
 	// Field (14) 'Slashings'
 	{
-		if size := len(b.Slashings); size != 8192 {
-			err = ssz.ErrVectorLengthFn("BeaconStateCapella.Slashings", size, 8192)
-			return
+		buf, err := ssz.TreePacked(nodes[14], 65536)
+		if err != nil {
+			return err
 		}
-		subIndx := hh.Index()
-		for _, i := range b.Slashings {
-			hh.AppendUint64(i)
+		b.Slashings = ssz.ExtendUint64(b.Slashings, 8192)
+		for ii := 0; ii < 8192; ii++ {
+			b.Slashings[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
 		}
-		hh.Merkleize(subIndx)
 	}
 
 	// Field (15) 'PreviousEpochParticipation'
 	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(b.PreviousEpochParticipation))
-		if byteLen > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
+		buf, err := ssz.TreeListPacked(nodes[15], 1099511627776, 1)
+		if err != nil {
+			return err
 		}
-		hh.Append(b.PreviousEpochParticipation)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+		if len(buf) > 1099511627776 {
+			return ssz.ErrBytesLength
+		}
+		if cap(b.PreviousEpochParticipation) == 0 {
+			b.PreviousEpochParticipation = make([]byte, 0, len(buf))
+		}
+		b.PreviousEpochParticipation = append(b.PreviousEpochParticipation, buf...)
 	}
 
 	// Field (16) 'CurrentEpochParticipation'
 	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(b.CurrentEpochParticipation))
-		if byteLen > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
+		buf, err := ssz.TreeListPacked(nodes[16], 1099511627776, 1)
+		if err != nil {
+			return err
 		}
-		hh.Append(b.CurrentEpochParticipation)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+		if len(buf) > 1099511627776 {
+			return ssz.ErrBytesLength
+		}
+		if cap(b.CurrentEpochParticipation) == 0 {
+			b.CurrentEpochParticipation = make([]byte, 0, len(buf))
+		}
+		b.CurrentEpochParticipation = append(b.CurrentEpochParticipation, buf...)
 	}
 
 	// Field (17) 'JustificationBits'
-	hh.PutBytes(b.JustificationBits[:])
+	{
+		buf, err := ssz.TreeBytes(nodes[17], 1)
+		if err != nil {
+			return err
+		}
+		copy(b.JustificationBits[:], buf)
+	}
 
 	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
+	{
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err := b.PreviousJustifiedCheckpoint.FromTree(nodes[18]); err != nil {
+			return err
+		}
 	}
 
 	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
+	{
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err := b.CurrentJustifiedCheckpoint.FromTree(nodes[19]); err != nil {
+			return err
+		}
 	}
 
 	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
+	{
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if err := b.FinalizedCheckpoint.FromTree(nodes[20]); err != nil {
+			return err
+		}
 	}
 
 	// Field (21) 'InactivityScores'
 	{
-		if size := len(b.InactivityScores); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconStateCapella.InactivityScores", size, 1099511627776)
-			return
+		buf, err := ssz.TreeListPacked(nodes[21], 1099511627776, 8)
+		if err != nil {
+			return err
 		}
-		subIndx := hh.Index()
-		for _, i := range b.InactivityScores {
-			hh.AppendUint64(i)
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.InactivityScores = ssz.ExtendUint64(b.InactivityScores, num)
+		for ii := 0; ii < num; ii++ {
+			b.InactivityScores[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
 		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.InactivityScores))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
 	}
 
 	// Field (22) 'CurrentSyncCommittee'
-	if b.CurrentSyncCommittee == nil {
-		b.CurrentSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
-		return
+	{
+		if b.CurrentSyncCommittee == nil {
+			b.CurrentSyncCommittee = new(SyncCommittee)
+		}
+		if err := b.CurrentSyncCommittee.FromTree(nodes[22]); err != nil {
+			return err
+		}
 	}
 
 	// Field (23) 'NextSyncCommittee'
-	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
-		return
+	{
+		if b.NextSyncCommittee == nil {
+			b.NextSyncCommittee = new(SyncCommittee)
+		}
+		if err := b.NextSyncCommittee.FromTree(nodes[23]); err != nil {
+			return err
+		}
 	}
 
 	// Field (24) 'LatestExecutionPayloadHeader'
-	if err = b.LatestExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
-		return
+	{
+		if b.LatestExecutionPayloadHeader == nil {
+			b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeaderCapella)
+		}
+		if err := b.LatestExecutionPayloadHeader.FromTree(nodes[24]); err != nil {
+			return err
+		}
 	}
 
 	// Field (25) 'NextWithdrawalIndex'
-	hh.PutUint64(b.NextWithdrawalIndex)
+	{
+		buf, err := ssz.TreeBytes(nodes[25], 8)
+		if err != nil {
+			return err
+		}
+		b.NextWithdrawalIndex = ssz.UnmarshallUint64(buf)
+	}
 
 	// Field (26) 'NextWithdrawalValidatorIndex'
-	hh.PutUint64(b.NextWithdrawalValidatorIndex)
+	{
+		buf, err := ssz.TreeBytes(nodes[26], 8)
+		if err != nil {
+			return err
+		}
+		b.NextWithdrawalValidatorIndex = ssz.UnmarshallUint64(buf)
+	}
 
 	// Field (27) 'HistoricalSummaries'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.HistoricalSummaries))
-		if num > 16777216 {
-			err = ssz.ErrIncorrectListSize
-			return
+		elems, err := ssz.TreeList(nodes[27], 16777216)
+		if err != nil {
+			return err
 		}
-		for _, elem := range b.HistoricalSummaries {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
+		num := len(elems)
+		b.HistoricalSummaries = make([]*HistoricalSummary, num)
+		for indx, elem := range elems {
+			if b.HistoricalSummaries[indx] == nil {
+				b.HistoricalSummaries[indx] = new(HistoricalSummary)
+			}
+			if err := b.HistoricalSummaries[indx].FromTree(elem); err != nil {
+				return err
 			}
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16777216)
 	}
 
-	hh.Merkleize(indx)
-	return
-}
-
-// GetTree ssz hashes the BeaconStateCapella object
-func (b *BeaconStateCapella) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
+	return nil
 }
 
 // MarshalSSZ ssz marshals the SignedBeaconBlockCapella object
@@ -9167,7 +13903,7 @@ func (s *SignedBeaconBlockCapella) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 100 {
+	if o0 != 100 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -9235,6 +13971,54 @@ func (s *SignedBeaconBlockCapella) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
 
+// SSZSchema returns the ssz schema of the SignedBeaconBlockCapella object
+func (s *SignedBeaconBlockCapella) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Block", Tag: "message", Schema: ssz.SchemaOf(new(BeaconBlockCapella))},
+			{Name: "Signature", Tag: "signature", Schema: ssz.BytesSchema(96)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the SignedBeaconBlockCapella object
+const (
+	SignedBeaconBlockCapella_BlockGIndex     = 2
+	SignedBeaconBlockCapella_SignatureGIndex = 3
+)
+
+// FromTree decodes the SignedBeaconBlockCapella object from its tree
+func (s *SignedBeaconBlockCapella) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Block'
+	{
+		if s.Block == nil {
+			s.Block = new(BeaconBlockCapella)
+		}
+		if err := s.Block.FromTree(nodes[0]); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Signature'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 96)
+		if err != nil {
+			return err
+		}
+		if cap(s.Signature) == 0 {
+			s.Signature = make([]byte, 0, len(buf))
+		}
+		s.Signature = append(s.Signature, buf...)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the BeaconBlockCapella object
 func (b *BeaconBlockCapella) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
@@ -9296,7 +14080,7 @@ func (b *BeaconBlockCapella) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o4 < 84 {
+	if o4 != 84 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -9354,13 +14138,91 @@ func (b *BeaconBlockCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		return
 	}
 
-	hh.Merkleize(indx)
-	return
-}
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BeaconBlockCapella object
+func (b *BeaconBlockCapella) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// SSZSchema returns the ssz schema of the BeaconBlockCapella object
+func (b *BeaconBlockCapella) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Slot", Tag: "slot", Schema: ssz.UintSchema(8)},
+			{Name: "ProposerIndex", Tag: "proposer_index", Schema: ssz.UintSchema(8)},
+			{Name: "ParentRoot", Tag: "parent_root", Schema: ssz.BytesSchema(32)},
+			{Name: "StateRoot", Tag: "state_root", Schema: ssz.BytesSchema(32)},
+			{Name: "Body", Tag: "body", Schema: ssz.SchemaOf(new(BeaconBlockBodyCapella))},
+		},
+	}
+}
+
+// Generalized indices of the fields of the BeaconBlockCapella object
+const (
+	BeaconBlockCapella_SlotGIndex          = 8
+	BeaconBlockCapella_ProposerIndexGIndex = 9
+	BeaconBlockCapella_ParentRootGIndex    = 10
+	BeaconBlockCapella_StateRootGIndex     = 11
+	BeaconBlockCapella_BodyGIndex          = 12
+)
+
+// FromTree decodes the BeaconBlockCapella object from its tree
+func (b *BeaconBlockCapella) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 5)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Slot'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		b.Slot = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'ProposerIndex'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 8)
+		if err != nil {
+			return err
+		}
+		b.ProposerIndex = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (2) 'ParentRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 32)
+		if err != nil {
+			return err
+		}
+		copy(b.ParentRoot[:], buf)
+	}
+
+	// Field (3) 'StateRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 32)
+		if err != nil {
+			return err
+		}
+		copy(b.StateRoot[:], buf)
+	}
+
+	// Field (4) 'Body'
+	{
+		if b.Body == nil {
+			b.Body = new(BeaconBlockBodyCapella)
+		}
+		if err := b.Body.FromTree(nodes[4]); err != nil {
+			return err
+		}
+	}
 
-// GetTree ssz hashes the BeaconBlockCapella object
-func (b *BeaconBlockCapella) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
+	return nil
 }
 
 // MarshalSSZ ssz marshals the BeaconBlockBodyCapella object
@@ -9556,7 +14418,7 @@ func (b *BeaconBlockBodyCapella) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 388 {
+	if o3 != 388 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -9917,6 +14779,209 @@ func (b *BeaconBlockBodyCapella) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
+// SSZSchema returns the ssz schema of the BeaconBlockBodyCapella object
+func (b *BeaconBlockBodyCapella) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "RandaoReveal", Tag: "randao_reveal", Schema: ssz.BytesSchema(96)},
+			{Name: "Eth1Data", Tag: "eth1_data", Schema: ssz.SchemaOf(new(Eth1Data))},
+			{Name: "Graffiti", Tag: "graffiti", Schema: ssz.BytesSchema(32)},
+			{Name: "ProposerSlashings", Tag: "proposer_slashings", Schema: ssz.ListSchema(ssz.SchemaOf(new(ProposerSlashing)), 16)},
+			{Name: "AttesterSlashings", Tag: "attester_slashings", Schema: ssz.ListSchema(ssz.SchemaOf(new(AttesterSlashing)), 2)},
+			{Name: "Attestations", Tag: "attestations", Schema: ssz.ListSchema(ssz.SchemaOf(new(Attestation)), 128)},
+			{Name: "Deposits", Tag: "deposits", Schema: ssz.ListSchema(ssz.SchemaOf(new(Deposit)), 16)},
+			{Name: "VoluntaryExits", Tag: "voluntary_exits", Schema: ssz.ListSchema(ssz.SchemaOf(new(SignedVoluntaryExit)), 16)},
+			{Name: "SyncAggregate", Tag: "sync_aggregate", Schema: ssz.SchemaOf(new(SyncAggregate))},
+			{Name: "ExecutionPayload", Tag: "execution_payload", Schema: ssz.SchemaOf(new(ExecutionPayloadCapella))},
+			{Name: "BlsToExecutionChanges", Tag: "bls_to_execution_changes", Schema: ssz.ListSchema(ssz.SchemaOf(new(SignedBLSToExecutionChange)), 16)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the BeaconBlockBodyCapella object
+const (
+	BeaconBlockBodyCapella_RandaoRevealGIndex          = 16
+	BeaconBlockBodyCapella_Eth1DataGIndex              = 17
+	BeaconBlockBodyCapella_GraffitiGIndex              = 18
+	BeaconBlockBodyCapella_ProposerSlashingsGIndex     = 19
+	BeaconBlockBodyCapella_AttesterSlashingsGIndex     = 20
+	BeaconBlockBodyCapella_AttestationsGIndex          = 21
+	BeaconBlockBodyCapella_DepositsGIndex              = 22
+	BeaconBlockBodyCapella_VoluntaryExitsGIndex        = 23
+	BeaconBlockBodyCapella_SyncAggregateGIndex         = 24
+	BeaconBlockBodyCapella_ExecutionPayloadGIndex      = 25
+	BeaconBlockBodyCapella_BlsToExecutionChangesGIndex = 26
+)
+
+// FromTree decodes the BeaconBlockBodyCapella object from its tree
+func (b *BeaconBlockBodyCapella) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 11)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'RandaoReveal'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 96)
+		if err != nil {
+			return err
+		}
+		if cap(b.RandaoReveal) == 0 {
+			b.RandaoReveal = make([]byte, 0, len(buf))
+		}
+		b.RandaoReveal = append(b.RandaoReveal, buf...)
+	}
+
+	// Field (1) 'Eth1Data'
+	{
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err := b.Eth1Data.FromTree(nodes[1]); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'Graffiti'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 32)
+		if err != nil {
+			return err
+		}
+		copy(b.Graffiti[:], buf)
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		elems, err := ssz.TreeList(nodes[3], 16)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.ProposerSlashings = make([]*ProposerSlashing, num)
+		for indx, elem := range elems {
+			if b.ProposerSlashings[indx] == nil {
+				b.ProposerSlashings[indx] = new(ProposerSlashing)
+			}
+			if err := b.ProposerSlashings[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		elems, err := ssz.TreeList(nodes[4], 2)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.AttesterSlashings = make([]*AttesterSlashing, num)
+		for indx, elem := range elems {
+			if b.AttesterSlashings[indx] == nil {
+				b.AttesterSlashings[indx] = new(AttesterSlashing)
+			}
+			if err := b.AttesterSlashings[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		elems, err := ssz.TreeList(nodes[5], 128)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.Attestations = make([]*Attestation, num)
+		for indx, elem := range elems {
+			if b.Attestations[indx] == nil {
+				b.Attestations[indx] = new(Attestation)
+			}
+			if err := b.Attestations[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		elems, err := ssz.TreeList(nodes[6], 16)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.Deposits = make([]*Deposit, num)
+		for indx, elem := range elems {
+			if b.Deposits[indx] == nil {
+				b.Deposits[indx] = new(Deposit)
+			}
+			if err := b.Deposits[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		elems, err := ssz.TreeList(nodes[7], 16)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+		for indx, elem := range elems {
+			if b.VoluntaryExits[indx] == nil {
+				b.VoluntaryExits[indx] = new(SignedVoluntaryExit)
+			}
+			if err := b.VoluntaryExits[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (8) 'SyncAggregate'
+	{
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if err := b.SyncAggregate.FromTree(nodes[8]); err != nil {
+			return err
+		}
+	}
+
+	// Field (9) 'ExecutionPayload'
+	{
+		if b.ExecutionPayload == nil {
+			b.ExecutionPayload = new(ExecutionPayloadCapella)
+		}
+		if err := b.ExecutionPayload.FromTree(nodes[9]); err != nil {
+			return err
+		}
+	}
+
+	// Field (10) 'BlsToExecutionChanges'
+	{
+		elems, err := ssz.TreeList(nodes[10], 16)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		b.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, num)
+		for indx, elem := range elems {
+			if b.BlsToExecutionChanges[indx] == nil {
+				b.BlsToExecutionChanges[indx] = new(SignedBLSToExecutionChange)
+			}
+			if err := b.BlsToExecutionChanges[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the ExecutionPayloadDeneb object
 func (e *ExecutionPayloadDeneb) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(e)
@@ -10070,7 +15135,7 @@ func (e *ExecutionPayloadDeneb) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o10 < 528 {
+	if o10 != 528 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -10196,93 +15261,331 @@ func (e *ExecutionPayloadDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error)
 	hh.PutBytes(e.ReceiptsRoot[:])
 
 	// Field (4) 'LogsBloom'
-	hh.PutBytes(e.LogsBloom[:])
+	hh.PutBytes(e.LogsBloom[:])
+
+	// Field (5) 'PrevRandao'
+	hh.PutBytes(e.PrevRandao[:])
+
+	// Field (6) 'BlockNumber'
+	hh.PutUint64(e.BlockNumber)
+
+	// Field (7) 'GasLimit'
+	hh.PutUint64(e.GasLimit)
+
+	// Field (8) 'GasUsed'
+	hh.PutUint64(e.GasUsed)
+
+	// Field (9) 'Timestamp'
+	hh.PutUint64(e.Timestamp)
+
+	// Field (10) 'ExtraData'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(e.ExtraData))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(e.ExtraData)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (11) 'BaseFeePerGas'
+	hh.PutBytes(e.BaseFeePerGas[:])
+
+	// Field (12) 'BlockHash'
+	hh.PutBytes(e.BlockHash[:])
+
+	// Field (13) 'Transactions'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Transactions))
+		if num > 1048576 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range e.Transactions {
+			{
+				elemIndx := hh.Index()
+				byteLen := uint64(len(elem))
+				if byteLen > 1073741824 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				hh.AppendBytes32(elem)
+				hh.MerkleizeWithMixin(elemIndx, byteLen, (1073741824+31)/32)
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1048576)
+	}
+
+	// Field (14) 'Withdrawals'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Withdrawals))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range e.Withdrawals {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (15) 'BlobGasUsed'
+	hh.PutUint64(e.BlobGasUsed)
+
+	// Field (16) 'ExcessBlobGas'
+	hh.PutUint64(e.ExcessBlobGas)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ExecutionPayloadDeneb object
+func (e *ExecutionPayloadDeneb) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
+}
+
+// SSZSchema returns the ssz schema of the ExecutionPayloadDeneb object
+func (e *ExecutionPayloadDeneb) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "ParentHash", Tag: "parent_hash", Schema: ssz.BytesSchema(32)},
+			{Name: "FeeRecipient", Tag: "fee_recipient", Schema: ssz.BytesSchema(20)},
+			{Name: "StateRoot", Tag: "state_root", Schema: ssz.BytesSchema(32)},
+			{Name: "ReceiptsRoot", Tag: "receipts_root", Schema: ssz.BytesSchema(32)},
+			{Name: "LogsBloom", Tag: "logs_bloom", Schema: ssz.BytesSchema(256)},
+			{Name: "PrevRandao", Tag: "prev_randao", Schema: ssz.BytesSchema(32)},
+			{Name: "BlockNumber", Tag: "block_number", Schema: ssz.UintSchema(8)},
+			{Name: "GasLimit", Tag: "gas_limit", Schema: ssz.UintSchema(8)},
+			{Name: "GasUsed", Tag: "gas_used", Schema: ssz.UintSchema(8)},
+			{Name: "Timestamp", Tag: "timestamp", Schema: ssz.UintSchema(8)},
+			{Name: "ExtraData", Tag: "extra_data", Schema: ssz.ByteListSchema(32)},
+			{Name: "BaseFeePerGas", Tag: "base_fee_per_gas", Schema: ssz.BytesSchema(32)},
+			{Name: "BlockHash", Tag: "block_hash", Schema: ssz.BytesSchema(32)},
+			{Name: "Transactions", Tag: "transactions", Schema: ssz.ListSchema(ssz.ByteListSchema(1073741824), 1048576)},
+			{Name: "Withdrawals", Tag: "withdrawals", Schema: ssz.ListSchema(ssz.SchemaOf(new(Withdrawal)), 16)},
+			{Name: "BlobGasUsed", Tag: "blob_gas_used", Schema: ssz.UintSchema(8)},
+			{Name: "ExcessBlobGas", Tag: "excess_blob_gas", Schema: ssz.UintSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the ExecutionPayloadDeneb object
+const (
+	ExecutionPayloadDeneb_ParentHashGIndex    = 32
+	ExecutionPayloadDeneb_FeeRecipientGIndex  = 33
+	ExecutionPayloadDeneb_StateRootGIndex     = 34
+	ExecutionPayloadDeneb_ReceiptsRootGIndex  = 35
+	ExecutionPayloadDeneb_LogsBloomGIndex     = 36
+	ExecutionPayloadDeneb_PrevRandaoGIndex    = 37
+	ExecutionPayloadDeneb_BlockNumberGIndex   = 38
+	ExecutionPayloadDeneb_GasLimitGIndex      = 39
+	ExecutionPayloadDeneb_GasUsedGIndex       = 40
+	ExecutionPayloadDeneb_TimestampGIndex     = 41
+	ExecutionPayloadDeneb_ExtraDataGIndex     = 42
+	ExecutionPayloadDeneb_BaseFeePerGasGIndex = 43
+	ExecutionPayloadDeneb_BlockHashGIndex     = 44
+	ExecutionPayloadDeneb_TransactionsGIndex  = 45
+	ExecutionPayloadDeneb_WithdrawalsGIndex   = 46
+	ExecutionPayloadDeneb_BlobGasUsedGIndex   = 47
+	ExecutionPayloadDeneb_ExcessBlobGasGIndex = 48
+)
+
+// FromTree decodes the ExecutionPayloadDeneb object from its tree
+func (e *ExecutionPayloadDeneb) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 17)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'ParentHash'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.ParentHash[:], buf)
+	}
+
+	// Field (1) 'FeeRecipient'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 20)
+		if err != nil {
+			return err
+		}
+		copy(e.FeeRecipient[:], buf)
+	}
+
+	// Field (2) 'StateRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.StateRoot[:], buf)
+	}
+
+	// Field (3) 'ReceiptsRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.ReceiptsRoot[:], buf)
+	}
+
+	// Field (4) 'LogsBloom'
+	{
+		buf, err := ssz.TreeBytes(nodes[4], 256)
+		if err != nil {
+			return err
+		}
+		copy(e.LogsBloom[:], buf)
+	}
 
 	// Field (5) 'PrevRandao'
-	hh.PutBytes(e.PrevRandao[:])
+	{
+		buf, err := ssz.TreeBytes(nodes[5], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.PrevRandao[:], buf)
+	}
 
 	// Field (6) 'BlockNumber'
-	hh.PutUint64(e.BlockNumber)
+	{
+		buf, err := ssz.TreeBytes(nodes[6], 8)
+		if err != nil {
+			return err
+		}
+		e.BlockNumber = ssz.UnmarshallUint64(buf)
+	}
 
 	// Field (7) 'GasLimit'
-	hh.PutUint64(e.GasLimit)
+	{
+		buf, err := ssz.TreeBytes(nodes[7], 8)
+		if err != nil {
+			return err
+		}
+		e.GasLimit = ssz.UnmarshallUint64(buf)
+	}
 
 	// Field (8) 'GasUsed'
-	hh.PutUint64(e.GasUsed)
+	{
+		buf, err := ssz.TreeBytes(nodes[8], 8)
+		if err != nil {
+			return err
+		}
+		e.GasUsed = ssz.UnmarshallUint64(buf)
+	}
 
 	// Field (9) 'Timestamp'
-	hh.PutUint64(e.Timestamp)
+	{
+		buf, err := ssz.TreeBytes(nodes[9], 8)
+		if err != nil {
+			return err
+		}
+		e.Timestamp = ssz.UnmarshallUint64(buf)
+	}
 
 	// Field (10) 'ExtraData'
 	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(e.ExtraData))
-		if byteLen > 32 {
-			err = ssz.ErrIncorrectListSize
-			return
+		buf, err := ssz.TreeListPacked(nodes[10], 32, 1)
+		if err != nil {
+			return err
 		}
-		hh.Append(e.ExtraData)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(e.ExtraData) == 0 {
+			e.ExtraData = make([]byte, 0, len(buf))
+		}
+		e.ExtraData = append(e.ExtraData, buf...)
 	}
 
 	// Field (11) 'BaseFeePerGas'
-	hh.PutBytes(e.BaseFeePerGas[:])
+	{
+		buf, err := ssz.TreeBytes(nodes[11], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.BaseFeePerGas[:], buf)
+	}
 
 	// Field (12) 'BlockHash'
-	hh.PutBytes(e.BlockHash[:])
+	{
+		buf, err := ssz.TreeBytes(nodes[12], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.BlockHash[:], buf)
+	}
 
 	// Field (13) 'Transactions'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(e.Transactions))
-		if num > 1048576 {
-			err = ssz.ErrIncorrectListSize
-			return
+		elems, err := ssz.TreeList(nodes[13], 1048576)
+		if err != nil {
+			return err
 		}
-		for _, elem := range e.Transactions {
-			{
-				elemIndx := hh.Index()
-				byteLen := uint64(len(elem))
-				if byteLen > 1073741824 {
-					err = ssz.ErrIncorrectListSize
-					return
-				}
-				hh.AppendBytes32(elem)
-				hh.MerkleizeWithMixin(elemIndx, byteLen, (1073741824+31)/32)
+		num := len(elems)
+		e.Transactions = make([][]byte, num)
+		for indx, elem := range elems {
+			buf, err := ssz.TreeListPacked(elem, 1073741824, 1)
+			if err != nil {
+				return err
+			}
+			if len(buf) > 1073741824 {
+				return ssz.ErrBytesLength
+			}
+			if cap(e.Transactions[indx]) == 0 {
+				e.Transactions[indx] = make([]byte, 0, len(buf))
 			}
+			e.Transactions[indx] = append(e.Transactions[indx], buf...)
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 1048576)
 	}
 
 	// Field (14) 'Withdrawals'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(e.Withdrawals))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
+		elems, err := ssz.TreeList(nodes[14], 16)
+		if err != nil {
+			return err
 		}
-		for _, elem := range e.Withdrawals {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
+		num := len(elems)
+		e.Withdrawals = make([]*Withdrawal, num)
+		for indx, elem := range elems {
+			if e.Withdrawals[indx] == nil {
+				e.Withdrawals[indx] = new(Withdrawal)
+			}
+			if err := e.Withdrawals[indx].FromTree(elem); err != nil {
+				return err
 			}
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
 
 	// Field (15) 'BlobGasUsed'
-	hh.PutUint64(e.BlobGasUsed)
+	{
+		buf, err := ssz.TreeBytes(nodes[15], 8)
+		if err != nil {
+			return err
+		}
+		e.BlobGasUsed = ssz.UnmarshallUint64(buf)
+	}
 
 	// Field (16) 'ExcessBlobGas'
-	hh.PutUint64(e.ExcessBlobGas)
-
-	hh.Merkleize(indx)
-	return
-}
+	{
+		buf, err := ssz.TreeBytes(nodes[16], 8)
+		if err != nil {
+			return err
+		}
+		e.ExcessBlobGas = ssz.UnmarshallUint64(buf)
+	}
 
-// GetTree ssz hashes the ExecutionPayloadDeneb object
-func (e *ExecutionPayloadDeneb) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(e)
+	return nil
 }
 
 // MarshalSSZ ssz marshals the ExecutionPayloadHeaderDeneb object
@@ -10402,7 +15705,7 @@ func (e *ExecutionPayloadHeaderDeneb) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o10 < 584 {
+	if o10 != 584 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -10527,3 +15830,218 @@ func (e *ExecutionPayloadHeaderDeneb) HashTreeRootWith(hh ssz.HashWalker) (err e
 func (e *ExecutionPayloadHeaderDeneb) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(e)
 }
+
+// SSZSchema returns the ssz schema of the ExecutionPayloadHeaderDeneb object
+func (e *ExecutionPayloadHeaderDeneb) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "ParentHash", Tag: "parent_hash", Schema: ssz.BytesSchema(32)},
+			{Name: "FeeRecipient", Tag: "fee_recipient", Schema: ssz.BytesSchema(20)},
+			{Name: "StateRoot", Tag: "state_root", Schema: ssz.BytesSchema(32)},
+			{Name: "ReceiptsRoot", Tag: "receipts_root", Schema: ssz.BytesSchema(32)},
+			{Name: "LogsBloom", Tag: "logs_bloom", Schema: ssz.BytesSchema(256)},
+			{Name: "PrevRandao", Tag: "prev_randao", Schema: ssz.BytesSchema(32)},
+			{Name: "BlockNumber", Tag: "block_number", Schema: ssz.UintSchema(8)},
+			{Name: "GasLimit", Tag: "gas_limit", Schema: ssz.UintSchema(8)},
+			{Name: "GasUsed", Tag: "gas_used", Schema: ssz.UintSchema(8)},
+			{Name: "Timestamp", Tag: "timestamp", Schema: ssz.UintSchema(8)},
+			{Name: "ExtraData", Tag: "extra_data", Schema: ssz.ByteListSchema(32)},
+			{Name: "BaseFeePerGas", Tag: "base_fee_per_gas", Schema: ssz.BytesSchema(32)},
+			{Name: "BlockHash", Tag: "block_hash", Schema: ssz.BytesSchema(32)},
+			{Name: "TransactionsRoot", Tag: "transactions_root", Schema: ssz.BytesSchema(32)},
+			{Name: "WithdrawalRoot", Tag: "withdrawals_root", Schema: ssz.BytesSchema(32)},
+			{Name: "BlobGasUsed", Tag: "blob_gas_used", Schema: ssz.UintSchema(8)},
+			{Name: "ExcessBlobGas", Tag: "excess_blob_gas", Schema: ssz.UintSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the ExecutionPayloadHeaderDeneb object
+const (
+	ExecutionPayloadHeaderDeneb_ParentHashGIndex       = 32
+	ExecutionPayloadHeaderDeneb_FeeRecipientGIndex     = 33
+	ExecutionPayloadHeaderDeneb_StateRootGIndex        = 34
+	ExecutionPayloadHeaderDeneb_ReceiptsRootGIndex     = 35
+	ExecutionPayloadHeaderDeneb_LogsBloomGIndex        = 36
+	ExecutionPayloadHeaderDeneb_PrevRandaoGIndex       = 37
+	ExecutionPayloadHeaderDeneb_BlockNumberGIndex      = 38
+	ExecutionPayloadHeaderDeneb_GasLimitGIndex         = 39
+	ExecutionPayloadHeaderDeneb_GasUsedGIndex          = 40
+	ExecutionPayloadHeaderDeneb_TimestampGIndex        = 41
+	ExecutionPayloadHeaderDeneb_ExtraDataGIndex        = 42
+	ExecutionPayloadHeaderDeneb_BaseFeePerGasGIndex    = 43
+	ExecutionPayloadHeaderDeneb_BlockHashGIndex        = 44
+	ExecutionPayloadHeaderDeneb_TransactionsRootGIndex = 45
+	ExecutionPayloadHeaderDeneb_WithdrawalRootGIndex   = 46
+	ExecutionPayloadHeaderDeneb_BlobGasUsedGIndex      = 47
+	ExecutionPayloadHeaderDeneb_ExcessBlobGasGIndex    = 48
+)
+
+// FromTree decodes the ExecutionPayloadHeaderDeneb object from its tree
+func (e *ExecutionPayloadHeaderDeneb) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 17)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'ParentHash'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.ParentHash[:], buf)
+	}
+
+	// Field (1) 'FeeRecipient'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 20)
+		if err != nil {
+			return err
+		}
+		copy(e.FeeRecipient[:], buf)
+	}
+
+	// Field (2) 'StateRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.StateRoot[:], buf)
+	}
+
+	// Field (3) 'ReceiptsRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.ReceiptsRoot[:], buf)
+	}
+
+	// Field (4) 'LogsBloom'
+	{
+		buf, err := ssz.TreeBytes(nodes[4], 256)
+		if err != nil {
+			return err
+		}
+		copy(e.LogsBloom[:], buf)
+	}
+
+	// Field (5) 'PrevRandao'
+	{
+		buf, err := ssz.TreeBytes(nodes[5], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.PrevRandao[:], buf)
+	}
+
+	// Field (6) 'BlockNumber'
+	{
+		buf, err := ssz.TreeBytes(nodes[6], 8)
+		if err != nil {
+			return err
+		}
+		e.BlockNumber = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (7) 'GasLimit'
+	{
+		buf, err := ssz.TreeBytes(nodes[7], 8)
+		if err != nil {
+			return err
+		}
+		e.GasLimit = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (8) 'GasUsed'
+	{
+		buf, err := ssz.TreeBytes(nodes[8], 8)
+		if err != nil {
+			return err
+		}
+		e.GasUsed = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (9) 'Timestamp'
+	{
+		buf, err := ssz.TreeBytes(nodes[9], 8)
+		if err != nil {
+			return err
+		}
+		e.Timestamp = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (10) 'ExtraData'
+	{
+		buf, err := ssz.TreeListPacked(nodes[10], 32, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(e.ExtraData) == 0 {
+			e.ExtraData = make([]byte, 0, len(buf))
+		}
+		e.ExtraData = append(e.ExtraData, buf...)
+	}
+
+	// Field (11) 'BaseFeePerGas'
+	{
+		buf, err := ssz.TreeBytes(nodes[11], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.BaseFeePerGas[:], buf)
+	}
+
+	// Field (12) 'BlockHash'
+	{
+		buf, err := ssz.TreeBytes(nodes[12], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.BlockHash[:], buf)
+	}
+
+	// Field (13) 'TransactionsRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[13], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.TransactionsRoot[:], buf)
+	}
+
+	// Field (14) 'WithdrawalRoot'
+	{
+		buf, err := ssz.TreeBytes(nodes[14], 32)
+		if err != nil {
+			return err
+		}
+		copy(e.WithdrawalRoot[:], buf)
+	}
+
+	// Field (15) 'BlobGasUsed'
+	{
+		buf, err := ssz.TreeBytes(nodes[15], 8)
+		if err != nil {
+			return err
+		}
+		e.BlobGasUsed = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (16) 'ExcessBlobGas'
+	{
+		buf, err := ssz.TreeBytes(nodes[16], 8)
+		if err != nil {
+			return err
+		}
+		e.ExcessBlobGas = ssz.UnmarshallUint64(buf)
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/NilFoundation/fastssz/fuzz"
+	"github.com/NilFoundation/fastssz/spectest"
 )
 
 func randomInt(min, max int) int {
@@ -88,7 +89,7 @@ func TestErrorResponse(t *testing.T) {
 		if err := obj2.UnmarshalSSZ(dst); err != nil {
 			t.Fatal(err)
 		}
-		if !deepEqual(obj, obj2) {
+		if !spectest.Equal(obj, obj2) {
 			t.Fatal("bad")
 		}
 	}
@@ -113,7 +114,7 @@ func TestFuzzEncoding(t *testing.T) {
 			if err := obj2.UnmarshalSSZ(dst); err != nil {
 				t.Fatal(err)
 			}
-			if !deepEqual(obj, obj2) {
+			if !spectest.Equal(obj, obj2) {
 				t.Fatal("bad")
 			}
 		}
@@ -152,7 +153,7 @@ func TestFuzzUnmarshalAppend(t *testing.T) {
 
 				obj2 := codec("")
 				if err := obj2.UnmarshalSSZ(buf); err == nil {
-					if deepEqual(obj, obj2) {
+					if spectest.Equal(obj, obj2) {
 						t.Fatal("bad")
 					}
 				}
@@ -188,7 +189,7 @@ func TestFuzzUnmarshalShuffle(t *testing.T) {
 			}
 			obj2 := codec("")
 			if err := obj2.UnmarshalSSZ(buf); err == nil {
-				if deepEqual(obj, obj2) {
+				if spectest.Equal(obj, obj2) {
 					t.Fatal("bad")
 				}
 			}
@@ -0,0 +1,587 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 94669d09774072812f06df44119d4739b8efb93b53f59c9c690a6e66ec4c7156
+// Version: 0.1.3
+package spectests
+
+import (
+	"testing"
+
+	"github.com/NilFoundation/fastssz/fuzz"
+	"github.com/NilFoundation/fastssz/ssztest"
+)
+
+func TestAggregateAndProofRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(AggregateAndProof) })
+}
+
+func TestAggregateAndProofDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(AggregateAndProof) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzAggregateAndProof(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(AggregateAndProof) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestCheckpointRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(Checkpoint) })
+}
+
+func TestCheckpointDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(Checkpoint) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzCheckpoint(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(Checkpoint) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestAttestationDataRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(AttestationData) })
+}
+
+func TestAttestationDataDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(AttestationData) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzAttestationData(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(AttestationData) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestAttestationRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(Attestation) })
+}
+
+func TestAttestationDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(Attestation) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzAttestation(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(Attestation) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestDepositDataRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(DepositData) })
+}
+
+func TestDepositDataDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(DepositData) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzDepositData(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(DepositData) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestDepositRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(Deposit) })
+}
+
+func TestDepositDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(Deposit) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzDeposit(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(Deposit) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestDepositMessageRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(DepositMessage) })
+}
+
+func TestDepositMessageDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(DepositMessage) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzDepositMessage(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(DepositMessage) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestIndexedAttestationRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(IndexedAttestation) })
+}
+
+func TestIndexedAttestationDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(IndexedAttestation) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzIndexedAttestation(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(IndexedAttestation) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestPendingAttestationRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(PendingAttestation) })
+}
+
+func TestPendingAttestationDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(PendingAttestation) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzPendingAttestation(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(PendingAttestation) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestForkRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(Fork) })
+}
+
+func TestForkDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(Fork) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzFork(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(Fork) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestValidatorRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(Validator) })
+}
+
+func TestValidatorDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(Validator) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzValidator(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(Validator) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestVoluntaryExitRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(VoluntaryExit) })
+}
+
+func TestVoluntaryExitDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(VoluntaryExit) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzVoluntaryExit(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(VoluntaryExit) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestSignedVoluntaryExitRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(SignedVoluntaryExit) })
+}
+
+func TestSignedVoluntaryExitDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(SignedVoluntaryExit) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzSignedVoluntaryExit(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(SignedVoluntaryExit) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestEth1BlockRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(Eth1Block) })
+}
+
+func TestEth1BlockDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(Eth1Block) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzEth1Block(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(Eth1Block) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestEth1DataRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(Eth1Data) })
+}
+
+func TestEth1DataDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(Eth1Data) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzEth1Data(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(Eth1Data) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestSigningRootRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(SigningRoot) })
+}
+
+func TestSigningRootDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(SigningRoot) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzSigningRoot(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(SigningRoot) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestHistoricalBatchRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(HistoricalBatch) })
+}
+
+func TestHistoricalBatchDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(HistoricalBatch) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzHistoricalBatch(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(HistoricalBatch) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestProposerSlashingRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(ProposerSlashing) })
+}
+
+func TestProposerSlashingDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(ProposerSlashing) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzProposerSlashing(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(ProposerSlashing) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestAttesterSlashingRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(AttesterSlashing) })
+}
+
+func TestAttesterSlashingDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(AttesterSlashing) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzAttesterSlashing(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(AttesterSlashing) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestBeaconBlockRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(BeaconBlock) })
+}
+
+func TestBeaconBlockDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(BeaconBlock) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzBeaconBlock(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(BeaconBlock) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestSignedBeaconBlockRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(SignedBeaconBlock) })
+}
+
+func TestSignedBeaconBlockDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(SignedBeaconBlock) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzSignedBeaconBlock(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(SignedBeaconBlock) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestTransferRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(Transfer) })
+}
+
+func TestTransferDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(Transfer) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzTransfer(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(Transfer) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestBeaconStateRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(BeaconState) })
+}
+
+func TestBeaconStateDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(BeaconState) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzBeaconState(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(BeaconState) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestBeaconBlockBodyPhase0RoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(BeaconBlockBodyPhase0) })
+}
+
+func TestBeaconBlockBodyPhase0Differential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(BeaconBlockBodyPhase0) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzBeaconBlockBodyPhase0(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(BeaconBlockBodyPhase0) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestBeaconBlockBodyAltairRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(BeaconBlockBodyAltair) })
+}
+
+func TestBeaconBlockBodyAltairDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(BeaconBlockBodyAltair) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzBeaconBlockBodyAltair(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(BeaconBlockBodyAltair) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestBeaconBlockBodyBellatrixRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(BeaconBlockBodyBellatrix) })
+}
+
+func TestBeaconBlockBodyBellatrixDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(BeaconBlockBodyBellatrix) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzBeaconBlockBodyBellatrix(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(BeaconBlockBodyBellatrix) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestBeaconStateAltairRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(BeaconStateAltair) })
+}
+
+func TestBeaconStateAltairDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(BeaconStateAltair) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzBeaconStateAltair(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(BeaconStateAltair) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestBeaconStateBellatrixRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(BeaconStateBellatrix) })
+}
+
+func TestBeaconStateBellatrixDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(BeaconStateBellatrix) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzBeaconStateBellatrix(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(BeaconStateBellatrix) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestSignedBeaconBlockHeaderRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(SignedBeaconBlockHeader) })
+}
+
+func TestSignedBeaconBlockHeaderDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(SignedBeaconBlockHeader) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzSignedBeaconBlockHeader(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(SignedBeaconBlockHeader) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestBeaconBlockHeaderRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(BeaconBlockHeader) })
+}
+
+func TestBeaconBlockHeaderDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(BeaconBlockHeader) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzBeaconBlockHeader(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(BeaconBlockHeader) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestErrorResponseRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(ErrorResponse) })
+}
+
+func TestErrorResponseDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(ErrorResponse) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzErrorResponse(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(ErrorResponse) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestDummyRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(Dummy) })
+}
+
+func TestDummyDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(Dummy) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzDummy(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(Dummy) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestSyncCommitteeRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(SyncCommittee) })
+}
+
+func TestSyncCommitteeDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(SyncCommittee) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzSyncCommittee(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(SyncCommittee) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestSyncAggregateRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(SyncAggregate) })
+}
+
+func TestSyncAggregateDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(SyncAggregate) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzSyncAggregate(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(SyncAggregate) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestExecutionPayloadRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(ExecutionPayload) })
+}
+
+func TestExecutionPayloadDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(ExecutionPayload) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzExecutionPayload(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(ExecutionPayload) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestExecutionPayloadHeaderRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(ExecutionPayloadHeader) })
+}
+
+func TestExecutionPayloadHeaderDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(ExecutionPayloadHeader) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzExecutionPayloadHeader(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(ExecutionPayloadHeader) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestExecutionPayloadCapellaRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(ExecutionPayloadCapella) })
+}
+
+func TestExecutionPayloadCapellaDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(ExecutionPayloadCapella) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzExecutionPayloadCapella(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(ExecutionPayloadCapella) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestExecutionPayloadHeaderCapellaRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(ExecutionPayloadHeaderCapella) })
+}
+
+func TestExecutionPayloadHeaderCapellaDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(ExecutionPayloadHeaderCapella) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzExecutionPayloadHeaderCapella(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(ExecutionPayloadHeaderCapella) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestBLSToExecutionChangeRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(BLSToExecutionChange) })
+}
+
+func TestBLSToExecutionChangeDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(BLSToExecutionChange) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzBLSToExecutionChange(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(BLSToExecutionChange) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestHistoricalSummaryRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(HistoricalSummary) })
+}
+
+func TestHistoricalSummaryDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(HistoricalSummary) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzHistoricalSummary(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(HistoricalSummary) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestSignedBLSToExecutionChangeRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(SignedBLSToExecutionChange) })
+}
+
+func TestSignedBLSToExecutionChangeDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(SignedBLSToExecutionChange) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzSignedBLSToExecutionChange(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(SignedBLSToExecutionChange) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestWithdrawalRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(Withdrawal) })
+}
+
+func TestWithdrawalDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(Withdrawal) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzWithdrawal(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(Withdrawal) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestBeaconStateCapellaRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(BeaconStateCapella) })
+}
+
+func TestBeaconStateCapellaDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(BeaconStateCapella) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzBeaconStateCapella(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(BeaconStateCapella) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestSignedBeaconBlockCapellaRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(SignedBeaconBlockCapella) })
+}
+
+func TestSignedBeaconBlockCapellaDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(SignedBeaconBlockCapella) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzSignedBeaconBlockCapella(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(SignedBeaconBlockCapella) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestBeaconBlockCapellaRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(BeaconBlockCapella) })
+}
+
+func TestBeaconBlockCapellaDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(BeaconBlockCapella) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzBeaconBlockCapella(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(BeaconBlockCapella) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestBeaconBlockBodyCapellaRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(BeaconBlockBodyCapella) })
+}
+
+func TestBeaconBlockBodyCapellaDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(BeaconBlockBodyCapella) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzBeaconBlockBodyCapella(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(BeaconBlockBodyCapella) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestExecutionPayloadDenebRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(ExecutionPayloadDeneb) })
+}
+
+func TestExecutionPayloadDenebDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(ExecutionPayloadDeneb) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzExecutionPayloadDeneb(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(ExecutionPayloadDeneb) }, "../eth2.0-spec-tests/tests")
+}
+
+func TestExecutionPayloadHeaderDenebRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(ExecutionPayloadHeaderDeneb) })
+}
+
+func TestExecutionPayloadHeaderDenebDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(ExecutionPayloadHeaderDeneb) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzExecutionPayloadHeaderDeneb(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(ExecutionPayloadHeaderDeneb) }, "../eth2.0-spec-tests/tests")
+}
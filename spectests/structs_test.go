@@ -1,238 +1,114 @@
 package spectests
 
 import (
-	"bytes"
-	"encoding/hex"
-	"fmt"
-	"io/ioutil"
-	"path/filepath"
-	"strings"
 	"testing"
 
 	ssz "github.com/NilFoundation/fastssz"
-	"github.com/golang/snappy"
+	"github.com/NilFoundation/fastssz/spectest"
 	"github.com/prysmaticlabs/gohashtree"
-
-	"gopkg.in/yaml.v3"
-)
-
-type codec interface {
-	ssz.Marshaler
-	ssz.Unmarshaler
-	ssz.HashRoot
-}
-
-type fork string
-
-const (
-	phase0    fork = "phase0"
-	altair    fork = "altair"
-	bellatrix fork = "bellatrix"
-	capella   fork = "capella"
-	deneb     fork = "deneb"
 )
 
-type testCallback func(fork fork) codec
-
-var codecs = map[string]testCallback{
-	"AttestationData":   func(fork fork) codec { return new(AttestationData) },
-	"Checkpoint":        func(fork fork) codec { return new(Checkpoint) },
-	"AggregateAndProof": func(fork fork) codec { return new(AggregateAndProof) },
-	"Attestation":       func(fork fork) codec { return new(Attestation) },
-	"AttesterSlashing":  func(fork fork) codec { return new(AttesterSlashing) },
-	"BeaconState": func(fork fork) codec {
-		if fork == phase0 {
-			return new(BeaconState)
-		} else if fork == altair {
-			return new(BeaconStateAltair)
-		} else if fork == bellatrix {
-			return new(BeaconStateBellatrix)
-		} else if fork == capella {
-			return new(BeaconStateCapella)
-		}
-		return nil
-	},
-	"BeaconBlock": func(fork fork) codec {
-		if fork == phase0 {
-			return new(BeaconBlock)
-		} else if fork == capella {
-			return new(BeaconBlockCapella)
-		}
-		return nil
-	},
-	"BeaconBlockBody": func(fork fork) codec {
-		if fork == phase0 {
-			return new(BeaconBlockBodyPhase0)
-		} else if fork == altair {
-			return new(BeaconBlockBodyAltair)
-		} else if fork == bellatrix {
-			return new(BeaconBlockBodyBellatrix)
-		} else if fork == capella {
-			return new(BeaconBlockBodyCapella)
-		}
-		return nil
-	},
-	"BeaconBlockHeader":  func(fork fork) codec { return new(BeaconBlockHeader) },
-	"Deposit":            func(fork fork) codec { return new(Deposit) },
-	"DepositData":        func(fork fork) codec { return new(DepositData) },
-	"DepositMessage":     func(fork fork) codec { return new(DepositMessage) },
-	"Eth1Block":          func(fork fork) codec { return new(Eth1Block) },
-	"Eth1Data":           func(fork fork) codec { return new(Eth1Data) },
-	"Fork":               func(fork fork) codec { return new(Fork) },
-	"HistoricalBatch":    func(fork fork) codec { return new(HistoricalBatch) },
-	"IndexedAttestation": func(fork fork) codec { return new(IndexedAttestation) },
-	"PendingAttestation": func(fork fork) codec { return new(PendingAttestation) },
-	"ProposerSlashing":   func(fork fork) codec { return new(ProposerSlashing) },
-	"SignedBeaconBlock": func(fork fork) codec {
-		if fork == phase0 {
-			return new(SignedBeaconBlock)
-		} else if fork == capella {
-			return new(SignedBeaconBlockCapella)
-		}
-		return nil
-	},
-	"SignedBeaconBlockHeader": func(fork fork) codec { return new(SignedBeaconBlockHeader) },
-	"SignedVoluntaryExit":     func(fork fork) codec { return new(SignedVoluntaryExit) },
-	"SigningRoot":             func(fork fork) codec { return new(SigningRoot) },
-	"Validator":               func(fork fork) codec { return new(Validator) },
-	"VoluntaryExit":           func(fork fork) codec { return new(VoluntaryExit) },
-	"ErrorResponse":           func(fork fork) codec { return new(ErrorResponse) },
-	"SyncCommittee": func(fork fork) codec {
-		return new(SyncCommittee)
-	},
-	"SyncAggregate": func(fork fork) codec {
-		return new(SyncAggregate)
-	},
-	"ExecutionPayload": func(fork fork) codec {
-		if fork == deneb {
+var codecs = spectest.Registry{
+	"AttestationData":   spectest.All(func() spectest.Object { return new(AttestationData) }),
+	"Checkpoint":        spectest.All(func() spectest.Object { return new(Checkpoint) }),
+	"AggregateAndProof": spectest.All(func() spectest.Object { return new(AggregateAndProof) }),
+	"Attestation":       spectest.All(func() spectest.Object { return new(Attestation) }),
+	"AttesterSlashing":  spectest.All(func() spectest.Object { return new(AttesterSlashing) }),
+	"BeaconState": spectest.Forks(map[spectest.Fork]func() spectest.Object{
+		spectest.Phase0:    func() spectest.Object { return new(BeaconState) },
+		spectest.Altair:    func() spectest.Object { return new(BeaconStateAltair) },
+		spectest.Bellatrix: func() spectest.Object { return new(BeaconStateBellatrix) },
+		spectest.Capella:   func() spectest.Object { return new(BeaconStateCapella) },
+	}),
+	"BeaconBlock": spectest.Forks(map[spectest.Fork]func() spectest.Object{
+		spectest.Phase0:  func() spectest.Object { return new(BeaconBlock) },
+		spectest.Capella: func() spectest.Object { return new(BeaconBlockCapella) },
+	}),
+	"BeaconBlockBody": spectest.Forks(map[spectest.Fork]func() spectest.Object{
+		spectest.Phase0:    func() spectest.Object { return new(BeaconBlockBodyPhase0) },
+		spectest.Altair:    func() spectest.Object { return new(BeaconBlockBodyAltair) },
+		spectest.Bellatrix: func() spectest.Object { return new(BeaconBlockBodyBellatrix) },
+		spectest.Capella:   func() spectest.Object { return new(BeaconBlockBodyCapella) },
+	}),
+	"BeaconBlockHeader":  spectest.All(func() spectest.Object { return new(BeaconBlockHeader) }),
+	"Deposit":            spectest.All(func() spectest.Object { return new(Deposit) }),
+	"DepositData":        spectest.All(func() spectest.Object { return new(DepositData) }),
+	"DepositMessage":     spectest.All(func() spectest.Object { return new(DepositMessage) }),
+	"Eth1Block":          spectest.All(func() spectest.Object { return new(Eth1Block) }),
+	"Eth1Data":           spectest.All(func() spectest.Object { return new(Eth1Data) }),
+	"Fork":               spectest.All(func() spectest.Object { return new(Fork) }),
+	"HistoricalBatch":    spectest.All(func() spectest.Object { return new(HistoricalBatch) }),
+	"IndexedAttestation": spectest.All(func() spectest.Object { return new(IndexedAttestation) }),
+	"PendingAttestation": spectest.All(func() spectest.Object { return new(PendingAttestation) }),
+	"ProposerSlashing":   spectest.All(func() spectest.Object { return new(ProposerSlashing) }),
+	"SignedBeaconBlock": spectest.Forks(map[spectest.Fork]func() spectest.Object{
+		spectest.Phase0:  func() spectest.Object { return new(SignedBeaconBlock) },
+		spectest.Capella: func() spectest.Object { return new(SignedBeaconBlockCapella) },
+	}),
+	"SignedBeaconBlockHeader": spectest.All(func() spectest.Object { return new(SignedBeaconBlockHeader) }),
+	"SignedVoluntaryExit":     spectest.All(func() spectest.Object { return new(SignedVoluntaryExit) }),
+	"SigningRoot":             spectest.All(func() spectest.Object { return new(SigningRoot) }),
+	"Validator":               spectest.All(func() spectest.Object { return new(Validator) }),
+	"VoluntaryExit":           spectest.All(func() spectest.Object { return new(VoluntaryExit) }),
+	"ErrorResponse":           spectest.All(func() spectest.Object { return new(ErrorResponse) }),
+	"SyncCommittee":           spectest.All(func() spectest.Object { return new(SyncCommittee) }),
+	"SyncAggregate":           spectest.All(func() spectest.Object { return new(SyncAggregate) }),
+	"ExecutionPayload": func(fork spectest.Fork) spectest.Object {
+		if fork == spectest.Deneb {
 			return new(ExecutionPayloadDeneb)
-		} else if fork == capella {
+		} else if fork == spectest.Capella {
 			return new(ExecutionPayloadCapella)
 		}
 		return new(ExecutionPayload)
 	},
-	"ExecutionPayloadHeader": func(fork fork) codec {
-		if fork == deneb {
+	"ExecutionPayloadHeader": func(fork spectest.Fork) spectest.Object {
+		if fork == spectest.Deneb {
 			return new(ExecutionPayloadHeaderDeneb)
-		} else if fork == capella {
+		} else if fork == spectest.Capella {
 			return new(ExecutionPayloadHeaderCapella)
 		}
 		return new(ExecutionPayloadHeader)
 	},
-	"BLSToExecutionChange":       func(f fork) codec { return new(BLSToExecutionChange) },
-	"HistoricalSummary":          func(f fork) codec { return new(HistoricalSummary) },
-	"SignedBLSToExecutionChange": func(f fork) codec { return new(SignedBLSToExecutionChange) },
-	"Withdrawal":                 func(f fork) codec { return new(Withdrawal) },
+	"BLSToExecutionChange":       spectest.All(func() spectest.Object { return new(BLSToExecutionChange) }),
+	"HistoricalSummary":          spectest.All(func() spectest.Object { return new(HistoricalSummary) }),
+	"SignedBLSToExecutionChange": spectest.All(func() spectest.Object { return new(SignedBLSToExecutionChange) }),
+	"Withdrawal":                 spectest.All(func() spectest.Object { return new(Withdrawal) }),
 }
 
-func testSpecFork(t *testing.T, fork fork) {
-	files := readDir(t, filepath.Join(testsPath, "/mainnet/"+string(fork)+"/ssz_static"))
-	for _, f := range files {
-		spl := strings.Split(f, "/")
-		name := spl[len(spl)-1]
-
-		base, ok := codecs[name]
-		if !ok {
-			continue
-		}
-
-		t.Run(name, func(t *testing.T) {
-			files := readDir(t, filepath.Join(f, "ssz_random"))
-			for _, f := range files {
-				checkSSZEncoding(t, fork, f, name, base)
-			}
-		})
-	}
+func testSpecFork(t *testing.T, fork spectest.Fork) {
+	spectest.RunTest(t, testsPath, codecs, &spectest.Options{
+		Forks:    []spectest.Fork{fork},
+		Handlers: []string{"ssz_random"},
+		Value:    true,
+		HashFns:  []ssz.HashFn{gohashtree.HashByteSlice},
+	})
 }
 
 func TestSpec_Phase0(t *testing.T) {
-	testSpecFork(t, phase0)
+	testSpecFork(t, spectest.Phase0)
 }
 
 func TestSpec_Altair(t *testing.T) {
-	testSpecFork(t, altair)
+	testSpecFork(t, spectest.Altair)
 }
 
 func TestSpec_Bellatrix(t *testing.T) {
-	testSpecFork(t, bellatrix)
+	testSpecFork(t, spectest.Bellatrix)
 }
 
 func TestSpec_Capella(t *testing.T) {
-	testSpecFork(t, capella)
+	testSpecFork(t, spectest.Capella)
 }
 
 func TestSpec_Deneb(t *testing.T) {
-	testSpecFork(t, deneb)
-}
-
-func checkSSZEncoding(t *testing.T, fork fork, fileName, structName string, base testCallback) {
-	obj := base(fork)
-	if obj == nil {
-		// skip
-		return
-	}
-	output := readValidGenericSSZ(t, fileName, &obj)
-
-	fatal := func(errHeader string, err error) {
-		t.Fatalf("%s spec file=%s, struct=%s, err=%v", errHeader, fileName, structName, err)
-	}
-
-	// Marshal
-	res, err := obj.MarshalSSZTo(nil)
-	if err != nil {
-		fatal("marshalSSZ", err)
-	}
-	if !bytes.Equal(res, output.ssz) {
-		fatal("marshalSSZ_equal", fmt.Errorf("bad marshal"))
-	}
-
-	// Unmarshal
-	obj2 := base(fork)
-	if err := obj2.UnmarshalSSZ(output.ssz); err != nil {
-		fatal("UnmarshalSSZ", err)
-	}
-	if !deepEqual(obj, obj2) {
-		fatal("UnmarshalSSZ_equal", fmt.Errorf("bad unmarshal"))
-	}
-
-	// Root
-	root, err := obj.HashTreeRoot()
-	if err != nil {
-		fatal("HashTreeRoot", err)
-	}
-	if !bytes.Equal(root[:], output.root) {
-		fatal("HashTreeRoot_equal", fmt.Errorf("bad root"))
-	}
-
-	// Root with gohashtree
-	hh := ssz.NewHasherWithHashFn(gohashtree.HashByteSlice)
-	if err := obj.HashTreeRootWith(hh); err != nil {
-		fatal("GohashtreeRoot", err)
-	}
-	gohashTreeRoot, err := hh.HashRoot()
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !bytes.Equal(gohashTreeRoot[:], output.root) {
-		fatal("GohashtreeRoot_equal", fmt.Errorf("bad root"))
-	}
-
-	// Proof
-	node, err := obj.GetTree()
-	if err != nil {
-		fatal("Tree", err)
-	}
-	nodeRoot := node.Hash()
-	if !bytes.Equal(nodeRoot, root[:]) {
-		fatal("Tree_equal", fmt.Errorf("bad node"))
-	}
+	testSpecFork(t, spectest.Deneb)
 }
 
 const benchmarkTestCase = "../eth2.0-spec-tests/tests/mainnet/phase0/ssz_static/BeaconBlock/ssz_random/case_4"
 
 func BenchmarkMarshal_Fast(b *testing.B) {
 	obj := new(BeaconBlock)
-	readValidGenericSSZ(nil, benchmarkTestCase, obj)
+	readBenchmarkCase(b, obj)
 
 	b.ReportAllocs()
 	b.ResetTimer()
@@ -244,7 +120,7 @@ func BenchmarkMarshal_Fast(b *testing.B) {
 
 func BenchmarkMarshal_SuperFast(b *testing.B) {
 	obj := new(BeaconBlock)
-	readValidGenericSSZ(nil, benchmarkTestCase, obj)
+	readBenchmarkCase(b, obj)
 
 	buf := make([]byte, 0)
 
@@ -258,7 +134,7 @@ func BenchmarkMarshal_SuperFast(b *testing.B) {
 
 func BenchmarkUnMarshal_Fast(b *testing.B) {
 	obj := new(BeaconBlock)
-	readValidGenericSSZ(nil, benchmarkTestCase, obj)
+	readBenchmarkCase(b, obj)
 
 	dst, err := obj.MarshalSSZ()
 	if err != nil {
@@ -278,7 +154,7 @@ func BenchmarkUnMarshal_Fast(b *testing.B) {
 
 func BenchmarkHashTreeRoot_Fast(b *testing.B) {
 	obj := new(BeaconBlock)
-	readValidGenericSSZ(nil, benchmarkTestCase, obj)
+	readBenchmarkCase(b, obj)
 
 	b.ReportAllocs()
 	b.ResetTimer()
@@ -292,7 +168,7 @@ func BenchmarkHashTreeRoot_Fast(b *testing.B) {
 
 func BenchmarkHashTreeRoot_SuperFast(b *testing.B) {
 	obj := new(BeaconBlock)
-	readValidGenericSSZ(nil, benchmarkTestCase, obj)
+	readBenchmarkCase(b, obj)
 
 	b.ReportAllocs()
 	b.ResetTimer()
@@ -306,7 +182,7 @@ func BenchmarkHashTreeRoot_SuperFast(b *testing.B) {
 
 func BenchmarkProof_Tree(b *testing.B) {
 	obj := new(BeaconBlock)
-	readValidGenericSSZ(nil, benchmarkTestCase, obj)
+	readBenchmarkCase(b, obj)
 
 	b.ReportAllocs()
 	b.ResetTimer()
@@ -316,61 +192,38 @@ func BenchmarkProof_Tree(b *testing.B) {
 	}
 }
 
-const (
-	testsPath      = "../eth2.0-spec-tests/tests"
-	serializedFile = "serialized.ssz_snappy"
-	valueFile      = "value.yaml"
-	rootsFile      = "roots.yaml"
-)
+const testsPath = "../eth2.0-spec-tests/tests"
 
-func readDir(t *testing.T, path string) []string {
-	files, err := ioutil.ReadDir(path)
+// readBenchmarkCase decodes the object of the benchmark test case
+func readBenchmarkCase(b *testing.B, obj ssz.Unmarshaler) {
+	c, err := spectest.ReadCase(benchmarkTestCase)
 	if err != nil {
-		t.Fatal(err)
+		b.Fatal(err)
 	}
-	res := []string{}
-	for _, f := range files {
-		res = append(res, filepath.Join(path, f.Name()))
+	if err := obj.UnmarshalSSZ(c.Serialized); err != nil {
+		b.Fatal(err)
 	}
-	return res
 }
 
-type output struct {
-	root []byte
-	ssz  []byte
-}
-
-func readValidGenericSSZ(t *testing.T, path string, obj interface{}) *output {
-	serializedSnappy, err := ioutil.ReadFile(filepath.Join(path, serializedFile))
-	if err != nil {
-		t.Fatal(err)
-	}
-	serialized, err := snappy.Decode(nil, serializedSnappy)
-	if err != nil {
-		t.Fatal(err)
+func TestMarshal_BitlistLimit(t *testing.T) {
+	// 2048 bits take 256 bytes and the length bit one more byte
+	bits := make([]byte, 257)
+	for i := range bits {
+		bits[i] = 0xff
 	}
+	bits[256] = 0x01
 
-	raw, err := ioutil.ReadFile(filepath.Join(path, valueFile))
-	if err != nil {
-		t.Fatal(err)
-	}
-	raw2, err := ioutil.ReadFile(filepath.Join(path, rootsFile))
-	if err != nil {
-		t.Fatal(err)
+	data := &AttestationData{
+		Source: &Checkpoint{Root: make([]byte, 32)},
+		Target: &Checkpoint{Root: make([]byte, 32)},
 	}
-
-	// Decode ssz root
-	var out map[string]string
-	if err := yaml.Unmarshal(raw2, &out); err != nil {
-		t.Fatal(err)
-	}
-	root, err := hex.DecodeString(out["root"][2:])
-	if err != nil {
-		t.Fatal(err)
+	obj := &Attestation{AggregationBits: bits, Data: data}
+	if _, err := obj.MarshalSSZ(); err != nil {
+		t.Fatalf("bitlist at the limit: %v", err)
 	}
 
-	if err := ssz.UnmarshalSSZTest(raw, obj); err != nil {
-		t.Fatal(err)
+	obj.AggregationBits = append(bits[:256], 0xff, 0x01)
+	if _, err := obj.MarshalSSZ(); err == nil {
+		t.Fatal("bitlist over the limit is marshalled")
 	}
-	return &output{root: root, ssz: serialized}
 }
@@ -0,0 +1,67 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"regexp"
+	"strconv"
+)
+
+// Bitvectors are encoded as fixed bytes. If the number of bits is not a multiple of 8,
+// the decoder checks that the bits after the length are not set. The number of bits is
+// set with the 'bitvector' option of the ssz tag, in which case ssz-size is the number
+// of bits like ssz-max for bitlists:
+//
+//	Bits []byte `ssz:"bitvector" ssz-size:"5"`
+//
+// or it is taken from the name of the go-bitfield type (i.e. Bitvector4), either the
+// type of the field or its cast-type tag.
+
+var bitvectorRegexp = regexp.MustCompile(`Bitvector(\d+)$`)
+
+// bitvectorField returns the value of a field with the 'bitvector' option of the ssz tag
+func (e *env) bitvectorField(name, tags string, expr ast.Expr) (*Value, bool, error) {
+	if tag, ok := getTags(tags, "ssz"); !ok || tag != "bitvector" {
+		return nil, false, nil
+	}
+	bits, ok := getTagsInt(tags, "ssz-size")
+	if !ok || bits == 0 {
+		return nil, false, fmt.Errorf("bitvector %s does not have a ssz-size tag with the number of bits", name)
+	}
+	size := (bits + 7) / 8
+
+	v, err := e.parseASTFieldType(name, fmt.Sprintf("`ssz-size:\"%d\"`", size), expr)
+	if err != nil {
+		return nil, false, err
+	}
+	if v.t != TypeBytes || !v.isFixed() || v.s != size {
+		return nil, false, fmt.Errorf("bitvector %s of %d bits must be a slice or an array of %d bytes", name, bits, size)
+	}
+	if bits%8 != 0 {
+		v.bits = bits
+	}
+	return v, true, nil
+}
+
+// setBitvectorBits sets the number of bits of the fixed bytes whose type is
+// a go-bitfield bitvector that is not a multiple of 8
+func setBitvectorBits(v *Value, tags string, expr ast.Expr) {
+	if v.t != TypeBytes || !v.isFixed() {
+		return
+	}
+	typ, ok := getTags(tags, "cast-type")
+	if !ok {
+		if sel, isSel := expr.(*ast.SelectorExpr); isSel {
+			typ = sel.Sel.Name
+		}
+	}
+	match := bitvectorRegexp.FindStringSubmatch(typ)
+	if match == nil {
+		return
+	}
+	bits, err := strconv.ParseUint(match[1], 10, 64)
+	if err != nil || bits%8 == 0 || (bits+7)/8 != v.s {
+		return
+	}
+	v.bits = bits
+}
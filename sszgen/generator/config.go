@@ -0,0 +1,354 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// The optional methods generated besides the ssz encoding and hashing methods
+const (
+	// MethodSchema generates SSZSchema and the generalized index constants
+	MethodSchema = "schema"
+	// MethodFromTree generates FromTree
+	MethodFromTree = "fromtree"
+	// MethodTests generates the round trip and fuzz tests
+	MethodTests = "tests"
+)
+
+var optionalMethods = []string{MethodSchema, MethodFromTree, MethodTests}
+
+// Config is the configuration to generate multiple packages in a single run. It is
+// decoded from a YAML or JSON file:
+//
+//	suffix: encoding
+//	presets:
+//	  spec:
+//	    exclude: [Hash, Uint256]
+//	    tests: true
+//	packages:
+//	  - path: ./spectests/structs.go
+//	    preset: spec
+//	  - path: ./tests
+//	    types:
+//	      Bar:
+//	        methods: [schema, fromtree, tests]
+//	    codecs:
+//	      uint256.Int:
+//	        codec: codecs.Uint256
+//	        import: github.com/org/project/codecs
+//	        size: 32
+//
+// The options of a package override the ones of its preset, which override the
+// top level options. The paths are relative to the directory of the file.
+type Config struct {
+	Options `yaml:",inline"`
+
+	// Presets are named options that the packages can use
+	Presets map[string]*Options `yaml:"presets"`
+
+	// Packages are the packages to generate
+	Packages []*PackageConfig `yaml:"packages"`
+
+	// dir is the directory of the config file
+	dir string
+}
+
+// Options are the options to generate a package
+type Options struct {
+	// Suffix is the suffix of the generated files, 'encoding' by default
+	Suffix string `yaml:"suffix"`
+	// Include are other paths to resolve the references, like the --include flag
+	Include []string `yaml:"include"`
+	// Exclude are the types that are not generated
+	Exclude []string `yaml:"exclude"`
+	// Tests enables the generated tests
+	Tests *bool `yaml:"tests"`
+	// Fixtures is the path of the spec tests used to seed the fuzz tests, relative
+	// to the directory of the package like the --tests-fixtures flag
+	Fixtures string `yaml:"fixtures"`
+	// Methods are the optional methods generated for the types (schema, fromtree and
+	// tests), all of them by default (the tests only if they are enabled)
+	Methods []string `yaml:"methods"`
+	// ParseFiles parses the files of the packages that cannot be loaded without
+	// type information, like the --parse-files flag
+	ParseFiles *bool `yaml:"parse-files"`
+	// Types are the options of specific types
+	Types map[string]*TypeOptions `yaml:"types"`
+	// Codecs are the custom codecs of the field types by the name of the type,
+	// either as it is written in the source (uint256.Int) or with the full path
+	// of its package (github.com/holiman/uint256.Int)
+	Codecs map[string]*CodecOptions `yaml:"codecs"`
+}
+
+// TypeOptions are the options of a generated type
+type TypeOptions struct {
+	// Methods are the optional methods generated for the type, they replace
+	// the methods of the package
+	Methods []string `yaml:"methods"`
+}
+
+// CodecOptions are the options of a custom codec
+type CodecOptions struct {
+	// Codec is the expression of the codec value, like codecs.Uint256
+	Codec string `yaml:"codec"`
+	// Import is the path of the package of the codec, if it is not
+	// imported by the source files
+	Import string `yaml:"import"`
+	// Size is the size of the encoding if it is fixed
+	Size uint64 `yaml:"size"`
+	// Max is the maximum size of the encoding if it is variable
+	Max uint64 `yaml:"max"`
+}
+
+// PackageConfig is the configuration of a generated package
+type PackageConfig struct {
+	// Path is the file or the directory of the package
+	Path string `yaml:"path"`
+	// Objs are the types to generate, all by default
+	Objs []string `yaml:"objs"`
+	// Output is the single output file for all the types (optional)
+	Output string `yaml:"output"`
+	// Preset is the name of the preset used by the package (optional)
+	Preset string `yaml:"preset"`
+
+	Options `yaml:",inline"`
+}
+
+// LoadConfig reads a YAML or JSON configuration file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	config := &Config{}
+	if err := dec.Decode(config); err != nil {
+		return nil, fmt.Errorf("failed to decode config %s: %v", path, err)
+	}
+	config.dir = filepath.Dir(path)
+
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("incorrect config %s: %v", path, err)
+	}
+	return config, nil
+}
+
+func (c *Config) validate() error {
+	if len(c.Packages) == 0 {
+		return fmt.Errorf("no packages")
+	}
+	all := []*Options{&c.Options}
+	for _, preset := range c.Presets {
+		all = append(all, preset)
+	}
+	for _, pkg := range c.Packages {
+		if pkg.Path == "" {
+			return fmt.Errorf("package without path")
+		}
+		if _, ok := c.Presets[pkg.Preset]; pkg.Preset != "" && !ok {
+			return fmt.Errorf("preset '%s' of package %s not found", pkg.Preset, pkg.Path)
+		}
+		all = append(all, &pkg.Options)
+	}
+	for _, opts := range all {
+		if err := validateMethods(opts.Methods); err != nil {
+			return err
+		}
+		for name, typ := range opts.Types {
+			if typ == nil {
+				return fmt.Errorf("empty options for type %s", name)
+			}
+			if err := validateMethods(typ.Methods); err != nil {
+				return err
+			}
+		}
+		for name, codec := range opts.Codecs {
+			if codec == nil || codec.Codec == "" {
+				return fmt.Errorf("no codec for type %s", name)
+			}
+			if (codec.Size == 0) == (codec.Max == 0) {
+				return fmt.Errorf("codec of type %s requires either a size or a max size", name)
+			}
+		}
+	}
+	return nil
+}
+
+func validateMethods(methods []string) error {
+	for _, method := range methods {
+		if !contains(method, optionalMethods) {
+			return fmt.Errorf("unknown method '%s', expected one of %s", method, strings.Join(optionalMethods, ", "))
+		}
+	}
+	return nil
+}
+
+// merge returns the options with the values of other applied on top of them
+func (o Options) merge(other *Options) Options {
+	if other == nil {
+		return o
+	}
+	if other.Suffix != "" {
+		o.Suffix = other.Suffix
+	}
+	o.Include = append(append([]string{}, o.Include...), other.Include...)
+	o.Exclude = append(append([]string{}, o.Exclude...), other.Exclude...)
+	if other.Tests != nil {
+		o.Tests = other.Tests
+	}
+	if other.Fixtures != "" {
+		o.Fixtures = other.Fixtures
+	}
+	if other.Methods != nil {
+		o.Methods = other.Methods
+	}
+	if other.ParseFiles != nil {
+		o.ParseFiles = other.ParseFiles
+	}
+	types := map[string]*TypeOptions{}
+	for name, typ := range o.Types {
+		types[name] = typ
+	}
+	for name, typ := range other.Types {
+		types[name] = typ
+	}
+	o.Types = types
+
+	codecs := map[string]*CodecOptions{}
+	for name, codec := range o.Codecs {
+		codecs[name] = codec
+	}
+	for name, codec := range other.Codecs {
+		codecs[name] = codec
+	}
+	o.Codecs = codecs
+	return o
+}
+
+// requests returns the request of each package, which share the loaded packages
+func (c *Config) requests() []*request {
+	cache := newPackageCache()
+
+	requests := []*request{}
+	for _, pkg := range c.Packages {
+		opts := c.Options.merge(c.Presets[pkg.Preset]).merge(&pkg.Options)
+
+		r := &request{
+			source:           c.path(pkg.Path),
+			targets:          pkg.Objs,
+			excludeTypeNames: map[string]bool{},
+			suffix:           normalizeSuffix(opts.Suffix),
+			methods:          opts.Methods,
+			typeMethods:      map[string][]string{},
+			codecs:           opts.Codecs,
+			cache:            cache,
+		}
+		if pkg.Output != "" {
+			r.output = c.path(pkg.Output)
+		}
+		for _, include := range opts.Include {
+			r.includePaths = append(r.includePaths, c.path(include))
+		}
+		for _, name := range opts.Exclude {
+			r.excludeTypeNames[name] = true
+		}
+		if opts.Tests != nil && *opts.Tests {
+			r.tests = &TestOptions{Fixtures: opts.Fixtures}
+		}
+		r.parseFiles = opts.ParseFiles != nil && *opts.ParseFiles
+		for name, typ := range opts.Types {
+			if typ.Methods != nil {
+				r.typeMethods[name] = typ.Methods
+			}
+		}
+		requests = append(requests, r)
+	}
+	return requests
+}
+
+// path returns the path relative to the directory of the config file
+func (c *Config) path(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(c.dir, path)
+}
+
+// preload loads the source packages of the requests at once
+func preload(requests []*request) {
+	dirs := []string{}
+	for _, r := range requests {
+		dir := r.source
+		if ok, err := isDir(dir); err == nil && !ok {
+			dir = filepath.Dir(dir)
+		}
+		dirs = append(dirs, dir)
+	}
+	if len(requests) != 0 {
+		// the packages that fail to load are loaded (or parsed) again by each request
+		_ = requests[0].cache.load(dirs...)
+	}
+}
+
+// EncodeConfig generates all the packages of the configuration in the same process
+func EncodeConfig(config *Config) error {
+	requests := config.requests()
+	preload(requests)
+
+	for _, r := range requests {
+		if err := r.encode(); err != nil {
+			return fmt.Errorf("failed to generate %s: %v", r.source, err)
+		}
+	}
+	return nil
+}
+
+// VerifyConfig checks that the files generated for all the packages of the configuration
+// are up to date, see Verify.
+func VerifyConfig(config *Config) ([]*FileReport, error) {
+	requests := config.requests()
+	preload(requests)
+
+	reports := []*FileReport{}
+	for _, r := range requests {
+		res, err := r.verify()
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify %s: %v", r.source, err)
+		}
+		reports = append(reports, res...)
+	}
+	return reports, nil
+}
+
+func normalizeSuffix(suffix string) string {
+	if suffix == "" {
+		suffix = "encoding"
+	}
+	if !strings.HasPrefix(suffix, "_") {
+		suffix = "_" + suffix
+	}
+	if !strings.HasSuffix(suffix, ".go") {
+		suffix = suffix + ".go"
+	}
+	return suffix
+}
+
+// hasMethod returns true if the optional method is generated for the type
+func (e *env) hasMethod(name, method string) bool {
+	methods, ok := e.typeMethods[name]
+	if !ok {
+		methods = e.methods
+	}
+	if methods == nil {
+		// all the methods, the tests only if they are enabled
+		return method != MethodTests || e.tests != nil
+	}
+	return contains(method, methods)
+}
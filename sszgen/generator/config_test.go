@@ -0,0 +1,190 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile := func(name, content string) {
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	readFile := func(name string) string {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+
+	writeFile(filepath.Join(dir, "a", "a.go"), "package a\n\ntype A struct {\n\tA uint64\n}\n\ntype B struct {\n\tB uint64\n}\n\ntype C struct {\n\tC uint64\n}\n")
+	writeFile(filepath.Join(dir, "b", "b.go"), "package b\n\ntype D struct {\n\tD uint64\n}\n")
+	writeFile(filepath.Join(dir, "sszgen.yaml"), `
+suffix: ssz
+parse-files: true
+presets:
+  small:
+    methods: []
+    exclude: [C]
+packages:
+  - path: a
+    preset: small
+    types:
+      B:
+        methods: [schema, tests]
+  - path: b/b.go
+    suffix: gen
+`)
+
+	config, err := LoadConfig(filepath.Join(dir, "sszgen.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := EncodeConfig(config); err != nil {
+		t.Fatal(err)
+	}
+
+	a := readFile(filepath.Join(dir, "a", "a_ssz.go"))
+	for _, str := range []string{"func (a *A) MarshalSSZ", "func (b *B) SSZSchema"} {
+		if !strings.Contains(a, str) {
+			t.Fatalf("%s not generated", str)
+		}
+	}
+	for _, str := range []string{"func (a *A) SSZSchema", "FromTree", "func (c *C)"} {
+		if strings.Contains(a, str) {
+			t.Fatalf("%s should not be generated", str)
+		}
+	}
+	if tests := readFile(filepath.Join(dir, "a", "a_ssz_test.go")); !strings.Contains(tests, "FuzzB(") || strings.Contains(tests, "FuzzA(") {
+		t.Fatalf("unexpected tests:\n%s", tests)
+	}
+
+	// the default methods
+	b := readFile(filepath.Join(dir, "b", "b_gen.go"))
+	if !strings.Contains(b, "func (d *D) SSZSchema") || !strings.Contains(b, "func (d *D) FromTree") {
+		t.Fatal("default methods not generated")
+	}
+
+	reports, err := VerifyConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 3 {
+		t.Fatalf("expected 3 reports but found %d", len(reports))
+	}
+	for _, report := range reports {
+		if report.Status != StatusUpToDate {
+			t.Fatalf("unexpected report %s", report)
+		}
+	}
+}
+
+func TestConfig_Codecs(t *testing.T) {
+	dir := t.TempDir()
+
+	src := "package a\n\nimport \"net/netip\"\n\ntype A struct {\n\tAddr netip.Addr\n\tB    uint64\n}\n"
+	if err := os.WriteFile(filepath.Join(dir, "a.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	config := `
+parse-files: true
+codecs:
+  netip.Addr:
+    codec: codecs.Addr
+    import: example.com/codecs
+    max: 16
+packages:
+  - path: a.go
+`
+	if err := os.WriteFile(filepath.Join(dir, "sszgen.yaml"), []byte(config), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := LoadConfig(filepath.Join(dir, "sszgen.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := EncodeConfig(c); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "a_encoding.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, str := range []string{
+		`"example.com/codecs"`,
+		"ssz.MarshalCustom(codecs.Addr, a.Addr, dst, ssz.VariableSize, 16)",
+		"ssz.HashCustom(codecs.Addr, a.Addr, hh)",
+	} {
+		if !strings.Contains(string(data), str) {
+			t.Fatalf("%s not generated:\n%s", str, data)
+		}
+	}
+}
+
+func TestConfig_Invalid(t *testing.T) {
+	cases := map[string]string{
+		"unknown preset": "packages:\n  - path: a\n    preset: b\n",
+		"unknown method": "methods: [foo]\npackages:\n  - path: a\n",
+		"unknown field":  "packages:\n  - path: a\n    objects: [A]\n",
+		"no packages":    "suffix: ssz\n",
+		"codec size":     "codecs:\n  netip.Addr:\n    codec: Addr\npackages:\n  - path: a\n",
+		"no codec":       "codecs:\n  netip.Addr:\n    size: 4\npackages:\n  - path: a\n",
+	}
+	for name, content := range cases {
+		path := filepath.Join(t.TempDir(), "sszgen.yaml")
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadConfig(path); err == nil {
+			t.Fatalf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestConfig_ImportsByFile(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"b/b.go":   "package b\n\ntype B struct {\n\tB uint64\n}\n",
+		"a/a1.go":  "package a\n\nimport \"example.com/b\"\n\ntype A1 struct {\n\tB *b.B\n}\n",
+		"a/a2.go":  "package a\n\ntype A2 struct {\n\tA uint64\n}\n",
+		"gen.yaml": "parse-files: true\npackages:\n  - path: a\n    include: [b]\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	config, err := LoadConfig(filepath.Join(dir, "gen.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := EncodeConfig(config); err != nil {
+		t.Fatal(err)
+	}
+
+	// only the file with the reference imports the other package
+	for name, imported := range map[string]bool{"a1_encoding.go": true, "a2_encoding.go": false} {
+		data, err := os.ReadFile(filepath.Join(dir, "a", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(data), `"example.com/b"`) != imported {
+			t.Fatalf("unexpected imports of %s:\n%s", name, data)
+		}
+	}
+}
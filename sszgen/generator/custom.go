@@ -0,0 +1,178 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strconv"
+	"strings"
+)
+
+// The fields of types that do not implement the ssz interfaces are encoded with the functions
+// of a codec, which is set with the 'custom' option of the ssz tag:
+//
+//	Balance uint256.Int `ssz:"custom,codec=Uint256Codec,size=32"`
+//
+// or for all the fields of a type with the codecs of the configuration file. The codec
+// implements the ssz.Codec interface for the type of the field and the generated code
+// calls it through the ssz.MarshalCustom, ssz.UnmarshalCustom, ssz.SizeCustom and
+// ssz.HashCustom functions. The encoding has a fixed size (size) or a maximum size (max).
+
+// customCodec is the codec of a field
+type customCodec struct {
+	// expr is the Go expression of the codec value
+	expr string
+	// path is the import path of the package of the codec, empty if the
+	// package is resolved from the imports of the source files
+	path string
+}
+
+// alias returns the name of the package of the codec, empty if the codec
+// belongs to the generated package
+func (c *customCodec) alias() string {
+	indx := strings.Index(c.expr, ".")
+	if indx == -1 {
+		return ""
+	}
+	return c.expr[:indx]
+}
+
+// customField returns the value of a field encoded with a custom codec, if the ssz tag has
+// the 'custom' option or there is a codec in the configuration for the type of the field.
+func (e *env) customField(name, tags string, expr ast.Expr) (*Value, bool, error) {
+	if tag, ok := getTags(tags, "ssz"); ok && (tag == "custom" || strings.HasPrefix(tag, "custom,")) {
+		v, err := parseCustomTag(name, tag)
+		if err != nil {
+			return nil, false, err
+		}
+		return v, true, nil
+	}
+
+	opts, ok := e.codecs[types.ExprString(expr)]
+	if !ok {
+		if tv, found := e.loader.values[expr]; found && tv.Type != nil {
+			opts, ok = e.codecs[types.TypeString(tv.Type, nil)]
+		}
+	}
+	if !ok {
+		return nil, false, nil
+	}
+	v := &Value{t: TypeCustom, codec: &customCodec{expr: opts.Codec, path: opts.Import}}
+	if err := v.setCustomSize(name, opts.Size, opts.Max); err != nil {
+		return nil, false, err
+	}
+	return v, true, nil
+}
+
+// parseCustomTag parses the options of a ssz tag like 'custom,codec=Codec,size=32'
+func parseCustomTag(name, tag string) (*Value, error) {
+	v := &Value{t: TypeCustom, codec: &customCodec{}}
+
+	var size, max uint64
+	for _, opt := range strings.Split(tag, ",")[1:] {
+		spl := strings.SplitN(opt, "=", 2)
+		if len(spl) != 2 {
+			return nil, fmt.Errorf("incorrect option '%s' in the custom tag of %s", opt, name)
+		}
+		key, val := strings.TrimSpace(spl[0]), strings.TrimSpace(spl[1])
+		switch key {
+		case "codec":
+			v.codec.expr = val
+		case "size", "max":
+			num, err := strconv.ParseUint(val, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("incorrect %s '%s' in the custom tag of %s", key, val, name)
+			}
+			if key == "size" {
+				size = num
+			} else {
+				max = num
+			}
+		default:
+			return nil, fmt.Errorf("unknown option '%s' in the custom tag of %s", key, name)
+		}
+	}
+	if v.codec.expr == "" {
+		return nil, fmt.Errorf("custom tag of %s does not have a codec", name)
+	}
+	if err := v.setCustomSize(name, size, max); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (v *Value) setCustomSize(name string, size, max uint64) error {
+	if (size == 0) == (max == 0) {
+		return fmt.Errorf("custom codec of %s requires either a size or a max size", name)
+	}
+	if size != 0 {
+		v.fixed = true
+		v.s = size
+	} else {
+		v.s = max
+		v.m = max
+	}
+	return nil
+}
+
+// customSizes returns the size and max arguments of the ssz custom functions
+func (v *Value) customSizes() string {
+	if v.fixed {
+		return fmt.Sprintf("%d, 0", v.s)
+	}
+	return fmt.Sprintf("ssz.VariableSize, %d", v.m)
+}
+
+func (v *Value) marshalCustom() string {
+	return fmt.Sprintf("if dst, err = ssz.MarshalCustom(%s, ::.%s, dst, %s); err != nil {\nreturn\n}", v.codec.expr, v.name, v.customSizes())
+}
+
+func (v *Value) unmarshalCustom(dst string) string {
+	return fmt.Sprintf("if err = ssz.UnmarshalCustom(%s, &::.%s, %s, %s); err != nil {\nreturn err\n}", v.codec.expr, v.name, dst, v.customSizes())
+}
+
+func (v *Value) sizeCustom(name string) string {
+	return fmt.Sprintf("%s += ssz.SizeCustom(%s, ::.%s)", name, v.codec.expr, v.name)
+}
+
+func (v *Value) hashTreeRootCustom(name string) string {
+	return fmt.Sprintf("if err = ssz.HashCustom(%s, %s, hh); err != nil {\nreturn\n}", v.codec.expr, name)
+}
+
+func (v *Value) schemaCustom() string {
+	size := "ssz.VariableSize"
+	if v.fixed {
+		size = strconv.Itoa(int(v.s))
+	}
+	return fmt.Sprintf("ssz.CustomSchema(%s, %s)", v.codec.expr, size)
+}
+
+func (v *Value) fromTreeCustom(node string) string {
+	return fmt.Sprintf("if err := ssz.DecodeTreeCustom(%s, %s, &::.%s); err != nil {\nreturn err\n}", v.codec.expr, node, v.name)
+}
+
+// customImports returns the packages of the codecs used by the objects, either
+// the names of the imports of the source files or full imports with their path
+func customImports(objs []*Value) (names []string, imports []string) {
+	for _, obj := range objs {
+		for _, f := range obj.o {
+			if f.t != TypeCustom {
+				continue
+			}
+			alias := f.codec.alias()
+			if alias == "" {
+				continue
+			}
+			if f.codec.path == "" {
+				names = appendWithoutRepeated(names, []string{alias})
+				continue
+			}
+			imp := &astImport{path: f.codec.path}
+			if !imp.match(alias) {
+				imp.alias = alias
+			}
+			imports = appendWithoutRepeated(imports, []string{imp.getFullName()})
+		}
+	}
+	return
+}
@@ -0,0 +1,38 @@
+package generator
+
+import (
+	"testing"
+)
+
+func TestParseCustomTag(t *testing.T) {
+	v, err := parseCustomTag("A", "custom,codec=pkg.Codec,size=32")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v.isFixed() || v.fixedSize() != 32 || v.codec.expr != "pkg.Codec" || v.codec.alias() != "pkg" {
+		t.Fatalf("unexpected fixed value %+v", v)
+	}
+
+	v, err = parseCustomTag("A", "custom,codec=Codec,max=16")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.isFixed() || v.m != 16 || v.codec.alias() != "" {
+		t.Fatalf("unexpected variable value %+v", v)
+	}
+
+	invalid := []string{
+		"custom",
+		"custom,size=32",
+		"custom,codec=Codec",
+		"custom,codec=Codec,size=32,max=32",
+		"custom,codec=Codec,size=a",
+		"custom,codec=Codec,foo=1",
+		"custom,codec",
+	}
+	for _, tag := range invalid {
+		if _, err := parseCustomTag("A", tag); err == nil {
+			t.Fatalf("expected an error for tag '%s'", tag)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// fromTree creates a function that decodes the struct from the nodes of its tree.
+// The layout of the tree is the one built by HashTreeRootWith.
+func (e *env) fromTree(name string, v *Value) string {
+	tmpl := `// FromTree decodes the {{.name}} object from its tree
+	func (:: *{{.name}}) FromTree(n *ssz.Node) error {
+		{{.fromTree}}
+		return nil
+	}`
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":     name,
+		"fromTree": v.fromTreeContainer(true, "n"),
+	})
+	return appendObjSignature(str, v)
+}
+
+// fromTree returns the code that decodes the value from the node stored in the
+// variable 'node'. Basic values and bytes are rebuilt as ssz bytes in 'buf' and
+// decoded with the same code used by UnmarshalSSZ.
+func (v *Value) fromTree(node string) string {
+	switch v.t {
+	case TypeContainer, TypeReference:
+		return v.fromTreeContainer(false, node)
+
+	case TypeUint, TypeBool, TypeTime:
+		return v.fromTreeBytes(fmt.Sprintf("ssz.TreeBytes(%s, %d)", node, v.fixedSize()))
+
+	case TypeBytes:
+		if v.isFixed() {
+			return v.fromTreeBytes(fmt.Sprintf("ssz.TreeBytes(%s, %d)", node, v.fixedSize()))
+		}
+		return v.fromTreeBytes(fmt.Sprintf("ssz.TreeListPacked(%s, %d, 1)", node, v.m))
+
+	case TypeBitList:
+		return v.fromTreeBytes(fmt.Sprintf("ssz.TreeBitlist(%s, %d)", node, v.m))
+
+	case TypeCustom:
+		return v.fromTreeCustom(node)
+
+	case TypeVector:
+		switch v.e.t {
+		case TypeUint:
+			return v.fromTreeBytes(fmt.Sprintf("ssz.TreePacked(%s, %d)", node, v.s*v.e.fixedSize()))
+		case TypeBytes:
+			if v.e.isFixed() {
+				return v.fromTreeBytes(fmt.Sprintf("ssz.TreeVectorBytes(%s, %d, %d)", node, v.s, v.e.s))
+			}
+		case TypeContainer, TypeReference:
+			return v.fromTreeVector(node)
+		}
+
+	case TypeList:
+		switch v.e.t {
+		case TypeUint:
+			return v.fromTreeBytes(fmt.Sprintf("ssz.TreeListPacked(%s, %d, %d)", node, v.s, v.e.fixedSize()))
+		case TypeBytes:
+			if v.e.isFixed() {
+				return v.fromTreeBytes(fmt.Sprintf("ssz.TreeListBytes(%s, %d, %d)", node, v.s, v.e.s))
+			}
+			// list of byte lists
+			return v.fromTreeList(node, fmt.Sprintf("buf, err := ssz.TreeListPacked(elem, %d, 1)\nif err != nil {\nreturn err\n}\n%s", v.e.m, "{{.unmarshal}}"))
+		case TypeContainer, TypeReference:
+			return v.fromTreeList(node, "{{.unmarshal}}")
+		}
+	}
+	panic(fmt.Errorf("from tree not implemented for type %s", v.t.String()))
+}
+
+// fromTreeBytes decodes the value from the ssz bytes returned by the expression 'call'
+func (v *Value) fromTreeBytes(call string) string {
+	tmpl := `buf, err := {{.call}}
+	if err != nil {
+		return err
+	}
+	{{.unmarshal}}`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"call":      call,
+		"unmarshal": v.unmarshal("buf"),
+	})
+}
+
+// fromTreeList decodes a list of composite values. Each element is decoded from
+// the node 'elem' with the code in 'elemTmpl'.
+func (v *Value) fromTreeList(node string, elemTmpl string) string {
+	name := v.e.name
+	v.e.name = v.name + "[indx]"
+	defer func() {
+		v.e.name = name
+	}()
+
+	var unmarshal string
+	if v.e.t == TypeBytes {
+		unmarshal = v.e.unmarshal("buf")
+	} else {
+		unmarshal = v.e.fromTree("elem")
+	}
+
+	tmpl := `elems, err := ssz.TreeList({{.node}}, {{.max}})
+	if err != nil {
+		return err
+	}
+	num := len(elems)
+	{{.create}}
+	for indx, elem := range elems {
+		` + strings.Replace(elemTmpl, "{{.unmarshal}}", unmarshal, 1) + `
+	}`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"node":   node,
+		"max":    v.s,
+		"create": v.createSlice(true),
+	})
+}
+
+// fromTreeVector decodes a vector of containers, whose elements are
+// the leaves of the tree like the fields of a container.
+func (v *Value) fromTreeVector(node string) string {
+	name := v.e.name
+	v.e.name = v.name + "[indx]"
+	defer func() {
+		v.e.name = name
+	}()
+
+	tmpl := `elems, err := ssz.TreeContainerFields({{.node}}, {{.size}})
+	if err != nil {
+		return err
+	}
+	{{.create}}
+	for indx, elem := range elems {
+		{{.fromTree}}
+	}`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"node":     node,
+		"size":     v.s,
+		"create":   v.createSlice(false),
+		"fromTree": v.e.fromTree("elem"),
+	})
+}
+
+func (v *Value) fromTreeContainer(start bool, node string) string {
+	if !start {
+		if v.param {
+			tmpl := `ssz.Alloc(&::.{{.name}})
+			if err := ssz.DecodeTree({{.node}}, ::.{{.name}}); err != nil {
+				return err
+			}`
+			return execTmpl(tmpl, map[string]interface{}{
+				"name": v.name,
+				"node": node,
+			})
+		}
+		if v.t == TypeReference {
+			// the type may not be generated by sszgen, decode it
+			// only if it implements the ssz.TreeDecoder interface
+			tmpl := `{{ if .check }}if ::.{{.name}} == nil {
+				::.{{.name}} = new({{ref .obj}})
+			}
+			{{ end }}if err := ssz.DecodeTree({{.node}}, {{ if .noPtr }}&{{ end }}::.{{.name}}); err != nil {
+				return err
+			}`
+			return execTmpl(tmpl, map[string]interface{}{
+				"name":  v.name,
+				"obj":   v,
+				"node":  node,
+				"check": !v.noPtr,
+				"noPtr": v.noPtr,
+			})
+		}
+
+		tmpl := `{{ if .check }}if ::.{{.name}} == nil {
+			::.{{.name}} = new({{ref .obj}})
+		}
+		{{ end }}if err := ::.{{.name}}.FromTree({{.node}}); err != nil {
+			return err
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":  v.name,
+			"obj":   v,
+			"node":  node,
+			"check": !v.noPtr,
+		})
+	}
+
+	if len(v.o) == 0 {
+		// empty container, nothing to decode
+		return ""
+	}
+
+	tmpl := `nodes, err := ssz.TreeContainerFields({{.node}}, {{.num}})
+	if err != nil {
+		return err
+	}
+	`
+	str := execTmpl(tmpl, map[string]interface{}{
+		"node": node,
+		"num":  len(v.o),
+	})
+
+	out := []string{}
+	for indx, i := range v.o {
+		res := fmt.Sprintf("// Field (%d) '%s'\n{\n%s\n}\n", indx, i.name, i.fromTree(fmt.Sprintf("nodes[%d]", indx)))
+		out = append(out, res)
+	}
+	str += strings.Join(out, "\n")
+	return str
+}
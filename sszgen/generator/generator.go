@@ -25,23 +25,102 @@ import (
 const bytesPerLengthOffset = 4
 
 // The SSZ code generation works in three steps:
-// 1. Parse the Go input with the go/parser library to generate an AST representation.
+// 1. Load the Go input packages with go/packages to get the AST representation and the
+// type information to resolve the references to other packages.
 // 2. Convert the AST into an Internal Representation (IR) to describe the structs and fields
 // using the Value object.
 // 3. Use the IR to print the encoding functions
 
-func Encode(source string, targets []string, output string, includePaths []string, excludeTypeNames map[string]bool, suffix string) error {
-	files, err := parseInput(source) // 1.
+func Encode(source string, targets []string, output string, includePaths []string, excludeTypeNames map[string]bool, suffix string, tests *TestOptions, parseFiles bool) error {
+	r := &request{
+		source:           source,
+		targets:          targets,
+		output:           output,
+		includePaths:     includePaths,
+		excludeTypeNames: excludeTypeNames,
+		suffix:           suffix,
+		tests:            tests,
+		parseFiles:       parseFiles,
+	}
+	return r.encode()
+}
+
+// request describes the files to generate from a source path
+type request struct {
+	source           string
+	targets          []string
+	output           string
+	includePaths     []string
+	excludeTypeNames map[string]bool
+	suffix           string
+	tests            *TestOptions
+	// methods are the optional methods generated for all the types and
+	// typeMethods the ones of specific types, nil for the default ones
+	methods     []string
+	typeMethods map[string][]string
+	// codecs are the custom codecs of the field types by type name
+	codecs map[string]*CodecOptions
+	// cache are the packages shared with other requests (if any)
+	cache *packageCache
+	// parseFiles parses the source files without type information
+	// if the package cannot be loaded (i.e. it is not part of a module)
+	parseFiles bool
+}
+
+func (r *request) encode() error {
+	out, err := r.generate()
 	if err != nil {
 		return err
 	}
+	for name, output := range out {
+		if err := ioutil.WriteFile(name, output, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	// parse all the include paths as well
+// generate returns the formatted content of the generated files by their name
+func (r *request) generate() (map[string][]byte, error) {
+	source, output := r.source, r.output
+	l := newLoader(r.cache)
+
+	// the other files of the package and the referenced packages are included
+	// to resolve the references but their structs are not generated
 	include := map[string]*ast.File{}
-	for _, i := range includePaths {
+
+	files, others, pkgPath, err := l.loadSource(source) // 1.
+	if err != nil {
+		if !r.parseFiles {
+			return nil, fmt.Errorf("failed to load package %s (use --parse-files to parse the files without type information): %v", source, err)
+		}
+		// fallback to parse the files without type information (i.e. the source
+		// is not part of a module)
+		log.Printf("INFO: Failed to load package, parsing files only: %v", err)
+		if files, err = parseInput(source); err != nil {
+			return nil, err
+		}
+	} else {
+		for k, v := range others {
+			include[k] = v
+		}
+		refs, err := l.loadReferences()
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range refs {
+			include[k] = v
+		}
+	}
+
+	// parse all the include paths as well, unless they were already loaded
+	for _, i := range r.includePaths {
+		if l.isLoaded(i) {
+			continue
+		}
 		files, err := parseInput(i)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		for k, v := range files {
 			include[k] = v
@@ -60,13 +139,19 @@ func Encode(source string, targets []string, output string, includePaths []strin
 		files:            files,
 		objs:             map[string]*Value{},
 		packName:         packName,
-		targets:          targets,
-		excludeTypeNames: excludeTypeNames,
-		suffix:           suffix,
+		pkgPath:          pkgPath,
+		loader:           l,
+		targets:          r.targets,
+		excludeTypeNames: r.excludeTypeNames,
+		suffix:           r.suffix,
+		tests:            r.tests,
+		methods:          r.methods,
+		typeMethods:      r.typeMethods,
+		codecs:           r.codecs,
 	}
 
 	if err := e.generateIR(); err != nil { // 2.
-		return err
+		return nil, err
 	}
 
 	// 3.
@@ -85,18 +170,15 @@ func Encode(source string, targets []string, output string, includePaths []strin
 		panic("No files to generate")
 	}
 
+	res := map[string][]byte{}
 	for name, str := range out {
-		output := []byte(str)
-
-		output, err = format.Source(output)
+		output, err := format.Source([]byte(str))
 		if err != nil {
-			return err
-		}
-		if err := ioutil.WriteFile(name, output, 0o644); err != nil {
-			return err
+			return nil, err
 		}
+		res[name] = output
 	}
-	return nil
+	return res, nil
 }
 
 func isDir(path string) (bool, error) {
@@ -143,19 +225,24 @@ func parseInput(source string) (map[string]*ast.File, error) {
 		}
 
 		// check if its a ssz generated code
-		if comments := f.Comments; len(comments) > 0 {
-			if strings.HasPrefix(comments[0].Text(), "Code generated by fastssz. DO NOT EDIT.") {
-				log.Printf("INFO: Skipped ssz generated object: %v", name)
+		if isGeneratedFile(f) {
+			log.Printf("INFO: Skipped ssz generated object: %v", name)
 
-				delete(files, name)
-				continue
-			}
+			delete(files, name)
+			continue
 		}
 	}
 
 	return files, nil
 }
 
+func isGeneratedFile(f *ast.File) bool {
+	if comments := f.Comments; len(comments) > 0 {
+		return strings.HasPrefix(comments[0].Text(), "Code generated by fastssz. DO NOT EDIT.")
+	}
+	return false
+}
+
 // Value is a type that represents a Go field or struct and his
 // correspondent SSZ type.
 type Value struct {
@@ -182,6 +269,18 @@ type Value struct {
 	noPtr bool
 	// isFixed allows us to explicitly mark fixed at parse time
 	fixed bool
+	// tag is the name of the field in the json tag
+	tag string
+	// param is set if the value is a field of a type parameter
+	param bool
+	// typeParams are the type parameters of a generic container
+	typeParams []*typeParam
+	// codec is the custom codec of the value
+	codec *customCodec
+	// bits is the number of bits of a bitvector that is not a multiple of 8
+	bits uint64
+	// env is the environment that imports the package of an external reference
+	env *env
 }
 
 func (v *Value) isListElem() bool {
@@ -225,7 +324,9 @@ func (v *Value) objRef() string {
 	if v.ref == "" {
 		return v.obj
 	}
-	valuesImported = append(valuesImported, v)
+	if v.env != nil {
+		v.env.imported = append(v.env.imported, v)
+	}
 	return v.ref + "." + v.obj
 }
 
@@ -266,6 +367,8 @@ const (
 	TypeReference
 	// TypeTime is a timestamp
 	TypeTime
+	// TypeCustom is a value encoded with a custom codec
+	TypeCustom
 )
 
 func (t Type) String() string {
@@ -290,6 +393,8 @@ func (t Type) String() string {
 		return "reference"
 	case TypeTime:
 		return "time.Time"
+	case TypeCustom:
+		return "custom"
 	default:
 		panic("not found")
 	}
@@ -303,6 +408,10 @@ type env struct {
 	files map[string]*ast.File
 	// name of the package
 	packName string
+	// path of the package, empty if it was parsed without type information
+	pkgPath string
+	// loader of the packages with their type information
+	loader *loader
 	// array of structs with their Go AST format
 	raw []*astStruct
 	// map of structs with their IR format
@@ -319,6 +428,16 @@ type env struct {
 	results []*astResult
 	// suffix is the suffix to append to codec files.
 	suffix string
+	// tests are the options of the generated tests, nil if they are not generated
+	tests *TestOptions
+	// methods are the optional methods of all the types and typeMethods the ones
+	// of specific types, nil for the default ones
+	methods     []string
+	typeMethods map[string][]string
+	// codecs are the custom codecs of the field types
+	codecs map[string]*CodecOptions
+	// imported are the external references printed in the current file
+	imported []*Value
 }
 
 func (e *env) generateOutputEncodings(output string) (map[string]string, error) {
@@ -342,6 +461,14 @@ func (e *env) generateOutputEncodings(output string) (map[string]string, error)
 		return nil, nil
 	}
 	out[output] = res
+
+	res, ok, err = e.printTests(orders)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		out[testsFileName(output)] = res
+	}
 	return out, nil
 }
 
@@ -349,6 +476,14 @@ func (e *env) generateEncodings() (map[string]string, error) {
 	outs := map[string]string{}
 
 	for name, order := range e.order {
+		tests, ok, err := e.printTests(order)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			outs[testsFileName(name)] = tests
+		}
+
 		// remove .go prefix and replace if with our own
 		ext := filepath.Ext(name)
 		name = strings.TrimSuffix(name, ext)
@@ -387,9 +522,10 @@ func (e *env) hashSource() (string, error) {
 	return hex.EncodeToString(hash[:]), nil
 }
 
-var valuesImported []*Value
-
 func (e *env) print(order []string) (string, bool, error) {
+	// the external references are collected while printing the objects
+	e.imported = nil
+
 	hash, err := e.hashSource()
 	if err != nil {
 		return "", false, fmt.Errorf("failed to hash files: %v", err)
@@ -412,6 +548,8 @@ func (e *env) print(order []string) (string, bool, error) {
 		{{ .Size }}
 		{{ .HashTreeRoot }}
 		{{ .GetTree }}
+		{{ .Schema }}
+		{{ .FromTree }}
 	{{ end }}
 	`
 
@@ -422,10 +560,11 @@ func (e *env) print(order []string) (string, bool, error) {
 	}
 
 	type Obj struct {
-		Size, Marshal, Unmarshal, HashTreeRoot, GetTree string
+		Size, Marshal, Unmarshal, HashTreeRoot, GetTree, Schema, FromTree string
 	}
 
 	objs := []*Obj{}
+	printed := []*Value{}
 
 	// Print the objects in the order in which they appear on the file.
 	for _, name := range order {
@@ -445,13 +584,31 @@ func (e *env) print(order []string) (string, bool, error) {
 			continue
 		}
 
-		objs = append(objs, &Obj{
-			HashTreeRoot: e.hashTreeRoot(name, obj),
-			GetTree:      e.getTree(name, obj),
-			Marshal:      e.marshal(name, obj),
-			Unmarshal:    e.unmarshal(name, obj),
-			Size:         e.size(name, obj),
-		})
+		// the methods of generic objects are only generated if the type
+		// parameters are constrained by the required interfaces. The order
+		// matters since the templates of the methods share the values.
+		o := &Obj{}
+		if obj.paramsImplement(hashRootMethods...) {
+			o.HashTreeRoot = e.hashTreeRoot(name, obj)
+			o.GetTree = e.getTree(name, obj)
+		}
+		if obj.paramsImplement(marshalerMethods...) {
+			o.Marshal = e.marshal(name, obj)
+		}
+		if obj.paramsImplement(unmarshalerMethods...) {
+			o.Unmarshal = e.unmarshal(name, obj)
+		}
+		if obj.paramsImplement(marshalerMethods...) {
+			o.Size = e.size(name, obj)
+		}
+		if e.hasMethod(name, MethodSchema) {
+			o.Schema = e.schema(name, obj)
+		}
+		if e.hasMethod(name, MethodFromTree) {
+			o.FromTree = e.fromTree(name, obj)
+		}
+		objs = append(objs, o)
+		printed = append(printed, obj)
 	}
 	if len(objs) == 0 {
 		// No valid objects found for this file
@@ -460,15 +617,18 @@ func (e *env) print(order []string) (string, bool, error) {
 	data["objs"] = objs
 
 	imports := []string{}
-	for _, v := range valuesImported {
+	for _, v := range e.imported {
 		imports = appendWithoutRepeated(imports, []string{detectImports(v)})
 	}
+	codecNames, codecImports := customImports(printed)
+	imports = appendWithoutRepeated(imports, codecNames)
 
 	// insert any required imports
 	importsStr, err := e.buildImports(imports)
 	if err != nil {
 		return "", false, err
 	}
+	importsStr = appendWithoutRepeated(importsStr, codecImports)
 	if len(importsStr) != 0 {
 		data["imports"] = importsStr
 	}
@@ -505,6 +665,10 @@ func (e *env) findImport(name string) string {
 // This function replaces the '::' string with a valid one that corresponds
 // to the first letter of the method in lower case.
 func appendObjSignature(str string, v *Value) string {
+	if len(v.typeParams) != 0 {
+		// the receiver of a generic type includes its type parameters
+		str = strings.Replace(str, "(:: *"+v.name+")", "(:: *"+v.name+"["+v.typeParamNames()+"])", -1)
+	}
 	signatures := map[string]string{
 		// pointer receiver
 		"::": strings.ToLower(string(v.name[0])),
@@ -521,16 +685,27 @@ type astStruct struct {
 	name       string
 	obj        *ast.StructType
 	packName   string
+	pkgPath    string
 	typ        ast.Expr
 	implFunc   bool
 	isRef      bool
 	paramTypes map[string]int
+	typeParams []*ast.Ident
 }
 
 func (a *astStruct) isAlias() bool {
 	return a.typ != nil
 }
 
+// pkgKey identifies the package of the struct, it is the name of the package
+// if the struct was parsed without type information
+func (a *astStruct) pkgKey() string {
+	if a.pkgPath != "" {
+		return a.pkgPath
+	}
+	return a.packName
+}
+
 type aliasRef struct {
 	name  string
 	value uint64
@@ -541,9 +716,10 @@ type astResult struct {
 	funcs    []string
 	alias    []*aliasRef
 	packName string
+	pkgPath  string
 }
 
-func decodeASTStruct(file *ast.File) *astResult {
+func decodeASTStruct(file *ast.File, pkgPath string) *astResult {
 	packName := file.Name.String()
 
 	res := &astResult{
@@ -551,6 +727,7 @@ func decodeASTStruct(file *ast.File) *astResult {
 		funcs:    []string{},
 		alias:    []*aliasRef{},
 		packName: packName,
+		pkgPath:  pkgPath,
 	}
 
 	funcRefs := map[string]int{}
@@ -561,6 +738,7 @@ func decodeASTStruct(file *ast.File) *astResult {
 					obj := &astStruct{
 						name:     typeSpec.Name.Name,
 						packName: packName,
+						pkgPath:  pkgPath,
 					}
 					structType, ok := typeSpec.Type.(*ast.StructType)
 					if ok {
@@ -570,8 +748,11 @@ func decodeASTStruct(file *ast.File) *astResult {
 						// process the type params if there is any
 						if typeSpec.TypeParams != nil && len(typeSpec.TypeParams.List) > 0 {
 							obj.paramTypes = map[string]int{}
-							for i, typ := range typeSpec.TypeParams.List {
-								obj.paramTypes[typ.Names[0].Name] = i
+							for _, typ := range typeSpec.TypeParams.List {
+								for _, name := range typ.Names {
+									obj.paramTypes[name.Name] = len(obj.typeParams)
+									obj.typeParams = append(obj.typeParams, name)
+								}
 							}
 						}
 					} else {
@@ -724,15 +905,50 @@ func decodeASTImports(file *ast.File) []*astImport {
 	return imports
 }
 
-func (e *env) getRawItemByName(name string) (*astStruct, bool) {
+// getRawItem returns the struct declared in the package with the given path. If the
+// path or the package of the struct are unknown, the struct is matched by its name.
+func (e *env) getRawItem(pkgPath, name string) (*astStruct, bool) {
 	for _, item := range e.raw {
-		if item.name == name {
+		if item.name != name {
+			continue
+		}
+		if pkgPath == "" || item.pkgPath == "" || item.pkgPath == pkgPath {
 			return item, true
 		}
 	}
 	return nil, false
 }
 
+// isLocal returns true if the struct is declared in the package being generated
+func (e *env) isLocal(raw *astStruct) bool {
+	if raw.pkgPath != "" && e.pkgPath != "" {
+		return raw.pkgPath == e.pkgPath
+	}
+	return raw.packName == e.packName
+}
+
+// importName returns the name used to reference the package of an external struct
+// and adds its import if the source files do not import it (i.e. dot imports).
+func (e *env) importName(raw *astStruct) string {
+	if raw.pkgPath == "" {
+		return raw.packName
+	}
+	for _, i := range e.imports {
+		if i.path == raw.pkgPath && i.alias != "." {
+			if i.alias != "" {
+				return i.alias
+			}
+			return raw.packName
+		}
+	}
+	imp := &astImport{path: raw.pkgPath}
+	if filepath.Base(raw.pkgPath) != raw.packName {
+		imp.alias = raw.packName
+	}
+	e.imports = append(e.imports, imp)
+	return raw.packName
+}
+
 func (e *env) addRawItem(i *astStruct) {
 	e.raw = append(e.raw, i)
 }
@@ -742,9 +958,9 @@ func (e *env) generateIR() error {
 	e.order = map[string][]string{}
 	e.imports = []*astImport{}
 
-	checkObjByPackage := func(packName, name string) (*astStruct, bool) {
+	checkObjByPackage := func(pkgKey, name string) (*astStruct, bool) {
 		for _, item := range e.raw {
-			if item.name == name && item.packName == packName {
+			if item.name == name && item.pkgKey() == pkgKey {
 				return item, true
 			}
 		}
@@ -755,7 +971,7 @@ func (e *env) generateIR() error {
 	// among the source and include paths.
 	addStructs := func(res *astResult, isRef bool) error {
 		for _, i := range res.objs {
-			if _, ok := checkObjByPackage(i.packName, i.name); ok {
+			if _, ok := checkObjByPackage(i.pkgKey(), i.name); ok {
 				return fmt.Errorf("two structs share the same name %s", i.name)
 			}
 			i.isRef = isRef
@@ -767,7 +983,11 @@ func (e *env) generateIR() error {
 	checkImplFunc := func(res *astResult) error {
 		// include all the functions that implement the interfaces
 		for _, name := range res.funcs {
-			v, ok := checkObjByPackage(res.packName, name)
+			pkgKey := res.pkgPath
+			if pkgKey == "" {
+				pkgKey = res.packName
+			}
+			v, ok := checkObjByPackage(pkgKey, name)
 			if !ok {
 				return fmt.Errorf("cannot find %s struct", name)
 			}
@@ -808,7 +1028,7 @@ func (e *env) generateIR() error {
 
 	// decode the structs from the input path
 	for name, file := range e.files {
-		res := decodeASTStruct(file)
+		res := decodeASTStruct(file, e.loader.pkgPath(file))
 		if err := addStructs(res, false); err != nil {
 			return err
 		}
@@ -828,7 +1048,7 @@ func (e *env) generateIR() error {
 	// If the structs are in raw they can be used as a reference at compilation time and since they are
 	// not in 'order' they cannot be used to marshal/unmarshal encodings
 	for _, file := range e.include {
-		res := decodeASTStruct(file)
+		res := decodeASTStruct(file, e.loader.pkgPath(file))
 		if err := addStructs(res, true); err != nil {
 			return err
 		}
@@ -862,7 +1082,7 @@ func (e *env) generateIR() error {
 				// do not process imported elements
 				continue
 			}
-			if _, err := e.encodeItem(name, ""); err != nil {
+			if _, err := e.encodeItem(obj.pkgPath, name, ""); err != nil {
 				return err
 			}
 		}
@@ -890,19 +1110,27 @@ func (e *env) resolveAlias(name string) (uint64, bool) {
 	return 0, false
 }
 
-func (e *env) encodeItem(name, tags string) (*Value, error) {
-	v, ok := e.objs[name]
+// encodeItem returns the value of the struct with the given name declared in the
+// package with the given path, or in any package if the path is empty.
+func (e *env) encodeItem(pkgPath, name, tags string) (*Value, error) {
+	raw, ok := e.getRawItem(pkgPath, name)
+	if !ok {
+		return nil, fmt.Errorf("could not find struct with name '%s'", name)
+	}
+	// the structs of other packages are cached by their full name
+	key := name
+	if !e.isLocal(raw) {
+		key = raw.pkgKey() + "." + name
+	}
+
+	v, ok := e.objs[key]
 	if !ok {
 		var err error
-		raw, ok := e.getRawItemByName(name)
-		if !ok {
-			return nil, fmt.Errorf("could not find struct with name '%s'", name)
-		}
 		if raw.implFunc {
 			size, _ := getTagsInt(tags, "ssz-size")
 			v = &Value{t: TypeReference, s: size, noPtr: raw.obj == nil}
 		} else if raw.obj != nil {
-			v, err = e.parseASTStructType(name)
+			v, err = e.parseASTStructType(raw.pkgPath, name)
 		} else {
 			v, err = e.parseASTFieldType(name, tags, raw.typ)
 		}
@@ -911,22 +1139,40 @@ func (e *env) encodeItem(name, tags string) (*Value, error) {
 		}
 		v.name = name
 		v.obj = name
-		v.ref = e.packName
-		if raw.packName == e.packName {
-			v.ref = ""
+		v.ref = ""
+		if !e.isLocal(raw) {
+			v.ref = e.importName(raw)
+			v.env = e
 		}
 
 		if !raw.isAlias() {
 			// alias objects have to be recreated every time and cannot be reused
 			// since they only define the type
-			e.objs[name] = v
+			e.objs[key] = v
 		}
 	}
 	return v.copy(), nil
 }
 
+// parseASTStructField parses the type of a struct field, which can be encoded with
+// a custom codec or be a bitvector besides the types parsed by parseASTFieldType
+func (e *env) parseASTStructField(name, tags string, expr ast.Expr) (*Value, error) {
+	if v, ok, err := e.customField(name, tags, expr); err != nil || ok {
+		return v, err
+	}
+	if v, ok, err := e.bitvectorField(name, tags, expr); err != nil || ok {
+		return v, err
+	}
+	v, err := e.parseASTFieldType(name, tags, expr)
+	if err != nil || v == nil {
+		return v, err
+	}
+	setBitvectorBits(v, tags, expr)
+	return v, nil
+}
+
 // parse the Go AST struct
-func (e *env) parseASTStructType(name string) (*Value, error) {
+func (e *env) parseASTStructType(pkgPath, name string) (*Value, error) {
 	v := &Value{
 		name: name,
 		t:    TypeContainer,
@@ -935,8 +1181,8 @@ func (e *env) parseASTStructType(name string) (*Value, error) {
 
 	visited := map[string]struct{}{}
 
-	var getFields func(subName string, genericTypes []string) ([]*ast.Field, error)
-	getFields = func(subName string, genericTypes []string) ([]*ast.Field, error) {
+	var getFields func(pkgPath, subName string, genericTypes []string) ([]*ast.Field, error)
+	getFields = func(pkgPath, subName string, genericTypes []string) ([]*ast.Field, error) {
 		if _, ok := visited[subName]; ok {
 			return nil, fmt.Errorf("loop in embed types %s", subName)
 		}
@@ -944,7 +1190,7 @@ func (e *env) parseASTStructType(name string) (*Value, error) {
 
 		var fields []*ast.Field
 
-		item, ok := e.getRawItemByName(subName)
+		item, ok := e.getRawItem(pkgPath, subName)
 		if !ok {
 			return nil, fmt.Errorf("struct %s not found", subName)
 		}
@@ -959,9 +1205,13 @@ func (e *env) parseASTStructType(name string) (*Value, error) {
 					// skip protobuf methods
 					continue
 				}
-				if itype, ok := f.Type.(*ast.Ident); ok {
+				if itype, ok := f.Type.(*ast.Ident); ok && genericTypes != nil {
 					if genericIndex, ok := item.paramTypes[itype.Name]; ok {
-						f.Type.(*ast.Ident).Name = genericTypes[genericIndex]
+						// substitute the type argument in a copy of the field since
+						// the same declaration can be used with other arguments
+						field := *f
+						field.Type = &ast.Ident{NamePos: itype.NamePos, Name: genericTypes[genericIndex]}
+						f = &field
 					}
 				}
 
@@ -970,7 +1220,7 @@ func (e *env) parseASTStructType(name string) (*Value, error) {
 				switch tp := f.Type.(type) {
 				case *ast.Ident:
 					// embed item in the same package, resolve it recursively
-					subFields, err := getFields(tp.Name, nil)
+					subFields, err := getFields(e.loader.lookup(tp), tp.Name, nil)
 					if err != nil {
 						return nil, err
 					}
@@ -981,7 +1231,8 @@ func (e *env) parseASTStructType(name string) (*Value, error) {
 					if !ok {
 						return nil, fmt.Errorf("embed type expects a typed object but %s found", reflect.TypeOf(tp.Index))
 					}
-					subFields, err := getFields(tp.X.(*ast.Ident).Name, []string{genericType.Name})
+					ident := tp.X.(*ast.Ident)
+					subFields, err := getFields(e.loader.lookup(ident), ident.Name, []string{genericType.Name})
 					if err != nil {
 						return nil, err
 					}
@@ -996,7 +1247,8 @@ func (e *env) parseASTStructType(name string) (*Value, error) {
 						}
 						typeList = append(typeList, genericType.Name)
 					}
-					subFields, err := getFields(tp.X.(*ast.Ident).Name, typeList)
+					ident := tp.X.(*ast.Ident)
+					subFields, err := getFields(e.loader.lookup(ident), ident.Name, typeList)
 					if err != nil {
 						return nil, err
 					}
@@ -1009,7 +1261,16 @@ func (e *env) parseASTStructType(name string) (*Value, error) {
 		return fields, nil
 	}
 
-	fields, err := getFields(name, nil)
+	if raw, ok := e.getRawItem(pkgPath, name); ok && len(raw.typeParams) != 0 {
+		// generic container
+		params, err := e.parseTypeParams(raw)
+		if err != nil {
+			return nil, err
+		}
+		v.typeParams = params
+	}
+
+	fields, err := getFields(pkgPath, name, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1021,14 +1282,21 @@ func (e *env) parseASTStructType(name string) (*Value, error) {
 			tags = f.Tag.Value
 		}
 
-		elem, err := e.parseASTFieldType(fieldName, tags, f.Type)
-		if err != nil {
+		elem, ok := v.typeParamField(f.Type)
+		if ok {
+			if tag, ok := getTags(tags, "ssz"); ok && tag == "-" {
+				continue
+			}
+		} else if elem, err = e.parseASTStructField(fieldName, tags, f.Type); err != nil {
 			return nil, err
 		}
 		if elem == nil {
 			continue
 		}
 		elem.name = fieldName
+		if json, ok := getTags(tags, "json"); ok {
+			elem.tag = strings.Split(json, ",")[0]
+		}
 		v.o = append(v.o, elem)
 	}
 
@@ -1048,17 +1316,18 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 		switch elem := obj.X.(type) {
 		case *ast.Ident:
 			// reference to a local package
-			return e.encodeItem(elem.Name, tags)
+			return e.encodeItem(e.loader.lookup(elem), elem.Name, tags)
 
 		case *ast.SelectorExpr:
 			// reference of the external package
 			ref := elem.X.(*ast.Ident).Name
 			// reference to a struct from another package
-			v, err := e.encodeItem(elem.Sel.Name, tags)
+			v, err := e.encodeItem(e.loader.lookup(elem.Sel), elem.Sel.Name, tags)
 			if err != nil {
 				return nil, err
 			}
 			v.ref = ref
+			v.env = e
 			return v, nil
 
 		default:
@@ -1073,7 +1342,10 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 		// so when a `[]byte` expression is parsed, Len will be nil:
 		var astSize *uint64
 		// if .Len is nil, this is a slice, not a fixed length array
-		if obj.Len != nil {
+		if num, ok := e.loader.constValue(obj.Len); ok {
+			// the length is a constant expression resolved with the type information
+			astSize = &num
+		} else if obj.Len != nil {
 			switch obj := obj.Len.(type) {
 			case *ast.BasicLit:
 				// fixed array with explicit len
@@ -1115,6 +1387,10 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 			}
 			outer.e = element
 		}
+		if astSize != nil && outer.t == TypeUndefined {
+			// arrays of other types than bytes are vectors
+			outer.t = TypeVector
+		}
 
 		if tags == "" {
 			// the type was part of a nested array/slice (i.e. []byte of [][]byte).
@@ -1182,7 +1458,7 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 			v = &Value{t: TypeBool, s: 1}
 		default:
 			// try to resolve as an alias
-			vv, err := e.encodeItem(obj.Name, tags)
+			vv, err := e.encodeItem(e.loader.lookup(obj), obj.Name, tags)
 			if err != nil {
 				return nil, fmt.Errorf("failed to encode %s: %v", obj.Name, err)
 			}
@@ -1220,11 +1496,12 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 			return &Value{t: TypeBytes, fixed: true, s: uint64(tailDim.VectorLen())}, nil
 		}
 		// external reference
-		vv, err := e.encodeItem(sel, tags)
+		vv, err := e.encodeItem(e.loader.lookup(obj.Sel), sel, tags)
 		if err != nil {
 			return nil, fmt.Errorf("failed to encode %s: %v", sel, err)
 		}
 		vv.ref = exprName
+		vv.env = e
 		vv.noPtr = true
 		return vv, nil
 
@@ -1317,6 +1594,8 @@ func (v *Value) isFixed() bool {
 		return false
 	case TypeTime:
 		return true
+	case TypeCustom:
+		return v.fixed
 	default:
 		// TypeUndefined should be the only type to fallthrough to this case
 		// TypeUndefined always means there is a fatal error in the parsing logic
@@ -0,0 +1,220 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"strconv"
+	"strings"
+)
+
+// The fields of a generic container whose type is a type parameter are encoded with the
+// methods of the ssz interfaces that constrain the type parameter. Since the type argument
+// may have a fixed or a variable size, the layout of the container is computed at runtime
+// with the ssz.FieldSize, ssz.FixedPartSize and ssz.SplitContainer functions.
+
+var (
+	marshalerMethods   = []string{"MarshalSSZTo", "MarshalSSZ", "SizeSSZ"}
+	unmarshalerMethods = []string{"UnmarshalSSZ"}
+	hashRootMethods    = []string{"GetTree", "HashTreeRoot", "HashTreeRootWith"}
+)
+
+// typeParam is a type parameter of a generic container
+type typeParam struct {
+	name string
+	// methods of the constraint, nil if the package was parsed without type information
+	methods map[string]bool
+}
+
+func (t *typeParam) implements(methods ...string) bool {
+	if t.methods == nil {
+		return true
+	}
+	for _, method := range methods {
+		if !t.methods[method] {
+			return false
+		}
+	}
+	return true
+}
+
+// parseTypeParams returns the type parameters of a generic struct
+func (e *env) parseTypeParams(raw *astStruct) ([]*typeParam, error) {
+	params := []*typeParam{}
+	for _, ident := range raw.typeParams {
+		param := &typeParam{
+			name:    ident.Name,
+			methods: e.loader.constraintMethods(ident),
+		}
+		if !param.implements(marshalerMethods...) {
+			return nil, fmt.Errorf("type parameter %s of %s is not constrained by ssz.Marshaler", param.name, raw.name)
+		}
+		params = append(params, param)
+	}
+	return params, nil
+}
+
+// typeParamField returns the value of a field whose type is a type parameter
+func (v *Value) typeParamField(expr ast.Expr) (*Value, bool) {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return nil, false
+	}
+	for _, param := range v.typeParams {
+		if param.name == ident.Name {
+			return &Value{t: TypeReference, obj: param.name, noPtr: true, param: true}, true
+		}
+	}
+	return nil, false
+}
+
+// paramsImplement returns true if all the type parameters implement the methods
+func (v *Value) paramsImplement(methods ...string) bool {
+	for _, param := range v.typeParams {
+		if !param.implements(methods...) {
+			return false
+		}
+	}
+	return true
+}
+
+// typeParamNames returns the list of type parameters used in the method receivers
+func (v *Value) typeParamNames() string {
+	names := []string{}
+	for _, param := range v.typeParams {
+		names = append(names, param.name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// hasParamFields returns true if the layout of the container depends on the type arguments
+func (v *Value) hasParamFields() bool {
+	for _, f := range v.o {
+		if f.param {
+			return true
+		}
+	}
+	return false
+}
+
+// allocParams allocates the fields of a type parameter if they are nil pointers
+func (v *Value) allocParams() string {
+	out := []string{}
+	for _, f := range v.o {
+		if f.param {
+			out = append(out, fmt.Sprintf("ssz.Alloc(&::.%s)", f.name))
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// fieldSizes returns the list of sizes of the fields in the fixed part of the container
+func (v *Value) fieldSizes() string {
+	sizes := []string{}
+	for _, f := range v.o {
+		switch {
+		case f.param:
+			sizes = append(sizes, fmt.Sprintf("ssz.FieldSize(::.%s)", f.name))
+		case f.isFixed():
+			sizes = append(sizes, strconv.Itoa(int(f.fixedSize())))
+		default:
+			sizes = append(sizes, "ssz.VariableSize")
+		}
+	}
+	return strings.Join(sizes, ", ")
+}
+
+// staticFixedSize returns the size of the fixed part of the container without the
+// fields of a type parameter
+func (v *Value) staticFixedSize() uint64 {
+	size := v.fixedSize()
+	for _, f := range v.o {
+		if f.param {
+			size -= bytesPerLengthOffset
+		}
+	}
+	return size
+}
+
+func (v *Value) marshalGeneric() string {
+	out := []string{}
+	for indx, i := range v.o {
+		var str string
+		switch {
+		case i.param:
+			tmpl := `// Field ({{.indx}}) '{{.name}}'
+			if sizes[{{.indx}}] != ssz.VariableSize {
+				{{.marshal}}
+			} else {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += ssz.OrZero(::.{{.name}}).SizeSSZ()
+			}
+			`
+			str = execTmpl(tmpl, map[string]interface{}{
+				"indx":    indx,
+				"name":    i.name,
+				"marshal": i.marshal(),
+			})
+		case i.isFixed():
+			str = fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshal())
+		default:
+			str = fmt.Sprintf("// Offset (%d) '%s'\ndst = ssz.WriteOffset(dst, offset)\n%s\n", indx, i.name, i.size("offset"))
+		}
+		out = append(out, str)
+	}
+
+	// write the dynamic parts
+	for indx, i := range v.o {
+		switch {
+		case i.param:
+			out = append(out, fmt.Sprintf("// Field (%d) '%s'\nif sizes[%d] == ssz.VariableSize {\n%s\n}\n", indx, i.name, indx, i.marshal()))
+		case !i.isFixed():
+			out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshal()))
+		}
+	}
+
+	tmpl := `sizes := []int{ {{.sizes}} }
+	offset := ssz.FixedPartSize(sizes...)
+
+	{{.fields}}`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"sizes":  v.fieldSizes(),
+		"fields": strings.Join(out, "\n"),
+	})
+}
+
+func (v *Value) unmarshalGeneric() string {
+	out := []string{}
+	for indx, i := range v.o {
+		tmpl := `// Field ({{.indx}}) '{{.name}}'
+		{
+			buf = bufs[{{.indx}}]
+			{{.unmarshal}}
+		}`
+		out = append(out, execTmpl(tmpl, map[string]interface{}{
+			"indx":      indx,
+			"name":      i.name,
+			"unmarshal": i.unmarshal("buf"),
+		}))
+	}
+
+	tmpl := `{{.alloc}}
+	bufs, err := ssz.SplitContainer(buf, {{.sizes}})
+	if err != nil {
+		return err
+	}
+
+	{{.fields}}`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"alloc":  v.allocParams(),
+		"sizes":  v.fieldSizes(),
+		"fields": strings.Join(out, "\n\n"),
+	})
+}
+
+// sizeParam returns the size of a field of a type parameter, which includes
+// the offset if the type argument has a variable size
+func (v *Value) sizeParam(name string) string {
+	return fmt.Sprintf("%s += ssz.ParamSize(::.%s)", name, v.name)
+}
@@ -58,6 +58,12 @@ func (v *Value) hashRoots(isList bool, elem Type) string {
 		// []uint64
 		appendFn = "Append" + uintVToName(v.e)
 		elemSize = uint64(v.e.fixedSize())
+		if v.e.s == 2 {
+			// HashWalker does not have a method for uint16, the values
+			// are appended as bytes and packed the same way
+			appendFn = "Append"
+			subName = "ssz.MarshalUint16(nil, i)"
+		}
 	}
 
 	var merkleize string
@@ -88,6 +94,10 @@ func (v *Value) hashRoots(isList bool, elem Type) string {
 		}
 	} else {
 		merkleize = "hh.Merkleize(subIndx)"
+		// the vectors of basic types are packed in chunks too
+		if elem == TypeUint {
+			merkleize = "hh.FillUpTo32()\n" + merkleize
+		}
 	}
 
 	tmpl := `{
@@ -183,6 +193,22 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 		return fmt.Sprintf("hh.PutBool(%s)", name)
 
 	case TypeVector:
+		if v.e.t == TypeContainer || v.e.t == TypeReference {
+			// vector of containers, merkleize the roots without the length
+			tmpl := `{
+				{{.validate}}subIndx := hh.Index()
+				for _, elem := range {{.name}} {
+					if err = elem.HashTreeRootWith(hh); err != nil {
+						return
+					}
+				}
+				hh.Merkleize(subIndx)
+			}`
+			return execTmpl(tmpl, map[string]interface{}{
+				"validate": v.validate(),
+				"name":     name,
+			})
+		}
 		return v.hashRoots(false, v.e.t)
 
 	case TypeList:
@@ -224,6 +250,9 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 	case TypeTime:
 		return fmt.Sprintf("hh.PutUint64(uint64(%s.Unix()))", name)
 
+	case TypeCustom:
+		return v.hashTreeRootCustom(name)
+
 	default:
 		panic(fmt.Errorf("hash not implemented for type %s", v.t.String()))
 	}
@@ -234,7 +263,7 @@ func (v *Value) hashTreeRootContainer(start bool) string {
 		tmpl := `{{ if .check }}if ::.{{.name}} == nil {
 			::.{{.name}} = new({{ref .obj}})
 		}
-		{{ end }}if err = ::.{{.name}}.HashTreeRootWith(hh); err != nil {
+		{{ end }}if err = {{ if .param }}ssz.OrZero(::.{{.name}}){{ else }}::.{{.name}}{{ end }}.HashTreeRootWith(hh); err != nil {
 			return
 		}`
 		// validate only for fixed structs
@@ -249,6 +278,7 @@ func (v *Value) hashTreeRootContainer(start bool) string {
 			"name":  v.name,
 			"obj":   v,
 			"check": check,
+			"param": v.param,
 		})
 	}
 
@@ -0,0 +1,345 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const loadMode = packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes |
+	packages.NeedTypesInfo | packages.NeedImports | packages.NeedDeps | packages.NeedModule
+
+// packageCache keeps the packages loaded with go/packages by directory so that the
+// generations of multiple packages in the same process share the type information.
+type packageCache struct {
+	// dirs are the loaded source packages by absolute directory
+	dirs map[string]*packages.Package
+	// pkgs are the loaded packages and all their dependencies by path
+	pkgs map[string]*packages.Package
+}
+
+func newPackageCache() *packageCache {
+	return &packageCache{
+		dirs: map[string]*packages.Package{},
+		pkgs: map[string]*packages.Package{},
+	}
+}
+
+// load loads the packages in the directories that are not loaded yet with
+// a single call to go/packages, so that the dependencies are checked once.
+func (c *packageCache) load(dirs ...string) error {
+	patterns := []string{}
+	for _, dir := range dirs {
+		abs, err := filepath.Abs(dir)
+		if err != nil {
+			return err
+		}
+		if _, ok := c.dirs[abs]; !ok {
+			patterns = append(patterns, abs)
+		}
+	}
+	if len(patterns) == 0 {
+		return nil
+	}
+
+	cfg := &packages.Config{
+		Mode: loadMode,
+		Dir:  patterns[0],
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return err
+	}
+	packages.Visit(pkgs, nil, func(p *packages.Package) {
+		c.pkgs[p.PkgPath] = p
+	})
+	for _, pkg := range pkgs {
+		if len(pkg.GoFiles) != 0 {
+			c.dirs[filepath.Dir(pkg.GoFiles[0])] = pkg
+		}
+	}
+	return nil
+}
+
+// get returns the package in the directory, loading it if required
+func (c *packageCache) get(dir string) (*packages.Package, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.load(abs); err != nil {
+		return nil, err
+	}
+	pkg, ok := c.dirs[abs]
+	if !ok {
+		return nil, fmt.Errorf("no package found in %s", dir)
+	}
+	return pkg, nil
+}
+
+// loader loads the input package and its dependencies with go/packages and keeps
+// the type information of the included files to resolve the identifiers used in
+// the declarations. The dependencies are type checked from source so that the
+// loader does not depend on the export data format of the Go toolchain.
+type loader struct {
+	// cache are the loaded packages, which can be shared with other loaders
+	cache *packageCache
+	// pkgs are the source package and all its dependencies by path
+	pkgs map[string]*packages.Package
+	// included is the set of packages whose files are included
+	included map[string]bool
+	// filePkgs is the package path of each included file
+	filePkgs map[*ast.File]string
+	// loaded is the set of absolute paths of the included files
+	loaded map[string]bool
+	// uses are the objects referenced by the identifiers of the included files
+	uses map[*ast.Ident]types.Object
+	// defs are the objects defined by the identifiers of the included files
+	defs map[*ast.Ident]types.Object
+	// values are the types and values of the expressions of the included files
+	values map[ast.Expr]types.TypeAndValue
+}
+
+func newLoader(cache *packageCache) *loader {
+	if cache == nil {
+		cache = newPackageCache()
+	}
+	return &loader{
+		cache:    cache,
+		pkgs:     cache.pkgs,
+		included: map[string]bool{},
+		filePkgs: map[*ast.File]string{},
+		loaded:   map[string]bool{},
+		uses:     map[*ast.Ident]types.Object{},
+		defs:     map[*ast.Ident]types.Object{},
+		values:   map[ast.Expr]types.TypeAndValue{},
+	}
+}
+
+// loadSource loads the package of the source path, which is either a directory
+// or a single file. It returns the files to generate and the other files of the
+// package, which can only be used as references.
+func (l *loader) loadSource(source string) (map[string]*ast.File, map[string]*ast.File, string, error) {
+	ok, err := isDir(source)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	dir := source
+	if !ok {
+		dir = filepath.Dir(source)
+	}
+	absSource, err := filepath.Abs(source)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	pkg, err := l.cache.get(dir)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if err := checkPackage(pkg); err != nil {
+		return nil, nil, "", err
+	}
+
+	files := map[string]*ast.File{}
+	others := map[string]*ast.File{}
+	for name, file := range l.addFiles(pkg) {
+		if ok {
+			// keep the same name as if the directory was parsed
+			files[filepath.Join(source, filepath.Base(name))] = file
+		} else if name == absSource {
+			files[source] = file
+		} else {
+			others[name] = file
+		}
+	}
+	if len(files) == 0 {
+		return nil, nil, "", fmt.Errorf("no files to generate found in %s", source)
+	}
+	return files, others, pkg.PkgPath, nil
+}
+
+// checkPackage returns an error if the package could not be listed or parsed. The type
+// errors are expected if the generated files are outdated and they are ignored.
+func checkPackage(pkg *packages.Package) error {
+	for _, err := range pkg.Errors {
+		if err.Kind != packages.TypeError {
+			return fmt.Errorf("failed to load package %s: %v", pkg.PkgPath, err)
+		}
+	}
+	if pkg.TypesInfo == nil {
+		return fmt.Errorf("failed to load type information of package %s", pkg.PkgPath)
+	}
+	return nil
+}
+
+// loadReferences includes the files of all the packages referenced by the type and
+// constant declarations of the included packages, except the standard library.
+func (l *loader) loadReferences() (map[string]*ast.File, error) {
+	files := map[string]*ast.File{}
+	for {
+		paths := l.references()
+		if len(paths) == 0 {
+			return files, nil
+		}
+		for _, path := range paths {
+			pkg := l.pkgs[path]
+			if err := checkPackage(pkg); err != nil {
+				return nil, err
+			}
+			for name, file := range l.addFiles(pkg) {
+				files[name] = file
+			}
+		}
+	}
+}
+
+func (l *loader) references() []string {
+	found := map[string]struct{}{}
+	paths := []string{}
+
+	for path := range l.included {
+		pkg := l.pkgs[path]
+		for _, file := range pkg.Syntax {
+			for _, decl := range file.Decls {
+				genDecl, ok := decl.(*ast.GenDecl)
+				if !ok || (genDecl.Tok != token.TYPE && genDecl.Tok != token.CONST) {
+					continue
+				}
+				ast.Inspect(genDecl, func(n ast.Node) bool {
+					ident, ok := n.(*ast.Ident)
+					if !ok {
+						return true
+					}
+					obj := pkg.TypesInfo.Uses[ident]
+					if obj == nil || obj.Pkg() == nil {
+						return true
+					}
+					if _, ok := obj.(*types.TypeName); !ok {
+						if _, ok := obj.(*types.Const); !ok {
+							return true
+						}
+					}
+					path := obj.Pkg().Path()
+					if _, ok := found[path]; ok || l.included[path] {
+						return true
+					}
+					if ref, ok := l.pkgs[path]; !ok || isStdLib(ref) {
+						return true
+					}
+					found[path] = struct{}{}
+					paths = append(paths, path)
+					return true
+				})
+			}
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// isStdLib returns true if the package belongs to the standard library
+func isStdLib(pkg *packages.Package) bool {
+	return pkg.Module == nil && !strings.Contains(strings.Split(pkg.PkgPath, "/")[0], ".")
+}
+
+// addFiles includes the package and returns its files by their absolute path
+// without the files generated by fastssz
+func (l *loader) addFiles(pkg *packages.Package) map[string]*ast.File {
+	l.included[pkg.PkgPath] = true
+	for ident, obj := range pkg.TypesInfo.Uses {
+		l.uses[ident] = obj
+	}
+	for ident, obj := range pkg.TypesInfo.Defs {
+		l.defs[ident] = obj
+	}
+	for expr, tv := range pkg.TypesInfo.Types {
+		l.values[expr] = tv
+	}
+
+	files := map[string]*ast.File{}
+	for _, file := range pkg.Syntax {
+		name := pkg.Fset.File(file.Pos()).Name()
+		if isGeneratedFile(file) {
+			continue
+		}
+		l.filePkgs[file] = pkg.PkgPath
+		l.loaded[name] = true
+		files[name] = file
+	}
+	return files
+}
+
+// isLoaded returns true if the include path is a file or a package directory
+// that is already included
+func (l *loader) isLoaded(path string) bool {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	if l.loaded[abs] {
+		return true
+	}
+	for name := range l.loaded {
+		if filepath.Dir(name) == abs {
+			return true
+		}
+	}
+	return false
+}
+
+// pkgPath returns the package path of a loaded file or an empty string
+// if the file was parsed without type information
+func (l *loader) pkgPath(file *ast.File) string {
+	return l.filePkgs[file]
+}
+
+// lookup returns the path of the package that declares the type or constant referenced
+// by the identifier. It returns an empty string if the identifier cannot be resolved
+// with the type information, in which case it has to be resolved by its name.
+func (l *loader) lookup(ident *ast.Ident) string {
+	obj, ok := l.uses[ident]
+	if !ok || obj.Pkg() == nil || obj.Name() != ident.Name {
+		return ""
+	}
+	if _, ok := obj.Type().(*types.TypeParam); ok {
+		return ""
+	}
+	return obj.Pkg().Path()
+}
+
+// constValue returns the value of a constant integer expression
+func (l *loader) constValue(expr ast.Expr) (uint64, bool) {
+	tv, ok := l.values[expr]
+	if !ok || tv.Value == nil {
+		return 0, false
+	}
+	return constant.Uint64Val(constant.ToInt(tv.Value))
+}
+
+// constraintMethods returns the methods of the constraint of the type parameter declared
+// by the identifier. It returns nil if there is no type information for the identifier.
+func (l *loader) constraintMethods(ident *ast.Ident) map[string]bool {
+	obj, ok := l.defs[ident]
+	if !ok || obj == nil {
+		return nil
+	}
+	param, ok := obj.Type().(*types.TypeParam)
+	if !ok {
+		return nil
+	}
+	methods := map[string]bool{}
+	if iface, ok := param.Constraint().Underlying().(*types.Interface); ok {
+		for i := 0; i < iface.NumMethods(); i++ {
+			methods[iface.Method(i).Name()] = true
+		}
+	}
+	return methods
+}
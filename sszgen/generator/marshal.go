@@ -27,7 +27,10 @@ func (e *env) marshal(name string, v *Value) string {
 		"marshal": v.marshalContainer(true),
 		"offset":  "",
 	}
-	if !v.isFixed() {
+	if v.hasParamFields() {
+		// the layout depends on the type arguments
+		data["marshal"] = v.marshalGeneric()
+	} else if !v.isFixed() {
 		// offset is the position where the offset starts
 		data["offset"] = fmt.Sprintf("offset := int(%d)\n", v.fixedSize())
 	}
@@ -80,6 +83,9 @@ func (v *Value) marshal() string {
 	case TypeTime:
 		return fmt.Sprintf("dst = ssz.MarshalTime(dst, ::.%s)", v.name)
 
+	case TypeCustom:
+		return v.marshalCustom()
+
 	default:
 		panic(fmt.Errorf("marshal not implemented for type %s", v.t.String()))
 	}
@@ -144,7 +150,7 @@ func (v *Value) marshalContainer(start bool) string {
 		tmpl := `{{ if .check }}if ::.{{.name}} == nil {
 			::.{{.name}} = new({{ref .obj}})
 		}
-		{{ end }}if dst, err = ::.{{.name}}.MarshalSSZTo(dst); err != nil {
+		{{ end }}if dst, err = {{ if .param }}ssz.OrZero(::.{{.name}}){{ else }}::.{{.name}}{{ end }}.MarshalSSZTo(dst); err != nil {
 			return
 		}`
 		// validate only for fixed structs
@@ -159,6 +165,7 @@ func (v *Value) marshalContainer(start bool) string {
 			"name":  v.name,
 			"obj":   v,
 			"check": check,
+			"param": v.param,
 		})
 	}
 
@@ -0,0 +1,126 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// schema creates a function that returns the ssz schema of the struct and a set of
+// constants with the generalized index of each of the fields of the struct.
+func (e *env) schema(name string, v *Value) string {
+	tmpl := `// SSZSchema returns the ssz schema of the {{.name}} object
+	func (:: *{{.name}}) SSZSchema() *ssz.Schema {
+		return {{.schema}}
+	}
+	{{if .gindices}}
+	// Generalized indices of the fields of the {{.name}} object
+	const (
+		{{.gindices}}
+	)
+	{{end}}`
+
+	var schema string
+	if v.t == TypeContainer {
+		schema = v.schemaContainer()
+	} else {
+		schema = v.schemaExpr()
+	}
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":     name,
+		"schema":   schema,
+		"gindices": v.fieldsGIndices(name),
+	})
+	return appendObjSignature(str, v)
+}
+
+func (v *Value) fieldsGIndices(name string) string {
+	if v.t != TypeContainer {
+		return ""
+	}
+	depth := getDepth(uint64(len(v.o)))
+
+	// the type and the field names are separated since their concatenation
+	// is not unique (i.e. the field BC of A and the field C of AB)
+	out := []string{}
+	for indx, f := range v.o {
+		out = append(out, fmt.Sprintf("%s_%sGIndex = %d", name, f.name, 1<<depth+indx))
+	}
+	return strings.Join(out, "\n")
+}
+
+func (v *Value) schemaContainer() string {
+	out := []string{}
+	for _, f := range v.o {
+		out = append(out, fmt.Sprintf("{Name: \"%s\", Tag: \"%s\", Schema: %s},", f.name, f.tag, f.schemaExpr()))
+	}
+
+	tmpl := `&ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{{.fields}}
+		},
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"fields": strings.Join(out, "\n"),
+	})
+}
+
+// schemaExpr returns the expression that builds the ssz schema of the value
+func (v *Value) schemaExpr() string {
+	switch v.t {
+	case TypeUint:
+		return fmt.Sprintf("ssz.UintSchema(%d)", v.s)
+
+	case TypeBool:
+		return "ssz.BoolSchema()"
+
+	case TypeTime:
+		return "ssz.UintSchema(8)"
+
+	case TypeBytes:
+		if v.isFixed() {
+			return fmt.Sprintf("ssz.BytesSchema(%d)", v.s)
+		}
+		return fmt.Sprintf("ssz.ByteListSchema(%d)", v.m)
+
+	case TypeBitList:
+		return fmt.Sprintf("ssz.BitlistSchema(%d)", v.m)
+
+	case TypeVector:
+		return fmt.Sprintf("ssz.VectorSchema(%s, %d)", v.e.schemaExpr(), v.s)
+
+	case TypeList:
+		return fmt.Sprintf("ssz.ListSchema(%s, %d)", v.e.schemaExpr(), v.m)
+
+	case TypeCustom:
+		return v.schemaCustom()
+
+	case TypeContainer, TypeReference:
+		if v.param {
+			return fmt.Sprintf("ssz.SchemaOfType[%s]()", v.obj)
+		}
+		// the schema is resolved at runtime from the object if it implements
+		// the ssz.SchemaProvider interface.
+		if v.t == TypeReference && v.s != 0 {
+			// the types implemented by hand keep the size of the ssz-size tag
+			return fmt.Sprintf("ssz.SchemaOfSize(new(%s), %d)", v.objRef(), v.s)
+		}
+		return fmt.Sprintf("ssz.SchemaOf(new(%s))", v.objRef())
+
+	default:
+		panic(fmt.Errorf("schema not implemented for type %s", v.t.String()))
+	}
+}
+
+// getDepth returns the depth of a merkle tree with the given number of leaves
+func getDepth(d uint64) uint8 {
+	if d <= 1 {
+		return 0
+	}
+	var depth uint8
+	for n := d - 1; n > 0; n >>= 1 {
+		depth++
+	}
+	return depth
+}
@@ -25,7 +25,7 @@ func (e *env) size(name string, v *Value) string {
 
 	str := execTmpl(tmpl, map[string]interface{}{
 		"name":           name,
-		"fixed":          v.fixedSize(),
+		"fixed":          v.staticFixedSize(),
 		"dynamic":        v.sizeContainer("size", true),
 		"fieldsMaxSizes": v.fieldsMaxSizes(name),
 	})
@@ -35,7 +35,7 @@ func (e *env) size(name string, v *Value) string {
 func (v *Value) fieldsMaxSizes(name string) string {
 	out := []string{}
 	for _, v := range v.o {
-		if !v.isFixed() {
+		if !v.isFixed() && !v.param {
 			out = append(out, fmt.Sprintf("const %sMax%sSize = %d", name, v.name, v.s))
 		}
 	}
@@ -117,6 +117,9 @@ func (v *Value) size(name string) string {
 
 	switch v.t {
 	case TypeContainer, TypeReference:
+		if v.param {
+			return v.sizeParam(name)
+		}
 		return v.sizeContainer(name, false)
 
 	case TypeBitList:
@@ -125,6 +128,9 @@ func (v *Value) size(name string) string {
 	case TypeBytes:
 		return fmt.Sprintf(name+" += len(::.%s)", v.name)
 
+	case TypeCustom:
+		return v.sizeCustom(name)
+
 	case TypeList:
 		fallthrough
 
@@ -0,0 +1,89 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/NilFoundation/fastssz/sszgen/version"
+)
+
+// TestOptions are the options of the tests generated with the encodings
+type TestOptions struct {
+	// Fixtures is the path of the consensus spec tests (i.e. 'eth2.0-spec-tests/tests')
+	// used to seed the fuzz tests, relative to the package directory. It is optional.
+	Fixtures string
+}
+
+// testsFileName returns the name of the tests file of a source or output file
+func testsFileName(name string) string {
+	return strings.TrimSuffix(name, filepath.Ext(name)) + "_ssz_test.go"
+}
+
+// printTests prints a round trip test, a differential test and a fuzz test for
+// each of the objects with the tests method. Generic objects are not tested since
+// they cannot be instantiated.
+func (e *env) printTests(order []string) (string, bool, error) {
+	hash, err := e.hashSource()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to hash files: %v", err)
+	}
+
+	tmpl := `// Code generated by fastssz. DO NOT EDIT.
+	// Hash: {{.hash}}
+	// Version: {{.version}}
+	package {{.package}}
+
+	import (
+		"testing"
+
+		"github.com/NilFoundation/fastssz/fuzz"
+		"github.com/NilFoundation/fastssz/ssztest"
+	)
+
+	{{ range .objs }}
+	func Test{{.}}RoundTrip(t *testing.T) {
+		ssztest.RoundTrip(t, func() ssztest.Object { return new({{.}}) })
+	}
+
+	func Test{{.}}Differential(t *testing.T) {
+		ssztest.DifferentialFuzz(t, func() ssztest.Object { return new({{.}}) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+	}
+
+	func Fuzz{{.}}(f *testing.F) {
+		ssztest.Fuzz(f, func() ssztest.Object { return new({{.}}) }, {{$.fixtures}})
+	}
+	{{ end }}
+	`
+
+	objs := []string{}
+	for _, name := range order {
+		if e.excludeTypeNames[name] {
+			continue
+		}
+		obj, ok := e.objs[name]
+		if !ok || len(obj.typeParams) != 0 || !e.hasMethod(name, MethodTests) {
+			continue
+		}
+		if obj.isFixed() && isBasicType(obj) {
+			continue
+		}
+		objs = append(objs, name)
+	}
+	if len(objs) == 0 {
+		return "", false, nil
+	}
+
+	var fixtures string
+	if e.tests != nil {
+		fixtures = e.tests.Fixtures
+	}
+	data := map[string]interface{}{
+		"package":  e.packName,
+		"hash":     hash,
+		"version":  version.Version,
+		"objs":     objs,
+		"fixtures": fmt.Sprintf("%q", fixtures),
+	}
+	return execTmpl(tmpl, data), true, nil
+}
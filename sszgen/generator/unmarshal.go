@@ -15,9 +15,16 @@ func (e *env) unmarshal(name string, v *Value) string {
 		return err
 	}`
 
+	var unmarshal string
+	if v.hasParamFields() {
+		// the layout depends on the type arguments
+		unmarshal = v.unmarshalGeneric()
+	} else {
+		unmarshal = v.umarshalContainer(true, "buf")
+	}
 	str := execTmpl(tmpl, map[string]interface{}{
 		"name":      name,
-		"unmarshal": v.umarshalContainer(true, "buf"),
+		"unmarshal": unmarshal,
 	})
 
 	return appendObjSignature(str, v)
@@ -30,13 +37,16 @@ func (v *Value) unmarshal(dst string) string {
 		return v.umarshalContainer(false, dst)
 
 	case TypeBytes:
+		validate := ""
+		if v.bits != 0 {
+			validate = fmt.Sprintf("if err = ssz.ValidateBitvector(%s, %d); err != nil {\nreturn err\n}\n", dst, v.bits)
+		}
 		if v.c {
-			return fmt.Sprintf("copy(::.%s[:], %s)", v.name, dst)
+			return fmt.Sprintf("%scopy(::.%s[:], %s)", validate, v.name, dst)
 		}
-		validate := ""
 		if !v.isFixed() {
 			// dynamic bytes, we need to validate the size of the buffer
-			validate = fmt.Sprintf("if len(%s) > %d { return ssz.ErrBytesLength }\n", dst, v.m)
+			validate += fmt.Sprintf("if len(%s) > %d { return ssz.ErrBytesLength }\n", dst, v.m)
 		}
 
 		// both fixed and dynamic are decoded equally
@@ -98,11 +108,14 @@ func (v *Value) unmarshal(dst string) string {
 		return v.unmarshalList()
 
 	case TypeBool:
-		return fmt.Sprintf("::.%s = ssz.UnmarshalBool(%s)", v.name, dst)
+		return fmt.Sprintf("if err = ssz.ValidateBool(%s); err != nil {\nreturn err\n}\n::.%s = ssz.UnmarshalBool(%s)", dst, v.name, dst)
 
 	case TypeTime:
 		return fmt.Sprintf("::.%s = ssz.UnmarshalTime(%s)", v.name, dst)
 
+	case TypeCustom:
+		return v.unmarshalCustom(dst)
+
 	default:
 		panic(fmt.Errorf("unmarshal not implemented for type %d", v.t))
 	}
@@ -129,7 +142,28 @@ func (v *Value) unmarshalList() string {
 	}
 
 	if v.t == TypeVector {
-		panic("it cannot happen")
+		// Decode a vector with a dynamic element. The number of elements is
+		// fixed, so the first offset must point to the end of the offsets.
+		tmpl := `if len(buf) < {{.offsets}} || ssz.ReadOffset(buf) != {{.offsets}} {
+			return ssz.ErrInvalidVariableOffset
+		}
+		{{.create}}
+		err = ssz.UnmarshalDynamic(buf, {{.size}}, func(indx int, buf []byte) (err error) {
+			{{.unmarshal}}
+			return nil
+		})
+		if err != nil {
+			return err
+		}`
+
+		v.e.name = v.name + "[indx]"
+
+		return execTmpl(tmpl, map[string]interface{}{
+			"offsets":   v.s * bytesPerLengthOffset,
+			"size":      v.s,
+			"create":    v.createSlice(false),
+			"unmarshal": v.e.unmarshal("buf"),
+		})
 	}
 
 	// Decode list with a dynamic element. 'ssz.DecodeDynamicLength' ensures
@@ -225,9 +259,9 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 	// Marshal the fixed part and offsets
 
 	// used for bounds checking of variable length offsets.
-	// for the first offset, use the size of the fixed-length data
-	// as the minimum boundary. subsequent offsets will replace this
-	// value with the name of the previous offset variable.
+	// the first offset must be exactly the size of the fixed-length
+	// data, otherwise the encoding is not canonical. subsequent offsets
+	// are checked against the previous offset variable.
 	firstOffsetCheck := fmt.Sprintf("%d", v.fixedSize())
 	outs := []string{}
 	for indx, i := range v.o {
@@ -274,7 +308,7 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 				return ssz.ErrOffset
 			}
 			{{ if .firstOffsetCheck }}
-			if {{.offset}} < {{.firstOffsetCheck}} {
+			if {{.offset}} != {{.firstOffsetCheck}} {
 				return ssz.ErrInvalidVariableOffset
 			}
 			{{ end }}
@@ -325,6 +359,11 @@ func (v *Value) createSlice(useNumVariable bool) string {
 		panic("BUG: create item is only intended to be used with vectors and lists")
 	}
 
+	if v.c {
+		// arrays do not need to be created
+		return ""
+	}
+
 	size := strconv.Itoa(int(v.s))
 	// when useNumVariable is specified, we assume there is a 'num' variable generated beforehand with the expected size.
 	if useNumVariable {
@@ -345,10 +384,6 @@ func (v *Value) createSlice(useNumVariable bool) string {
 		return fmt.Sprintf("::.%s = make([]%s%s, %s)", v.name, ptr, v.e.objRef(), size)
 
 	case TypeBytes:
-		if v.c {
-			return ""
-		}
-
 		// Check for a type alias.
 		ref := v.e.objRef()
 		if ref != "" {
@@ -14,6 +14,13 @@ func (v *Value) validate() string {
 			cmp = ">"
 		}
 
+		size := v.s
+		if v.t == TypeBitList {
+			// the limit of a bitlist is in bits, it takes the bytes of
+			// the bits and the length bit
+			size = v.m/8 + 1
+		}
+
 		tmpl := `if size := len(::.{{.name}}); size {{.cmp}} {{.size}} {
 			err = ssz.ErrBytesLengthFn("--.{{.name}}", size, {{.size}})
 			return
@@ -22,7 +29,7 @@ func (v *Value) validate() string {
 		return execTmpl(tmpl, map[string]interface{}{
 			"cmp":  cmp,
 			"name": v.name,
-			"size": v.s,
+			"size": size,
 		})
 
 	case TypeVector:
@@ -0,0 +1,139 @@
+package generator
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/NilFoundation/fastssz/sszgen/version"
+)
+
+// Status is the status of a generated file on disk
+type Status int
+
+const (
+	// StatusUpToDate is a file generated from the current sources and generator version
+	StatusUpToDate Status = iota
+	// StatusMissing is a file that has not been generated
+	StatusMissing
+	// StatusStale is a file generated from a different version of the sources
+	StatusStale
+	// StatusVersion is a file generated by a different version of the generator
+	StatusVersion
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusUpToDate:
+		return "up to date"
+	case StatusMissing:
+		return "missing"
+	case StatusStale:
+		return "stale"
+	case StatusVersion:
+		return "version"
+	default:
+		return fmt.Sprintf("status(%d)", int(s))
+	}
+}
+
+// FileReport is the result of the verification of a generated file
+type FileReport struct {
+	// File is the path of the generated file
+	File string
+	// Status is the status of the file
+	Status Status
+	// Hash and Version are the values in the header of the file on disk
+	Hash, Version string
+	// ExpectedHash is the hash of the current sources
+	ExpectedHash string
+}
+
+func (f *FileReport) String() string {
+	switch f.Status {
+	case StatusStale:
+		return fmt.Sprintf("%s: stale, generated from sources with hash %s but the current hash is %s", f.File, f.Hash, f.ExpectedHash)
+	case StatusVersion:
+		return fmt.Sprintf("%s: generated by version %s but the current version is %s", f.File, f.Version, version.Version)
+	default:
+		return fmt.Sprintf("%s: %s", f.File, f.Status)
+	}
+}
+
+// Verify checks that the files generated from the source are up to date. It generates the
+// files in memory with the same arguments as Encode and compares the 'Hash' and 'Version'
+// headers with the ones of the files on disk. The reports are sorted by file name.
+func Verify(source string, targets []string, output string, includePaths []string, excludeTypeNames map[string]bool, suffix string, tests *TestOptions, parseFiles bool) ([]*FileReport, error) {
+	r := &request{
+		source:           source,
+		targets:          targets,
+		output:           output,
+		includePaths:     includePaths,
+		excludeTypeNames: excludeTypeNames,
+		suffix:           suffix,
+		tests:            tests,
+		parseFiles:       parseFiles,
+	}
+	return r.verify()
+}
+
+func (r *request) verify() ([]*FileReport, error) {
+	out, err := r.generate()
+	if err != nil {
+		return nil, err
+	}
+
+	reports := []*FileReport{}
+	for name, content := range out {
+		expectedHash, _ := readHeader(content)
+		report := &FileReport{
+			File:         name,
+			ExpectedHash: expectedHash,
+		}
+		reports = append(reports, report)
+
+		current, err := os.ReadFile(name)
+		if os.IsNotExist(err) {
+			report.Status = StatusMissing
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		report.Hash, report.Version = readHeader(current)
+		switch {
+		case report.Hash != expectedHash:
+			report.Status = StatusStale
+		case report.Version != version.Version:
+			report.Status = StatusVersion
+		default:
+			report.Status = StatusUpToDate
+		}
+	}
+
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].File < reports[j].File
+	})
+	return reports, nil
+}
+
+// readHeader returns the hash and the version in the header of a generated file
+func readHeader(content []byte) (hash string, version string) {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "package ") {
+			break
+		}
+		if v := strings.TrimPrefix(line, "// Hash: "); v != line {
+			hash = strings.TrimSpace(v)
+		}
+		if v := strings.TrimPrefix(line, "// Version: "); v != line {
+			version = strings.TrimSpace(v)
+		}
+	}
+	return
+}
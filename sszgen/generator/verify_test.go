@@ -0,0 +1,59 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "obj.go")
+	encoding := filepath.Join(dir, "obj_encoding.go")
+
+	writeFile := func(name, content string) {
+		if err := os.WriteFile(name, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	verify := func(expected Status) {
+		t.Helper()
+		reports, err := Verify(source, nil, "", nil, nil, "_encoding.go", nil, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(reports) != 1 || reports[0].File != encoding {
+			t.Fatalf("unexpected reports %v", reports)
+		}
+		if reports[0].Status != expected {
+			t.Fatalf("expected status %s but found %s", expected, reports[0].Status)
+		}
+	}
+
+	writeFile(source, "package obj\n\ntype Obj struct {\n\tA uint64\n}\n")
+
+	// the source is not part of a module, it is parsed only if requested
+	if err := Encode(source, nil, "", nil, nil, "_encoding.go", nil, false); err == nil {
+		t.Fatal("expected an error loading a package outside a module")
+	}
+	verify(StatusMissing)
+
+	if err := Encode(source, nil, "", nil, nil, "_encoding.go", nil, true); err != nil {
+		t.Fatal(err)
+	}
+	verify(StatusUpToDate)
+
+	// generated by another version
+	content, err := os.ReadFile(encoding)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, version := readHeader(content)
+	writeFile(encoding, strings.Replace(string(content), "// Version: "+version, "// Version: 0.0.1", 1))
+	verify(StatusVersion)
+
+	// the sources changed
+	writeFile(source, "package obj\n\ntype Obj struct {\n\tA uint64\n\tB uint64\n}\n")
+	verify(StatusStale)
+}
@@ -20,35 +20,50 @@ func main() {
 	switch cmd {
 	case "version":
 		fmt.Println(version.Version)
+	case "verify":
+		verify(args[1:])
 	default:
 		generate()
 	}
 }
 
-func generate() {
-	var source string
-	var objsStr string
-	var output string
-	var include string
-	var excludeObjs string
-	var suffix string
-
-	flag.StringVar(&source, "path", "", "")
-	flag.StringVar(&objsStr, "objs", "", "")
-	flag.StringVar(&excludeObjs, "exclude-objs", "", "Comma-separated list of types to exclude from output")
-	flag.StringVar(&output, "output", "", "")
-	flag.StringVar(&include, "include", "", "")
-	flag.StringVar(&suffix, "suffix", "encoding", "")
+// generateFlags are the flags that describe the files to generate
+type generateFlags struct {
+	config      string
+	source      string
+	objsStr     string
+	output      string
+	include     string
+	excludeObjs string
+	suffix      string
+	tests       bool
+	fixtures    string
+	parseFiles  bool
+}
 
-	flag.Parse()
+func (g *generateFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&g.config, "config", "", "YAML or JSON configuration file with the packages to generate")
+	fs.StringVar(&g.source, "path", "", "")
+	fs.StringVar(&g.objsStr, "objs", "", "")
+	fs.StringVar(&g.excludeObjs, "exclude-objs", "", "Comma-separated list of types to exclude from output")
+	fs.StringVar(&g.output, "output", "", "")
+	fs.StringVar(&g.include, "include", "", "")
+	fs.StringVar(&g.suffix, "suffix", "encoding", "")
+	fs.BoolVar(&g.tests, "tests", false, "Generate round trip and fuzz tests in a _ssz_test.go file")
+	fs.StringVar(&g.fixtures, "tests-fixtures", "", "Path of the consensus spec tests used to seed the fuzz tests")
+	fs.BoolVar(&g.parseFiles, "parse-files", false, "Parse the source files without type information if the package cannot be loaded")
+}
 
-	targets := decodeList(objsStr)
-	includeList := decodeList(include)
-	excludeTypeNames := make(map[string]bool)
-	for _, name := range decodeList(excludeObjs) {
+// args returns the arguments of generator.Encode and generator.Verify
+func (g *generateFlags) args() (targets []string, includeList []string, excludeTypeNames map[string]bool, suffix string, tests *generator.TestOptions) {
+	targets = decodeList(g.objsStr)
+	includeList = decodeList(g.include)
+	excludeTypeNames = make(map[string]bool)
+	for _, name := range decodeList(g.excludeObjs) {
 		excludeTypeNames[name] = true
 	}
 
+	suffix = g.suffix
 	if !strings.HasPrefix(suffix, "_") {
 		suffix = fmt.Sprintf("_%s", suffix)
 	}
@@ -56,10 +71,83 @@ func generate() {
 		suffix = fmt.Sprintf("%s.go", suffix)
 	}
 
-	if err := generator.Encode(source, targets, output, includeList, excludeTypeNames, suffix); err != nil {
+	if g.tests {
+		tests = &generator.TestOptions{Fixtures: g.fixtures}
+	}
+	return
+}
+
+func (g *generateFlags) encode() error {
+	if g.config != "" {
+		config, err := generator.LoadConfig(g.config)
+		if err != nil {
+			return err
+		}
+		return generator.EncodeConfig(config)
+	}
+	targets, includeList, excludeTypeNames, suffix, tests := g.args()
+	return generator.Encode(g.source, targets, g.output, includeList, excludeTypeNames, suffix, tests, g.parseFiles)
+}
+
+func (g *generateFlags) verify() ([]*generator.FileReport, error) {
+	if g.config != "" {
+		config, err := generator.LoadConfig(g.config)
+		if err != nil {
+			return nil, err
+		}
+		return generator.VerifyConfig(config)
+	}
+	targets, includeList, excludeTypeNames, suffix, tests := g.args()
+	return generator.Verify(g.source, targets, g.output, includeList, excludeTypeNames, suffix, tests, g.parseFiles)
+}
+
+func generate() {
+	var g generateFlags
+	g.register(flag.CommandLine)
+	flag.Parse()
+
+	if err := g.encode(); err != nil {
+		fmt.Printf("[ERR]: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// verify reports the generated files that are missing, stale or generated by
+// another version of sszgen and exits with an error if there is any.
+func verify(args []string) {
+	var g generateFlags
+	var fix bool
+
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	g.register(fs)
+	fs.BoolVar(&fix, "fix", false, "Regenerate the files that are not up to date")
+	fs.Parse(args)
+
+	reports, err := g.verify()
+	if err != nil {
+		fmt.Printf("[ERR]: %v\n", err)
+		os.Exit(1)
+	}
+
+	outdated := 0
+	for _, report := range reports {
+		if report.Status != generator.StatusUpToDate {
+			fmt.Println(report)
+			outdated++
+		}
+	}
+	if outdated == 0 {
+		return
+	}
+	if !fix {
+		fmt.Printf("[ERR]: %d generated files are not up to date\n", outdated)
+		os.Exit(1)
+	}
+	if err := g.encode(); err != nil {
 		fmt.Printf("[ERR]: %v\n", err)
 		os.Exit(1)
 	}
+	fmt.Printf("%d generated files regenerated\n", outdated)
 }
 
 func decodeList(input string) []string {
@@ -46,7 +46,7 @@ func (c *Case1A) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
+	if o0 != 4 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -106,6 +106,45 @@ func (c *Case1A) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
 
+// SSZSchema returns the ssz schema of the Case1A object
+func (c *Case1A) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Foo", Tag: "", Schema: ssz.ByteListSchema(2048)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Case1A object
+const (
+	Case1A_FooGIndex = 1
+)
+
+// FromTree decodes the Case1A object from its tree
+func (c *Case1A) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Foo'
+	{
+		buf, err := ssz.TreeListPacked(nodes[0], 2048, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 2048 {
+			return ssz.ErrBytesLength
+		}
+		if cap(c.Foo) == 0 {
+			c.Foo = make([]byte, 0, len(buf))
+		}
+		c.Foo = append(c.Foo, buf...)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Case1B object
 func (c *Case1B) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -145,7 +184,7 @@ func (c *Case1B) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
+	if o0 != 4 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -204,3 +243,42 @@ func (c *Case1B) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *Case1B) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// SSZSchema returns the ssz schema of the Case1B object
+func (c *Case1B) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Bar", Tag: "", Schema: ssz.ByteListSchema(32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Case1B object
+const (
+	Case1B_BarGIndex = 1
+)
+
+// FromTree decodes the Case1B object from its tree
+func (c *Case1B) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Bar'
+	{
+		buf, err := ssz.TreeListPacked(nodes[0], 32, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(c.Bar) == 0 {
+			c.Bar = make([]byte, 0, len(buf))
+		}
+		c.Bar = append(c.Bar, buf...)
+	}
+
+	return nil
+}
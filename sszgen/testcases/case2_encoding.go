@@ -63,6 +63,39 @@ func (c *Case2A) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
 
+// SSZSchema returns the ssz schema of the Case2A object
+func (c *Case2A) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "A", Tag: "", Schema: ssz.UintSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Case2A object
+const (
+	Case2A_AGIndex = 1
+)
+
+// FromTree decodes the Case2A object from its tree
+func (c *Case2A) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'A'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		c.A = ssz.UnmarshallUint64(buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Case2B object
 func (c *Case2B) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -127,3 +160,47 @@ func (c *Case2B) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *Case2B) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// SSZSchema returns the ssz schema of the Case2B object
+func (c *Case2B) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "A", Tag: "", Schema: ssz.UintSchema(8)},
+			{Name: "B", Tag: "", Schema: ssz.UintSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Case2B object
+const (
+	Case2B_AGIndex = 2
+	Case2B_BGIndex = 3
+)
+
+// FromTree decodes the Case2B object from its tree
+func (c *Case2B) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'A'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		c.A = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'B'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 8)
+		if err != nil {
+			return err
+		}
+		c.B = ssz.UnmarshallUint64(buf)
+	}
+
+	return nil
+}
@@ -4,7 +4,7 @@ import (
 	"github.com/NilFoundation/fastssz/sszgen/testcases/other"
 )
 
-//go:generate go run ../main.go --path case3.go
+//go:generate go run ../main.go --path case3.go --tests
 
 type Case3B struct {
 }
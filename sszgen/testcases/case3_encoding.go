@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: a5b4ae1eaa349dafdbddc5fa5cb145806bd911906dcc66d64b47302b448e27b0
+// Hash: 74c9270706d1a59543cd8ef89ab238bcb62f55d5dd06e7b0c02f51b7eff0ff9a
 // Version: 0.1.3
 package testcases
 
@@ -55,6 +55,20 @@ func (c *Case3B) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
 
+// SSZSchema returns the ssz schema of the Case3B object
+func (c *Case3B) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind:   ssz.KindContainer,
+		Fields: []*ssz.Field{},
+	}
+}
+
+// FromTree decodes the Case3B object from its tree
+func (c *Case3B) FromTree(n *ssz.Node) error {
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Case3A object
 func (c *Case3A) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -179,3 +193,67 @@ func (c *Case3A) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *Case3A) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// SSZSchema returns the ssz schema of the Case3A object
+func (c *Case3A) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "A", Tag: "", Schema: ssz.SchemaOf(new(Case3B))},
+			{Name: "B", Tag: "", Schema: ssz.SchemaOf(new(Case3B))},
+			{Name: "C", Tag: "", Schema: ssz.SchemaOf(new(other.Case3B))},
+			{Name: "D", Tag: "", Schema: ssz.SchemaOf(new(other.Case3B))},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Case3A object
+const (
+	Case3A_AGIndex = 4
+	Case3A_BGIndex = 5
+	Case3A_CGIndex = 6
+	Case3A_DGIndex = 7
+)
+
+// FromTree decodes the Case3A object from its tree
+func (c *Case3A) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 4)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'A'
+	{
+		if err := c.A.FromTree(nodes[0]); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'B'
+	{
+		if c.B == nil {
+			c.B = new(Case3B)
+		}
+		if err := c.B.FromTree(nodes[1]); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'C'
+	{
+		if err := c.C.FromTree(nodes[2]); err != nil {
+			return err
+		}
+	}
+
+	// Field (3) 'D'
+	{
+		if c.D == nil {
+			c.D = new(other.Case3B)
+		}
+		if err := c.D.FromTree(nodes[3]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
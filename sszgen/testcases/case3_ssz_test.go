@@ -0,0 +1,35 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 74c9270706d1a59543cd8ef89ab238bcb62f55d5dd06e7b0c02f51b7eff0ff9a
+// Version: 0.1.3
+package testcases
+
+import (
+	"testing"
+
+	"github.com/NilFoundation/fastssz/fuzz"
+	"github.com/NilFoundation/fastssz/ssztest"
+)
+
+func TestCase3BRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(Case3B) })
+}
+
+func TestCase3BDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(Case3B) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzCase3B(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(Case3B) }, "")
+}
+
+func TestCase3ARoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(Case3A) })
+}
+
+func TestCase3ADifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(Case3A) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzCase3A(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(Case3A) }, "")
+}
@@ -132,3 +132,82 @@ func (c *Case4) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *Case4) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// SSZSchema returns the ssz schema of the Case4 object
+func (c *Case4) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "A", Tag: "", Schema: ssz.SchemaOfSize(new(other.Case4Interface), 96)},
+			{Name: "B", Tag: "", Schema: ssz.SchemaOfSize(new(other.Case4Interface), 96)},
+			{Name: "C", Tag: "", Schema: ssz.UintSchema(8)},
+			{Name: "D", Tag: "", Schema: ssz.BytesSchema(96)},
+			{Name: "E", Tag: "", Schema: ssz.BytesSchema(96)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Case4 object
+const (
+	Case4_AGIndex = 8
+	Case4_BGIndex = 9
+	Case4_CGIndex = 10
+	Case4_DGIndex = 11
+	Case4_EGIndex = 12
+)
+
+// FromTree decodes the Case4 object from its tree
+func (c *Case4) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 5)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'A'
+	{
+		if err := ssz.DecodeTree(nodes[0], &c.A); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'B'
+	{
+		if c.B == nil {
+			c.B = new(other.Case4Interface)
+		}
+		if err := ssz.DecodeTree(nodes[1], c.B); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'C'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 8)
+		if err != nil {
+			return err
+		}
+		c.C = alias.Case4Slot(ssz.UnmarshallUint64(buf))
+	}
+
+	// Field (3) 'D'
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 96)
+		if err != nil {
+			return err
+		}
+		if cap(c.D) == 0 {
+			c.D = other.Case4Bytes(make([]byte, 0, len(buf)))
+		}
+		c.D = append(c.D, buf...)
+	}
+
+	// Field (4) 'E'
+	{
+		buf, err := ssz.TreeBytes(nodes[4], 96)
+		if err != nil {
+			return err
+		}
+		copy(c.E[:], buf)
+	}
+
+	return nil
+}
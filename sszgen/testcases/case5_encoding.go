@@ -170,3 +170,76 @@ func (c *Case5A) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *Case5A) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// SSZSchema returns the ssz schema of the Case5A object
+func (c *Case5A) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "A", Tag: "", Schema: ssz.VectorSchema(ssz.BytesSchema(2), 2)},
+			{Name: "B", Tag: "", Schema: ssz.VectorSchema(ssz.BytesSchema(2), 2)},
+			{Name: "C", Tag: "", Schema: ssz.VectorSchema(ssz.BytesSchema(2), 2)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Case5A object
+const (
+	Case5A_AGIndex = 4
+	Case5A_BGIndex = 5
+	Case5A_CGIndex = 6
+)
+
+// FromTree decodes the Case5A object from its tree
+func (c *Case5A) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 3)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'A'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[0], 2, 2)
+		if err != nil {
+			return err
+		}
+		c.A = make([][]byte, 2)
+		for ii := 0; ii < 2; ii++ {
+			if cap(c.A[ii]) == 0 {
+				c.A[ii] = make([]byte, 0, len(buf[ii*2:(ii+1)*2]))
+			}
+			c.A[ii] = append(c.A[ii], buf[ii*2:(ii+1)*2]...)
+		}
+	}
+
+	// Field (1) 'B'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[1], 2, 2)
+		if err != nil {
+			return err
+		}
+		c.B = make([]Case5Bytes, 2)
+		for ii := 0; ii < 2; ii++ {
+			if cap(c.B[ii]) == 0 {
+				c.B[ii] = make([]byte, 0, len(buf[ii*2:(ii+1)*2]))
+			}
+			c.B[ii] = append(c.B[ii], buf[ii*2:(ii+1)*2]...)
+		}
+	}
+
+	// Field (2) 'C'
+	{
+		buf, err := ssz.TreeVectorBytes(nodes[2], 2, 2)
+		if err != nil {
+			return err
+		}
+		c.C = make([][]byte, 2)
+		for ii := 0; ii < 2; ii++ {
+			if cap(c.C[ii]) == 0 {
+				c.C[ii] = make([]byte, 0, len(buf[ii*2:(ii+1)*2]))
+			}
+			c.C[ii] = append(c.C[ii], buf[ii*2:(ii+1)*2]...)
+		}
+	}
+
+	return nil
+}
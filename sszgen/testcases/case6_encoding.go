@@ -62,3 +62,36 @@ func (c *Case6) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *Case6) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// SSZSchema returns the ssz schema of the Case6 object
+func (c *Case6) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "A", Tag: "", Schema: ssz.BytesSchema(32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Case6 object
+const (
+	Case6_AGIndex = 1
+)
+
+// FromTree decodes the Case6 object from its tree
+func (c *Case6) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'A'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+		copy(c.A[:], buf)
+	}
+
+	return nil
+}
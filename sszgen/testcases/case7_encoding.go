@@ -52,7 +52,7 @@ func (c *Case7) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
+	if o0 != 4 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -121,3 +121,46 @@ func (c *Case7) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *Case7) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// SSZSchema returns the ssz schema of the Case7 object
+func (c *Case7) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "BlobKzgs", Tag: "", Schema: ssz.ListSchema(ssz.BytesSchema(48), 16)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Case7 object
+const (
+	Case7_BlobKzgsGIndex = 1
+)
+
+// FromTree decodes the Case7 object from its tree
+func (c *Case7) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'BlobKzgs'
+	{
+		buf, err := ssz.TreeListBytes(nodes[0], 16, 48)
+		if err != nil {
+			return err
+		}
+		num, err := ssz.DivideInt2(len(buf), 48, 16)
+		if err != nil {
+			return err
+		}
+		c.BlobKzgs = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(c.BlobKzgs[ii]) == 0 {
+				c.BlobKzgs[ii] = make([]byte, 0, len(buf[ii*48:(ii+1)*48]))
+			}
+			c.BlobKzgs[ii] = append(c.BlobKzgs[ii], buf[ii*48:(ii+1)*48]...)
+		}
+	}
+
+	return nil
+}
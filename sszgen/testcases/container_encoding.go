@@ -70,6 +70,7 @@ func (v *Vec) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		for _, i := range v.Values {
 			hh.AppendUint64(i)
 		}
+		hh.FillUpTo32()
 		hh.Merkleize(subIndx)
 	}
 
@@ -82,6 +83,42 @@ func (v *Vec) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(v)
 }
 
+// SSZSchema returns the ssz schema of the Vec object
+func (v *Vec) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Values", Tag: "", Schema: ssz.VectorSchema(ssz.UintSchema(8), 6)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Vec object
+const (
+	Vec_ValuesGIndex = 1
+)
+
+// FromTree decodes the Vec object from its tree
+func (v *Vec) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Values'
+	{
+		buf, err := ssz.TreePacked(nodes[0], 48)
+		if err != nil {
+			return err
+		}
+		v.Values = ssz.ExtendUint64(v.Values, 6)
+		for ii := 0; ii < 6; ii++ {
+			v.Values[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Vec2 object
 func (v *Vec2) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(v)
@@ -123,7 +160,7 @@ func (v *Vec2) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
+	if o0 != 4 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -186,3 +223,43 @@ func (v *Vec2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (v *Vec2) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(v)
 }
+
+// SSZSchema returns the ssz schema of the Vec2 object
+func (v *Vec2) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Values2", Tag: "", Schema: ssz.ListSchema(ssz.UintSchema(4), 100)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Vec2 object
+const (
+	Vec2_Values2GIndex = 1
+)
+
+// FromTree decodes the Vec2 object from its tree
+func (v *Vec2) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Values2'
+	{
+		buf, err := ssz.TreeListPacked(nodes[0], 100, 4)
+		if err != nil {
+			return err
+		}
+		num, err := ssz.DivideInt2(len(buf), 4, 100)
+		if err != nil {
+			return err
+		}
+		v.Values2 = ssz.ExtendUint32(v.Values2, num)
+		for ii := 0; ii < num; ii++ {
+			v.Values2[ii] = ssz.UnmarshallUint32(buf[ii*4 : (ii+1)*4])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package testcases
+
+import (
+	"math/big"
+	"net/netip"
+)
+
+//go:generate go run ../main.go --path custom.go
+
+// Custom has fields of types that are encoded with custom codecs
+type Custom struct {
+	Balance *big.Int   `ssz:"custom,codec=BigIntCodec,size=32"`
+	Addr    netip.Addr `ssz:"custom,codec=AddrCodec,max=16"`
+	Count   uint64
+}
+
+// CustomBytes has the same layout than Custom with the encodings of the fields
+type CustomBytes struct {
+	Balance [32]byte
+	Addr    []byte `ssz-max:"16"`
+	Count   uint64
+}
@@ -0,0 +1,92 @@
+package testcases
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	ssz "github.com/NilFoundation/fastssz"
+)
+
+// BigIntCodec encodes big integers as uint256 values
+var BigIntCodec = bigIntCodec{}
+
+type bigIntCodec struct{}
+
+func (bigIntCodec) SizeSSZ(v *big.Int) int {
+	return 32
+}
+
+func (bigIntCodec) MarshalSSZTo(v *big.Int, dst []byte) ([]byte, error) {
+	var buf [32]byte
+	if v != nil {
+		if v.Sign() < 0 || v.BitLen() > 256 {
+			return nil, fmt.Errorf("big integer %s is not a uint256", v)
+		}
+		v.FillBytes(buf[:])
+	}
+	// little endian
+	for i := 0; i < 16; i++ {
+		buf[i], buf[31-i] = buf[31-i], buf[i]
+	}
+	return append(dst, buf[:]...), nil
+}
+
+func (bigIntCodec) UnmarshalSSZ(v **big.Int, buf []byte) error {
+	be := make([]byte, len(buf))
+	for i := range buf {
+		be[len(buf)-1-i] = buf[i]
+	}
+	*v = new(big.Int).SetBytes(be)
+	return nil
+}
+
+func (c bigIntCodec) HashTreeRootWith(v *big.Int, hh ssz.HashWalker) error {
+	buf, err := c.MarshalSSZTo(v, nil)
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(buf)
+	return nil
+}
+
+func (c bigIntCodec) FromTree(n *ssz.Node, v **big.Int) error {
+	buf, err := ssz.TreeBytes(n, 32)
+	if err != nil {
+		return err
+	}
+	return c.UnmarshalSSZ(v, buf)
+}
+
+// AddrCodec encodes ip addresses as byte lists of up to 16 bytes
+var AddrCodec = addrCodec{}
+
+type addrCodec struct{}
+
+func (addrCodec) SizeSSZ(v netip.Addr) int {
+	return v.BitLen() / 8
+}
+
+func (addrCodec) MarshalSSZTo(v netip.Addr, dst []byte) ([]byte, error) {
+	return v.AppendBinary(dst)
+}
+
+func (addrCodec) UnmarshalSSZ(v *netip.Addr, buf []byte) error {
+	return v.UnmarshalBinary(buf)
+}
+
+func (addrCodec) HashTreeRootWith(v netip.Addr, hh ssz.HashWalker) error {
+	indx := hh.Index()
+	buf := v.AsSlice()
+	hh.Append(buf)
+	hh.MerkleizeWithMixin(indx, uint64(len(buf)), (16+31)/32)
+	return nil
+}
+
+func (c addrCodec) FromTree(n *ssz.Node, v *netip.Addr) error {
+	buf, err := ssz.TreeListPacked(n, 16, 1)
+	if err != nil {
+		return err
+	}
+	return c.UnmarshalSSZ(v, buf)
+}
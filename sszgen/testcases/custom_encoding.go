@@ -0,0 +1,347 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: b4a4021035347169604e2543d7ab9e33da67c46b6f3702585a5defe5581ee9b6
+// Version: 0.1.3
+package testcases
+
+import (
+	ssz "github.com/NilFoundation/fastssz"
+)
+
+// MarshalSSZ ssz marshals the Custom object
+func (c *Custom) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the Custom object to a target array
+func (c *Custom) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(44)
+
+	// Field (0) 'Balance'
+	if dst, err = ssz.MarshalCustom(BigIntCodec, c.Balance, dst, 32, 0); err != nil {
+		return
+	}
+
+	// Offset (1) 'Addr'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'Count'
+	dst = ssz.MarshalUint64(dst, c.Count)
+
+	// Field (1) 'Addr'
+	if dst, err = ssz.MarshalCustom(AddrCodec, c.Addr, dst, ssz.VariableSize, 16); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Custom object
+func (c *Custom) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 44 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Balance'
+	if err = ssz.UnmarshalCustom(BigIntCodec, &c.Balance, buf[0:32], 32, 0); err != nil {
+		return err
+	}
+
+	// Offset (1) 'Addr'
+	if o1 = ssz.ReadOffset(buf[32:36]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 != 44 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (2) 'Count'
+	c.Count = ssz.UnmarshallUint64(buf[36:44])
+
+	// Field (1) 'Addr'
+	{
+		buf = tail[o1:]
+		if err = ssz.UnmarshalCustom(AddrCodec, &c.Addr, buf, ssz.VariableSize, 16); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Custom object
+func (c *Custom) SizeSSZ() (size int) {
+	size = 44
+
+	// Field (1) 'Addr'
+	size += ssz.SizeCustom(AddrCodec, c.Addr)
+
+	return
+}
+
+const CustomMaxAddrSize = 16
+
+// HashTreeRoot ssz hashes the Custom object
+func (c *Custom) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the Custom object with a hasher
+func (c *Custom) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Balance'
+	if err = ssz.HashCustom(BigIntCodec, c.Balance, hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Addr'
+	if err = ssz.HashCustom(AddrCodec, c.Addr, hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Count'
+	hh.PutUint64(c.Count)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Custom object
+func (c *Custom) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(c)
+}
+
+// SSZSchema returns the ssz schema of the Custom object
+func (c *Custom) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Balance", Tag: "", Schema: ssz.CustomSchema(BigIntCodec, 32)},
+			{Name: "Addr", Tag: "", Schema: ssz.CustomSchema(AddrCodec, ssz.VariableSize)},
+			{Name: "Count", Tag: "", Schema: ssz.UintSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Custom object
+const (
+	Custom_BalanceGIndex = 4
+	Custom_AddrGIndex    = 5
+	Custom_CountGIndex   = 6
+)
+
+// FromTree decodes the Custom object from its tree
+func (c *Custom) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 3)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Balance'
+	{
+		if err := ssz.DecodeTreeCustom(BigIntCodec, nodes[0], &c.Balance); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Addr'
+	{
+		if err := ssz.DecodeTreeCustom(AddrCodec, nodes[1], &c.Addr); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'Count'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 8)
+		if err != nil {
+			return err
+		}
+		c.Count = ssz.UnmarshallUint64(buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the CustomBytes object
+func (c *CustomBytes) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CustomBytes object to a target array
+func (c *CustomBytes) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(44)
+
+	// Field (0) 'Balance'
+	dst = append(dst, c.Balance[:]...)
+
+	// Offset (1) 'Addr'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'Count'
+	dst = ssz.MarshalUint64(dst, c.Count)
+
+	// Field (1) 'Addr'
+	if size := len(c.Addr); size > 16 {
+		err = ssz.ErrBytesLengthFn("CustomBytes.Addr", size, 16)
+		return
+	}
+	dst = append(dst, c.Addr...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CustomBytes object
+func (c *CustomBytes) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 44 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Balance'
+	copy(c.Balance[:], buf[0:32])
+
+	// Offset (1) 'Addr'
+	if o1 = ssz.ReadOffset(buf[32:36]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 != 44 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (2) 'Count'
+	c.Count = ssz.UnmarshallUint64(buf[36:44])
+
+	// Field (1) 'Addr'
+	{
+		buf = tail[o1:]
+		if len(buf) > 16 {
+			return ssz.ErrBytesLength
+		}
+		if cap(c.Addr) == 0 {
+			c.Addr = make([]byte, 0, len(buf))
+		}
+		c.Addr = append(c.Addr, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CustomBytes object
+func (c *CustomBytes) SizeSSZ() (size int) {
+	size = 44
+
+	// Field (1) 'Addr'
+	size += len(c.Addr)
+
+	return
+}
+
+const CustomBytesMaxAddrSize = 16
+
+// HashTreeRoot ssz hashes the CustomBytes object
+func (c *CustomBytes) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the CustomBytes object with a hasher
+func (c *CustomBytes) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Balance'
+	hh.PutBytes(c.Balance[:])
+
+	// Field (1) 'Addr'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(c.Addr))
+		if byteLen > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(c.Addr)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (16+31)/32)
+	}
+
+	// Field (2) 'Count'
+	hh.PutUint64(c.Count)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the CustomBytes object
+func (c *CustomBytes) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(c)
+}
+
+// SSZSchema returns the ssz schema of the CustomBytes object
+func (c *CustomBytes) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Balance", Tag: "", Schema: ssz.BytesSchema(32)},
+			{Name: "Addr", Tag: "", Schema: ssz.ByteListSchema(16)},
+			{Name: "Count", Tag: "", Schema: ssz.UintSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the CustomBytes object
+const (
+	CustomBytes_BalanceGIndex = 4
+	CustomBytes_AddrGIndex    = 5
+	CustomBytes_CountGIndex   = 6
+)
+
+// FromTree decodes the CustomBytes object from its tree
+func (c *CustomBytes) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 3)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Balance'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+		copy(c.Balance[:], buf)
+	}
+
+	// Field (1) 'Addr'
+	{
+		buf, err := ssz.TreeListPacked(nodes[1], 16, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 16 {
+			return ssz.ErrBytesLength
+		}
+		if cap(c.Addr) == 0 {
+			c.Addr = make([]byte, 0, len(buf))
+		}
+		c.Addr = append(c.Addr, buf...)
+	}
+
+	// Field (2) 'Count'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 8)
+		if err != nil {
+			return err
+		}
+		c.Count = ssz.UnmarshallUint64(buf)
+	}
+
+	return nil
+}
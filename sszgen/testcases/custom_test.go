@@ -0,0 +1,84 @@
+package testcases
+
+import (
+	"math/big"
+	"net/netip"
+	"testing"
+
+	ssz "github.com/NilFoundation/fastssz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomCodec(t *testing.T) {
+	balance, _ := new(big.Int).SetString("0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20", 16)
+
+	cases := []netip.Addr{
+		{},
+		netip.MustParseAddr("10.0.0.1"),
+		netip.MustParseAddr("2001:db8::1"),
+	}
+	for _, addr := range cases {
+		c := &Custom{Balance: balance, Addr: addr, Count: 5}
+		expected := &CustomBytes{Addr: addr.AsSlice(), Count: 5}
+		balance.FillBytes(expected.Balance[:])
+		for i := 0; i < 16; i++ {
+			expected.Balance[i], expected.Balance[31-i] = expected.Balance[31-i], expected.Balance[i]
+		}
+
+		// the encoding matches the one of the fields encoded as bytes
+		buf, err := c.MarshalSSZ()
+		require.NoError(t, err)
+		expectedBuf, err := expected.MarshalSSZ()
+		require.NoError(t, err)
+		assert.Equal(t, expectedBuf, buf)
+		assert.Equal(t, len(buf), c.SizeSSZ())
+
+		root, err := c.HashTreeRoot()
+		require.NoError(t, err)
+		expectedRoot, err := expected.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, expectedRoot, root)
+
+		var c2 Custom
+		require.NoError(t, c2.UnmarshalSSZ(buf))
+		assert.Equal(t, 0, balance.Cmp(c2.Balance))
+		assert.Equal(t, addr, c2.Addr)
+
+		// decode from the tree
+		tree, err := c.GetTree()
+		require.NoError(t, err)
+		var c3 Custom
+		require.NoError(t, c3.FromTree(tree))
+		assert.Equal(t, 0, balance.Cmp(c3.Balance))
+		assert.Equal(t, addr, c3.Addr)
+	}
+}
+
+func TestCustomCodec_Invalid(t *testing.T) {
+	// the codec fails to encode the value
+	c := &Custom{Balance: big.NewInt(-1)}
+	_, err := c.MarshalSSZ()
+	assert.Error(t, err)
+
+	// the encoding is higher than the max size
+	c = &Custom{Balance: big.NewInt(1), Addr: netip.MustParseAddr("fe80::1%eth0")}
+	_, err = c.MarshalSSZ()
+	assert.Error(t, err)
+
+	// the bytes are higher than the max size
+	_, err = (&CustomBytes{Addr: make([]byte, 17)}).MarshalSSZ()
+	require.Error(t, err)
+
+	// the codec rejects an address of 3 bytes
+	buf, err := (&CustomBytes{Addr: make([]byte, 3)}).MarshalSSZ()
+	require.NoError(t, err)
+	assert.Error(t, new(Custom).UnmarshalSSZ(buf))
+}
+
+func TestCustomSchema(t *testing.T) {
+	schema := new(Custom).SSZSchema()
+	assert.Equal(t, ssz.KindUnknown, schema.Fields[0].Schema.Kind)
+	assert.Equal(t, uint64(32), schema.Fields[0].Schema.Size)
+	assert.False(t, schema.Fields[1].Schema.IsFixed())
+}
@@ -0,0 +1,46 @@
+package testcases
+
+import (
+	"testing"
+
+	"github.com/NilFoundation/fastssz/fuzz"
+	"github.com/NilFoundation/fastssz/ssztest"
+)
+
+func TestDifferential(t *testing.T) {
+	// the objects generated with the tests have their own differential tests
+	objs := map[string]func() ssztest.Object{
+		"Case1A":       func() ssztest.Object { return new(Case1A) },
+		"Case1B":       func() ssztest.Object { return new(Case1B) },
+		"Case2A":       func() ssztest.Object { return new(Case2A) },
+		"Case2B":       func() ssztest.Object { return new(Case2B) },
+		"Case4":        func() ssztest.Object { return new(Case4) },
+		"Case5A":       func() ssztest.Object { return new(Case5A) },
+		"Case6":        func() ssztest.Object { return new(Case6) },
+		"Case7":        func() ssztest.Object { return new(Case7) },
+		"Vec":          func() ssztest.Object { return new(Vec) },
+		"Vec2":         func() ssztest.Object { return new(Vec2) },
+		"Test1":        func() ssztest.Object { return new(Test1) },
+		"Test2":        func() ssztest.Object { return new(Test2) },
+		"Imports":      func() ssztest.Object { return new(Imports) },
+		"Obj2":         func() ssztest.Object { return new(Obj2) },
+		"Issue136":     func() ssztest.Object { return new(Issue136) },
+		"Issue153":     func() ssztest.Object { return new(Issue153) },
+		"Issue156":     func() ssztest.Object { return new(Issue156) },
+		"Issue165":     func() ssztest.Object { return new(Issue165) },
+		"BytesWrapper": func() ssztest.Object { return new(BytesWrapper) },
+		"ListC":        func() ssztest.Object { return new(ListC) },
+		"ListP":        func() ssztest.Object { return new(ListP) },
+		"PR1512":       func() ssztest.Object { return new(PR1512) },
+		"Uints":        func() ssztest.Object { return new(Uints) },
+	}
+	for name, newObj := range objs {
+		newObj := newObj
+		t.Run(name, func(t *testing.T) {
+			f := fuzz.NewWithSeed(1)
+			ssztest.DifferentialFuzz(t, newObj, f, ssztest.RoundTripCount)
+			f.SetStrategy(fuzz.StrategyBoundary)
+			ssztest.DifferentialFuzz(t, newObj, f, ssztest.RoundTripCount)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package testcases
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	ssz "github.com/NilFoundation/fastssz"
+)
+
+type treeCodec interface {
+	GetTree() (*ssz.Node, error)
+	FromTree(n *ssz.Node) error
+}
+
+func TestFromTree(t *testing.T) {
+	listC := &ListC{}
+	listP := &ListP{}
+	for i := 0; i < 5; i++ {
+		listC.Elems = append(listC.Elems, BytesWrapper{Bytes: bytes.Repeat([]byte{byte(i)}, 48)})
+		listP.Elems = append(listP.Elems, &BytesWrapper{Bytes: bytes.Repeat([]byte{byte(i)}, 48)})
+	}
+
+	cases := []struct {
+		name string
+		obj  treeCodec
+		new  func() treeCodec
+	}{
+		{"Vector", &Vec{Values: []uint64{1, 2, 3, 4, 5, 6}}, func() treeCodec { return new(Vec) }},
+		{"ListUint", &Vec2{Values2: []uint32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}}, func() treeCodec { return new(Vec2) }},
+		{"ListEmpty", &Vec2{Values2: []uint32{}}, func() treeCodec { return new(Vec2) }},
+		{"ByteList", &Case1A{Foo: bytes.Repeat([]byte{1, 2, 3}, 100)}, func() treeCodec { return new(Case1A) }},
+		{"ListContainers", listC, func() treeCodec { return new(ListC) }},
+		{"ListPtrContainers", listP, func() treeCodec { return new(ListP) }},
+		{"FixedBytes", &Issue156{A: [32]byte{1}, A2: [32]byte{2}, A3: [32]byte{3}, A4: bytes.Repeat([]byte{4}, 32)}, func() treeCodec { return new(Issue156) }},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tree, err := c.obj.GetTree()
+			if err != nil {
+				t.Fatal(err)
+			}
+			obj := c.new()
+			if err := obj.FromTree(tree); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(c.obj, obj) {
+				t.Fatalf("expected %v but found %v", c.obj, obj)
+			}
+		})
+	}
+}
+
+func TestFromTree_WrongLayout(t *testing.T) {
+	tree, err := (&Vec{Values: []uint64{1, 2, 3, 4, 5, 6}}).GetTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the vector is stored in two leaves, there is no data subtree for the list
+	if err := new(Vec2).FromTree(tree); err == nil {
+		t.Fatal("expected error decoding a vector as a list")
+	}
+
+	tree, err = (&Vec2{Values2: make([]uint32, 10)}).GetTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// length mixin higher than the maximum size of the list
+	if tree, err = tree.Set(3, ssz.LeafFromUint64(101)); err != nil {
+		t.Fatal(err)
+	}
+	if err := new(Vec2).FromTree(tree); err != ssz.ErrListTooBig {
+		t.Fatalf("expected list too big error but found %v", err)
+	}
+}
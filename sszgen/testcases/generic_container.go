@@ -0,0 +1,42 @@
+package testcases
+
+import ssz "github.com/NilFoundation/fastssz"
+
+//go:generate go run ../main.go --path generic_container.go --tests
+
+// Object is implemented by the generated ssz objects
+type Object interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+type Pair[A, B Object] struct {
+	Index  uint64
+	First  A
+	Second B
+	Data   []byte `ssz-max:"32"`
+}
+
+type Envelope[T ssz.Marshaler] struct {
+	Version uint32
+	Payload T
+}
+
+type PairFixedItem struct {
+	A uint64
+	B [32]byte `ssz-size:"32"`
+}
+
+type PairVariableItem struct {
+	A uint64
+	B []byte `ssz-max:"64"`
+}
+
+// PairFixedVariable has the same layout as Pair[*PairFixedItem, *PairVariableItem]
+type PairFixedVariable struct {
+	Index  uint64
+	First  *PairFixedItem
+	Second *PairVariableItem
+	Data   []byte `ssz-max:"32"`
+}
@@ -0,0 +1,849 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: ed3c5971c85b2bc5c954350b262d0a5c5db744cf96be7e1249b685cae0455c89
+// Version: 0.1.3
+package testcases
+
+import (
+	ssz "github.com/NilFoundation/fastssz"
+)
+
+// MarshalSSZ ssz marshals the Pair object
+func (p *Pair[A, B]) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the Pair object to a target array
+func (p *Pair[A, B]) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	sizes := []int{8, ssz.FieldSize(p.First), ssz.FieldSize(p.Second), ssz.VariableSize}
+	offset := ssz.FixedPartSize(sizes...)
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, p.Index)
+
+	// Field (1) 'First'
+	if sizes[1] != ssz.VariableSize {
+		if dst, err = ssz.OrZero(p.First).MarshalSSZTo(dst); err != nil {
+			return
+		}
+	} else {
+		dst = ssz.WriteOffset(dst, offset)
+		offset += ssz.OrZero(p.First).SizeSSZ()
+	}
+
+	// Field (2) 'Second'
+	if sizes[2] != ssz.VariableSize {
+		if dst, err = ssz.OrZero(p.Second).MarshalSSZTo(dst); err != nil {
+			return
+		}
+	} else {
+		dst = ssz.WriteOffset(dst, offset)
+		offset += ssz.OrZero(p.Second).SizeSSZ()
+	}
+
+	// Offset (3) 'Data'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.Data)
+
+	// Field (1) 'First'
+	if sizes[1] == ssz.VariableSize {
+		if dst, err = ssz.OrZero(p.First).MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'Second'
+	if sizes[2] == ssz.VariableSize {
+		if dst, err = ssz.OrZero(p.Second).MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (3) 'Data'
+	if size := len(p.Data); size > 32 {
+		err = ssz.ErrBytesLengthFn("Pair.Data", size, 32)
+		return
+	}
+	dst = append(dst, p.Data...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Pair object
+func (p *Pair[A, B]) UnmarshalSSZ(buf []byte) error {
+	var err error
+	ssz.Alloc(&p.First)
+	ssz.Alloc(&p.Second)
+	bufs, err := ssz.SplitContainer(buf, 8, ssz.FieldSize(p.First), ssz.FieldSize(p.Second), ssz.VariableSize)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Index'
+	{
+		buf = bufs[0]
+		p.Index = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'First'
+	{
+		buf = bufs[1]
+		if err = p.First.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'Second'
+	{
+		buf = bufs[2]
+		if err = p.Second.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (3) 'Data'
+	{
+		buf = bufs[3]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(p.Data) == 0 {
+			p.Data = make([]byte, 0, len(buf))
+		}
+		p.Data = append(p.Data, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Pair object
+func (p *Pair[A, B]) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (1) 'First'
+	size += ssz.ParamSize(p.First)
+
+	// Field (2) 'Second'
+	size += ssz.ParamSize(p.Second)
+
+	// Field (3) 'Data'
+	size += len(p.Data)
+
+	return
+}
+
+const PairMaxDataSize = 32
+
+// HashTreeRoot ssz hashes the Pair object
+func (p *Pair[A, B]) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the Pair object with a hasher
+func (p *Pair[A, B]) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(p.Index)
+
+	// Field (1) 'First'
+	if err = ssz.OrZero(p.First).HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Second'
+	if err = ssz.OrZero(p.Second).HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (3) 'Data'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(p.Data))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(p.Data)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Pair object
+func (p *Pair[A, B]) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(p)
+}
+
+// SSZSchema returns the ssz schema of the Pair object
+func (p *Pair[A, B]) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Index", Tag: "", Schema: ssz.UintSchema(8)},
+			{Name: "First", Tag: "", Schema: ssz.SchemaOfType[A]()},
+			{Name: "Second", Tag: "", Schema: ssz.SchemaOfType[B]()},
+			{Name: "Data", Tag: "", Schema: ssz.ByteListSchema(32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Pair object
+const (
+	Pair_IndexGIndex  = 4
+	Pair_FirstGIndex  = 5
+	Pair_SecondGIndex = 6
+	Pair_DataGIndex   = 7
+)
+
+// FromTree decodes the Pair object from its tree
+func (p *Pair[A, B]) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 4)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Index'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		p.Index = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'First'
+	{
+		ssz.Alloc(&p.First)
+		if err := ssz.DecodeTree(nodes[1], p.First); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'Second'
+	{
+		ssz.Alloc(&p.Second)
+		if err := ssz.DecodeTree(nodes[2], p.Second); err != nil {
+			return err
+		}
+	}
+
+	// Field (3) 'Data'
+	{
+		buf, err := ssz.TreeListPacked(nodes[3], 32, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(p.Data) == 0 {
+			p.Data = make([]byte, 0, len(buf))
+		}
+		p.Data = append(p.Data, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Envelope object
+func (e *Envelope[T]) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the Envelope object to a target array
+func (e *Envelope[T]) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	sizes := []int{4, ssz.FieldSize(e.Payload)}
+	offset := ssz.FixedPartSize(sizes...)
+
+	// Field (0) 'Version'
+	dst = ssz.MarshalUint32(dst, e.Version)
+
+	// Field (1) 'Payload'
+	if sizes[1] != ssz.VariableSize {
+		if dst, err = ssz.OrZero(e.Payload).MarshalSSZTo(dst); err != nil {
+			return
+		}
+	} else {
+		dst = ssz.WriteOffset(dst, offset)
+		offset += ssz.OrZero(e.Payload).SizeSSZ()
+	}
+
+	// Field (1) 'Payload'
+	if sizes[1] == ssz.VariableSize {
+		if dst, err = ssz.OrZero(e.Payload).MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Envelope object
+func (e *Envelope[T]) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (1) 'Payload'
+	size += ssz.ParamSize(e.Payload)
+
+	return
+}
+
+// SSZSchema returns the ssz schema of the Envelope object
+func (e *Envelope[T]) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Version", Tag: "", Schema: ssz.UintSchema(4)},
+			{Name: "Payload", Tag: "", Schema: ssz.SchemaOfType[T]()},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Envelope object
+const (
+	Envelope_VersionGIndex = 2
+	Envelope_PayloadGIndex = 3
+)
+
+// FromTree decodes the Envelope object from its tree
+func (e *Envelope[T]) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Version'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 4)
+		if err != nil {
+			return err
+		}
+		e.Version = ssz.UnmarshallUint32(buf)
+	}
+
+	// Field (1) 'Payload'
+	{
+		ssz.Alloc(&e.Payload)
+		if err := ssz.DecodeTree(nodes[1], e.Payload); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the PairFixedItem object
+func (p *PairFixedItem) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the PairFixedItem object to a target array
+func (p *PairFixedItem) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, p.A)
+
+	// Field (1) 'B'
+	dst = append(dst, p.B[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the PairFixedItem object
+func (p *PairFixedItem) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'A'
+	p.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'B'
+	copy(p.B[:], buf[8:40])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the PairFixedItem object
+func (p *PairFixedItem) SizeSSZ() (size int) {
+	size = 40
+	return
+}
+
+// HashTreeRoot ssz hashes the PairFixedItem object
+func (p *PairFixedItem) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the PairFixedItem object with a hasher
+func (p *PairFixedItem) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(p.A)
+
+	// Field (1) 'B'
+	hh.PutBytes(p.B[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the PairFixedItem object
+func (p *PairFixedItem) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(p)
+}
+
+// SSZSchema returns the ssz schema of the PairFixedItem object
+func (p *PairFixedItem) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "A", Tag: "", Schema: ssz.UintSchema(8)},
+			{Name: "B", Tag: "", Schema: ssz.BytesSchema(32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the PairFixedItem object
+const (
+	PairFixedItem_AGIndex = 2
+	PairFixedItem_BGIndex = 3
+)
+
+// FromTree decodes the PairFixedItem object from its tree
+func (p *PairFixedItem) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'A'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		p.A = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'B'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 32)
+		if err != nil {
+			return err
+		}
+		copy(p.B[:], buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the PairVariableItem object
+func (p *PairVariableItem) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the PairVariableItem object to a target array
+func (p *PairVariableItem) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(12)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, p.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'B'
+	if size := len(p.B); size > 64 {
+		err = ssz.ErrBytesLengthFn("PairVariableItem.B", size, 64)
+		return
+	}
+	dst = append(dst, p.B...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the PairVariableItem object
+func (p *PairVariableItem) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'A'
+	p.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 != 12 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:]
+		if len(buf) > 64 {
+			return ssz.ErrBytesLength
+		}
+		if cap(p.B) == 0 {
+			p.B = make([]byte, 0, len(buf))
+		}
+		p.B = append(p.B, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the PairVariableItem object
+func (p *PairVariableItem) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (1) 'B'
+	size += len(p.B)
+
+	return
+}
+
+const PairVariableItemMaxBSize = 64
+
+// HashTreeRoot ssz hashes the PairVariableItem object
+func (p *PairVariableItem) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the PairVariableItem object with a hasher
+func (p *PairVariableItem) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(p.A)
+
+	// Field (1) 'B'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(p.B))
+		if byteLen > 64 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(p.B)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (64+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the PairVariableItem object
+func (p *PairVariableItem) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(p)
+}
+
+// SSZSchema returns the ssz schema of the PairVariableItem object
+func (p *PairVariableItem) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "A", Tag: "", Schema: ssz.UintSchema(8)},
+			{Name: "B", Tag: "", Schema: ssz.ByteListSchema(64)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the PairVariableItem object
+const (
+	PairVariableItem_AGIndex = 2
+	PairVariableItem_BGIndex = 3
+)
+
+// FromTree decodes the PairVariableItem object from its tree
+func (p *PairVariableItem) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'A'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		p.A = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'B'
+	{
+		buf, err := ssz.TreeListPacked(nodes[1], 64, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 64 {
+			return ssz.ErrBytesLength
+		}
+		if cap(p.B) == 0 {
+			p.B = make([]byte, 0, len(buf))
+		}
+		p.B = append(p.B, buf...)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the PairFixedVariable object
+func (p *PairFixedVariable) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the PairFixedVariable object to a target array
+func (p *PairFixedVariable) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(56)
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, p.Index)
+
+	// Field (1) 'First'
+	if p.First == nil {
+		p.First = new(PairFixedItem)
+	}
+	if dst, err = p.First.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (2) 'Second'
+	dst = ssz.WriteOffset(dst, offset)
+	if p.Second == nil {
+		p.Second = new(PairVariableItem)
+	}
+	offset += p.Second.SizeSSZ()
+
+	// Offset (3) 'Data'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'Second'
+	if dst, err = p.Second.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'Data'
+	if size := len(p.Data); size > 32 {
+		err = ssz.ErrBytesLengthFn("PairFixedVariable.Data", size, 32)
+		return
+	}
+	dst = append(dst, p.Data...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the PairFixedVariable object
+func (p *PairFixedVariable) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 56 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o2, o3 uint64
+
+	// Field (0) 'Index'
+	p.Index = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'First'
+	if p.First == nil {
+		p.First = new(PairFixedItem)
+	}
+	if err = p.First.UnmarshalSSZ(buf[8:48]); err != nil {
+		return err
+	}
+
+	// Offset (2) 'Second'
+	if o2 = ssz.ReadOffset(buf[48:52]); o2 > size {
+		return ssz.ErrOffset
+	}
+
+	if o2 != 56 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (3) 'Data'
+	if o3 = ssz.ReadOffset(buf[52:56]); o3 > size || o2 > o3 {
+		return ssz.ErrOffset
+	}
+
+	// Field (2) 'Second'
+	{
+		buf = tail[o2:o3]
+		if p.Second == nil {
+			p.Second = new(PairVariableItem)
+		}
+		if err = p.Second.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (3) 'Data'
+	{
+		buf = tail[o3:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(p.Data) == 0 {
+			p.Data = make([]byte, 0, len(buf))
+		}
+		p.Data = append(p.Data, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the PairFixedVariable object
+func (p *PairFixedVariable) SizeSSZ() (size int) {
+	size = 56
+
+	// Field (2) 'Second'
+	if p.Second == nil {
+		p.Second = new(PairVariableItem)
+	}
+	size += p.Second.SizeSSZ()
+
+	// Field (3) 'Data'
+	size += len(p.Data)
+
+	return
+}
+
+const PairFixedVariableMaxSecondSize = 0
+const PairFixedVariableMaxDataSize = 32
+
+// HashTreeRoot ssz hashes the PairFixedVariable object
+func (p *PairFixedVariable) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the PairFixedVariable object with a hasher
+func (p *PairFixedVariable) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(p.Index)
+
+	// Field (1) 'First'
+	if p.First == nil {
+		p.First = new(PairFixedItem)
+	}
+	if err = p.First.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Second'
+	if err = p.Second.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (3) 'Data'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(p.Data))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(p.Data)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the PairFixedVariable object
+func (p *PairFixedVariable) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(p)
+}
+
+// SSZSchema returns the ssz schema of the PairFixedVariable object
+func (p *PairFixedVariable) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Index", Tag: "", Schema: ssz.UintSchema(8)},
+			{Name: "First", Tag: "", Schema: ssz.SchemaOf(new(PairFixedItem))},
+			{Name: "Second", Tag: "", Schema: ssz.SchemaOf(new(PairVariableItem))},
+			{Name: "Data", Tag: "", Schema: ssz.ByteListSchema(32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the PairFixedVariable object
+const (
+	PairFixedVariable_IndexGIndex  = 4
+	PairFixedVariable_FirstGIndex  = 5
+	PairFixedVariable_SecondGIndex = 6
+	PairFixedVariable_DataGIndex   = 7
+)
+
+// FromTree decodes the PairFixedVariable object from its tree
+func (p *PairFixedVariable) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 4)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Index'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		p.Index = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'First'
+	{
+		if p.First == nil {
+			p.First = new(PairFixedItem)
+		}
+		if err := p.First.FromTree(nodes[1]); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'Second'
+	{
+		if p.Second == nil {
+			p.Second = new(PairVariableItem)
+		}
+		if err := p.Second.FromTree(nodes[2]); err != nil {
+			return err
+		}
+	}
+
+	// Field (3) 'Data'
+	{
+		buf, err := ssz.TreeListPacked(nodes[3], 32, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(p.Data) == 0 {
+			p.Data = make([]byte, 0, len(buf))
+		}
+		p.Data = append(p.Data, buf...)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: ed3c5971c85b2bc5c954350b262d0a5c5db744cf96be7e1249b685cae0455c89
+// Version: 0.1.3
+package testcases
+
+import (
+	"testing"
+
+	"github.com/NilFoundation/fastssz/fuzz"
+	"github.com/NilFoundation/fastssz/ssztest"
+)
+
+func TestPairFixedItemRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(PairFixedItem) })
+}
+
+func TestPairFixedItemDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(PairFixedItem) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzPairFixedItem(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(PairFixedItem) }, "")
+}
+
+func TestPairVariableItemRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(PairVariableItem) })
+}
+
+func TestPairVariableItemDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(PairVariableItem) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzPairVariableItem(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(PairVariableItem) }, "")
+}
+
+func TestPairFixedVariableRoundTrip(t *testing.T) {
+	ssztest.RoundTrip(t, func() ssztest.Object { return new(PairFixedVariable) })
+}
+
+func TestPairFixedVariableDifferential(t *testing.T) {
+	ssztest.DifferentialFuzz(t, func() ssztest.Object { return new(PairFixedVariable) }, fuzz.NewWithSeed(0), ssztest.RoundTripCount)
+}
+
+func FuzzPairFixedVariable(f *testing.F) {
+	ssztest.Fuzz(f, func() ssztest.Object { return new(PairFixedVariable) }, "")
+}
@@ -0,0 +1,144 @@
+package testcases
+
+import (
+	"testing"
+
+	ssz "github.com/NilFoundation/fastssz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenericContainer(t *testing.T) {
+	first := &PairFixedItem{A: 1}
+	first.B[0] = 2
+	second := &PairVariableItem{A: 3, B: []byte{4, 5, 6}}
+
+	p := &Pair[*PairFixedItem, *PairVariableItem]{Index: 7, First: first, Second: second, Data: []byte{8, 9}}
+	expected := &PairFixedVariable{Index: 7, First: first, Second: second, Data: []byte{8, 9}}
+
+	// the encoding of the generic container matches the container with the same layout
+	assert.Equal(t, expected.SizeSSZ(), p.SizeSSZ())
+
+	buf, err := p.MarshalSSZ()
+	require.NoError(t, err)
+	expectedBuf, err := expected.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, expectedBuf, buf)
+
+	root, err := p.HashTreeRoot()
+	require.NoError(t, err)
+	expectedRoot, err := expected.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, expectedRoot, root)
+
+	var p2 Pair[*PairFixedItem, *PairVariableItem]
+	require.NoError(t, p2.UnmarshalSSZ(buf))
+	assert.Equal(t, p, &p2)
+
+	// swap the fixed and the variable type arguments
+	swapped := &Pair[*PairVariableItem, *PairFixedItem]{Index: 7, First: second, Second: first}
+	buf, err = swapped.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Len(t, buf, swapped.SizeSSZ())
+
+	var swapped2 Pair[*PairVariableItem, *PairFixedItem]
+	require.NoError(t, swapped2.UnmarshalSSZ(buf))
+	assert.Equal(t, swapped.First, swapped2.First)
+	assert.Equal(t, swapped.Second, swapped2.Second)
+
+	// the first offset must point to the end of the fixed part
+	buf[8] = buf[8] + 1
+	assert.Error(t, swapped2.UnmarshalSSZ(buf))
+}
+
+func TestGenericContainer_FromTree(t *testing.T) {
+	p := &Pair[*PairFixedItem, *PairVariableItem]{
+		Index:  1,
+		First:  &PairFixedItem{A: 2},
+		Second: &PairVariableItem{A: 3, B: []byte{4}},
+		Data:   []byte{},
+	}
+	tree, err := p.GetTree()
+	require.NoError(t, err)
+
+	var p2 Pair[*PairFixedItem, *PairVariableItem]
+	require.NoError(t, p2.FromTree(tree))
+	assert.Equal(t, p, &p2)
+
+	schema := p.SSZSchema()
+	assert.True(t, schema.Fields[1].Schema.IsFixed())
+	assert.False(t, schema.Fields[2].Schema.IsFixed())
+}
+
+func TestGenericContainer_Envelope(t *testing.T) {
+	e := &Envelope[*PairVariableItem]{Version: 1, Payload: &PairVariableItem{A: 2, B: []byte{3}}}
+	buf, err := e.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, 4+4+8+4+1, len(buf))
+	assert.Equal(t, len(buf), e.SizeSSZ())
+
+	var fixed Envelope[*PairFixedItem]
+	assert.Equal(t, 4+8+32, fixed.SizeSSZ())
+	// the size does not allocate the payload
+	assert.Nil(t, fixed.Payload)
+
+	// the payload implemented by hand has a fixed size
+	payload := handFixed(2)
+	h := &Envelope[*handFixed]{Version: 1, Payload: &payload}
+	buf, err = h.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0}, buf)
+	assert.Equal(t, 12, h.SizeSSZ())
+	assert.True(t, h.SSZSchema().IsFixed())
+}
+
+func TestGenericContainer_NilArgs(t *testing.T) {
+	p := &Pair[*PairFixedItem, *PairVariableItem]{Index: 1}
+	expected := &Pair[*PairFixedItem, *PairVariableItem]{Index: 1, First: new(PairFixedItem), Second: new(PairVariableItem)}
+
+	// the nil type arguments are encoded and hashed as the zero values
+	buf, err := p.MarshalSSZ()
+	require.NoError(t, err)
+	expectedBuf, err := expected.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, expectedBuf, buf)
+
+	root, err := p.HashTreeRoot()
+	require.NoError(t, err)
+	expectedRoot, err := expected.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, expectedRoot, root)
+
+	tree, err := p.GetTree()
+	require.NoError(t, err)
+	assert.Equal(t, expectedRoot[:], tree.Hash())
+
+	// without allocating them
+	assert.Nil(t, p.First)
+	assert.Nil(t, p.Second)
+
+	// but they are allocated to decode
+	var p2 Pair[*PairFixedItem, *PairVariableItem]
+	require.NoError(t, p2.UnmarshalSSZ(buf))
+	assert.Equal(t, expected.First, p2.First)
+	assert.NotNil(t, p2.Second)
+}
+
+// handFixed implements the ssz interfaces by hand with a fixed size
+type handFixed uint64
+
+func (h *handFixed) MarshalSSZ() ([]byte, error) {
+	return h.MarshalSSZTo(nil)
+}
+
+func (h *handFixed) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return ssz.MarshalUint64(dst, uint64(*h)), nil
+}
+
+func (h *handFixed) SizeSSZ() int {
+	return 8
+}
+
+func (h *handFixed) FixedSizeSSZ() int {
+	return 8
+}
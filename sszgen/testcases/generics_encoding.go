@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 9ff48511bbbf06dd8e499ef1c2945e0aa8b31fdd03ba38afb743196ccd543525
+// Hash: cf0fb74ca686fe43262e50f1681968256ebbe892c0859ed6783c67252f9a7a90
 // Version: 0.1.3
 package testcases
 
@@ -63,6 +63,39 @@ func (w *Wrapper) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(w)
 }
 
+// SSZSchema returns the ssz schema of the Wrapper object
+func (w *Wrapper) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value", Tag: "", Schema: ssz.UintSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Wrapper object
+const (
+	Wrapper_ValueGIndex = 1
+)
+
+// FromTree decodes the Wrapper object from its tree
+func (w *Wrapper) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		w.Value = ssz.UnmarshallUint64(buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Test1 object
 func (t *Test1) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(t)
@@ -125,6 +158,37 @@ func (t *Test1) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(t)
 }
 
+// SSZSchema returns the ssz schema of the Test1 object
+func (t *Test1) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "G", Tag: "", Schema: ssz.SchemaOf(new(Wrapper))},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Test1 object
+const (
+	Test1_GGIndex = 1
+)
+
+// FromTree decodes the Test1 object from its tree
+func (t *Test1) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'G'
+	{
+		if err := t.G.FromTree(nodes[0]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Wrapper2 object
 func (w *Wrapper2) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(w)
@@ -190,6 +254,50 @@ func (w *Wrapper2) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(w)
 }
 
+// SSZSchema returns the ssz schema of the Wrapper2 object
+func (w *Wrapper2) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value1", Tag: "", Schema: ssz.UintSchema(8)},
+			{Name: "Value2", Tag: "", Schema: ssz.UintSchema(2)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Wrapper2 object
+const (
+	Wrapper2_Value1GIndex = 2
+	Wrapper2_Value2GIndex = 3
+)
+
+// FromTree decodes the Wrapper2 object from its tree
+func (w *Wrapper2) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value1'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		w.Value1 = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'Value2'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 2)
+		if err != nil {
+			return err
+		}
+		w.Value2 = ssz.UnmarshallUint16(buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Test2 object
 func (t *Test2) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(t)
@@ -251,3 +359,34 @@ func (t *Test2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (t *Test2) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(t)
 }
+
+// SSZSchema returns the ssz schema of the Test2 object
+func (t *Test2) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "G", Tag: "", Schema: ssz.SchemaOf(new(Wrapper2))},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Test2 object
+const (
+	Test2_GGIndex = 1
+)
+
+// FromTree decodes the Test2 object from its tree
+func (t *Test2) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'G'
+	{
+		if err := t.G.FromTree(nodes[0]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
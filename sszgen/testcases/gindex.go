@@ -0,0 +1,14 @@
+package testcases
+
+//go:generate go run ../main.go --path gindex.go
+
+// GIndexA and GIndexAB have the fields BC and C whose names concatenated
+// with the names of the types are the same (GIndexABC)
+type GIndexA struct {
+	BC uint64
+}
+
+type GIndexAB struct {
+	B uint64
+	C uint64
+}
@@ -0,0 +1,206 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 5d715f36f21e26cba7dbfea81e6df2895f0feda43a814048965fb6829765e2a6
+// Version: 0.1.3
+package testcases
+
+import (
+	ssz "github.com/NilFoundation/fastssz"
+)
+
+// MarshalSSZ ssz marshals the GIndexA object
+func (g *GIndexA) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(g)
+}
+
+// MarshalSSZTo ssz marshals the GIndexA object to a target array
+func (g *GIndexA) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'BC'
+	dst = ssz.MarshalUint64(dst, g.BC)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the GIndexA object
+func (g *GIndexA) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 8 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'BC'
+	g.BC = ssz.UnmarshallUint64(buf[0:8])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the GIndexA object
+func (g *GIndexA) SizeSSZ() (size int) {
+	size = 8
+	return
+}
+
+// HashTreeRoot ssz hashes the GIndexA object
+func (g *GIndexA) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(g)
+}
+
+// HashTreeRootWith ssz hashes the GIndexA object with a hasher
+func (g *GIndexA) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'BC'
+	hh.PutUint64(g.BC)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the GIndexA object
+func (g *GIndexA) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(g)
+}
+
+// SSZSchema returns the ssz schema of the GIndexA object
+func (g *GIndexA) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "BC", Tag: "", Schema: ssz.UintSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the GIndexA object
+const (
+	GIndexA_BCGIndex = 1
+)
+
+// FromTree decodes the GIndexA object from its tree
+func (g *GIndexA) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'BC'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		g.BC = ssz.UnmarshallUint64(buf)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the GIndexAB object
+func (g *GIndexAB) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(g)
+}
+
+// MarshalSSZTo ssz marshals the GIndexAB object to a target array
+func (g *GIndexAB) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'B'
+	dst = ssz.MarshalUint64(dst, g.B)
+
+	// Field (1) 'C'
+	dst = ssz.MarshalUint64(dst, g.C)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the GIndexAB object
+func (g *GIndexAB) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'B'
+	g.B = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'C'
+	g.C = ssz.UnmarshallUint64(buf[8:16])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the GIndexAB object
+func (g *GIndexAB) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// HashTreeRoot ssz hashes the GIndexAB object
+func (g *GIndexAB) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(g)
+}
+
+// HashTreeRootWith ssz hashes the GIndexAB object with a hasher
+func (g *GIndexAB) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'B'
+	hh.PutUint64(g.B)
+
+	// Field (1) 'C'
+	hh.PutUint64(g.C)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the GIndexAB object
+func (g *GIndexAB) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(g)
+}
+
+// SSZSchema returns the ssz schema of the GIndexAB object
+func (g *GIndexAB) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "B", Tag: "", Schema: ssz.UintSchema(8)},
+			{Name: "C", Tag: "", Schema: ssz.UintSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the GIndexAB object
+const (
+	GIndexAB_BGIndex = 2
+	GIndexAB_CGIndex = 3
+)
+
+// FromTree decodes the GIndexAB object from its tree
+func (g *GIndexAB) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'B'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		g.B = ssz.UnmarshallUint64(buf)
+	}
+
+	// Field (1) 'C'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 8)
+		if err != nil {
+			return err
+		}
+		g.C = ssz.UnmarshallUint64(buf)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package testcases
+
+import (
+	"github.com/NilFoundation/fastssz/sszgen/testcases/other"
+	. "github.com/NilFoundation/fastssz/sszgen/testcases/other2"
+)
+
+//go:generate go run ../main.go --path imports.go
+
+const importsNumRoots = uint64(RootLength / 16)
+
+type SlotAlias = Case4Slot
+
+type Imports struct {
+	A Case4Slot
+	B SlotAlias
+	C [RootLength]byte
+	D [other.Case4RootLength]byte
+	E other.Case4Bytes `ssz-size:"96"`
+	F [importsNumRoots * 16]byte
+}
@@ -0,0 +1,213 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 9f866b5bf0fc1d9bd38489335503d0f546ccca43c26a5955e33ed20ccabe89a7
+// Version: 0.1.3
+package testcases
+
+import (
+	ssz "github.com/NilFoundation/fastssz"
+	"github.com/NilFoundation/fastssz/sszgen/testcases/other"
+	"github.com/NilFoundation/fastssz/sszgen/testcases/other2"
+)
+
+// MarshalSSZ ssz marshals the Imports object
+func (i *Imports) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(i)
+}
+
+// MarshalSSZTo ssz marshals the Imports object to a target array
+func (i *Imports) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, uint64(i.A))
+
+	// Field (1) 'B'
+	dst = ssz.MarshalUint64(dst, uint64(i.B))
+
+	// Field (2) 'C'
+	dst = append(dst, i.C[:]...)
+
+	// Field (3) 'D'
+	dst = append(dst, i.D[:]...)
+
+	// Field (4) 'E'
+	if size := len(i.E); size != 96 {
+		err = ssz.ErrBytesLengthFn("Imports.E", size, 96)
+		return
+	}
+	dst = append(dst, i.E...)
+
+	// Field (5) 'F'
+	dst = append(dst, i.F[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Imports object
+func (i *Imports) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 208 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'A'
+	i.A = other2.Case4Slot(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'B'
+	i.B = SlotAlias(ssz.UnmarshallUint64(buf[8:16]))
+
+	// Field (2) 'C'
+	copy(i.C[:], buf[16:48])
+
+	// Field (3) 'D'
+	copy(i.D[:], buf[48:80])
+
+	// Field (4) 'E'
+	if cap(i.E) == 0 {
+		i.E = other.Case4Bytes(make([]byte, 0, len(buf[80:176])))
+	}
+	i.E = append(i.E, buf[80:176]...)
+
+	// Field (5) 'F'
+	copy(i.F[:], buf[176:208])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Imports object
+func (i *Imports) SizeSSZ() (size int) {
+	size = 208
+	return
+}
+
+// HashTreeRoot ssz hashes the Imports object
+func (i *Imports) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(i)
+}
+
+// HashTreeRootWith ssz hashes the Imports object with a hasher
+func (i *Imports) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(uint64(i.A))
+
+	// Field (1) 'B'
+	hh.PutUint64(uint64(i.B))
+
+	// Field (2) 'C'
+	hh.PutBytes(i.C[:])
+
+	// Field (3) 'D'
+	hh.PutBytes(i.D[:])
+
+	// Field (4) 'E'
+	if size := len(i.E); size != 96 {
+		err = ssz.ErrBytesLengthFn("Imports.E", size, 96)
+		return
+	}
+	hh.PutBytes(i.E)
+
+	// Field (5) 'F'
+	hh.PutBytes(i.F[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Imports object
+func (i *Imports) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(i)
+}
+
+// SSZSchema returns the ssz schema of the Imports object
+func (i *Imports) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "A", Tag: "", Schema: ssz.UintSchema(8)},
+			{Name: "B", Tag: "", Schema: ssz.UintSchema(8)},
+			{Name: "C", Tag: "", Schema: ssz.BytesSchema(32)},
+			{Name: "D", Tag: "", Schema: ssz.BytesSchema(32)},
+			{Name: "E", Tag: "", Schema: ssz.BytesSchema(96)},
+			{Name: "F", Tag: "", Schema: ssz.BytesSchema(32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Imports object
+const (
+	Imports_AGIndex = 8
+	Imports_BGIndex = 9
+	Imports_CGIndex = 10
+	Imports_DGIndex = 11
+	Imports_EGIndex = 12
+	Imports_FGIndex = 13
+)
+
+// FromTree decodes the Imports object from its tree
+func (i *Imports) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 6)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'A'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 8)
+		if err != nil {
+			return err
+		}
+		i.A = other2.Case4Slot(ssz.UnmarshallUint64(buf))
+	}
+
+	// Field (1) 'B'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 8)
+		if err != nil {
+			return err
+		}
+		i.B = SlotAlias(ssz.UnmarshallUint64(buf))
+	}
+
+	// Field (2) 'C'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 32)
+		if err != nil {
+			return err
+		}
+		copy(i.C[:], buf)
+	}
+
+	// Field (3) 'D'
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 32)
+		if err != nil {
+			return err
+		}
+		copy(i.D[:], buf)
+	}
+
+	// Field (4) 'E'
+	{
+		buf, err := ssz.TreeBytes(nodes[4], 96)
+		if err != nil {
+			return err
+		}
+		if cap(i.E) == 0 {
+			i.E = other.Case4Bytes(make([]byte, 0, len(buf)))
+		}
+		i.E = append(i.E, buf...)
+	}
+
+	// Field (5) 'F'
+	{
+		buf, err := ssz.TreeBytes(nodes[5], 32)
+		if err != nil {
+			return err
+		}
+		copy(i.F[:], buf)
+	}
+
+	return nil
+}
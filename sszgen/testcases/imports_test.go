@@ -0,0 +1,30 @@
+package testcases
+
+import (
+	"testing"
+
+	"github.com/NilFoundation/fastssz/sszgen/testcases/other2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImports(t *testing.T) {
+	s := Imports{
+		A: other2.Case4Slot(1),
+		B: SlotAlias(2),
+		E: make([]byte, 96),
+	}
+	s.C[0] = 3
+	s.D[0] = 4
+	s.F[0] = 5
+
+	assert.Equal(t, 8+8+32+32+96+32, s.SizeSSZ())
+
+	bytes, err := s.MarshalSSZ()
+	assert.NoError(t, err)
+
+	var s2 Imports
+	err = s2.UnmarshalSSZ(bytes)
+	assert.NoError(t, err)
+
+	assert.Equal(t, s, s2)
+}
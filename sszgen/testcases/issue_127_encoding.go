@@ -59,7 +59,7 @@ func (o *Obj2) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
+	if o0 != 4 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -143,3 +143,50 @@ func (o *Obj2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (o *Obj2) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(o)
 }
+
+// SSZSchema returns the ssz schema of the Obj2 object
+func (o *Obj2) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "T1", Tag: "", Schema: ssz.ListSchema(ssz.ByteListSchema(256), 1024)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Obj2 object
+const (
+	Obj2_T1GIndex = 1
+)
+
+// FromTree decodes the Obj2 object from its tree
+func (o *Obj2) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'T1'
+	{
+		elems, err := ssz.TreeList(nodes[0], 1024)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		o.T1 = make([]Data, num)
+		for indx, elem := range elems {
+			buf, err := ssz.TreeListPacked(elem, 256, 1)
+			if err != nil {
+				return err
+			}
+			if len(buf) > 256 {
+				return ssz.ErrBytesLength
+			}
+			if cap(o.T1[indx]) == 0 {
+				o.T1[indx] = make([]byte, 0, len(buf))
+			}
+			o.T1[indx] = append(o.T1[indx], buf...)
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package testcases
 
 import (
 	ssz "github.com/NilFoundation/fastssz"
+	"github.com/NilFoundation/fastssz/sszgen/testcases/other"
 )
 
 // MarshalSSZ ssz marshals the Issue136 object
@@ -68,3 +69,34 @@ func (i *Issue136) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (i *Issue136) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
+
+// SSZSchema returns the ssz schema of the Issue136 object
+func (i *Issue136) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "C", Tag: "", Schema: ssz.SchemaOf(new(other.Case3B))},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Issue136 object
+const (
+	Issue136_CGIndex = 1
+)
+
+// FromTree decodes the Issue136 object from its tree
+func (i *Issue136) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'C'
+	{
+		if err := i.C.FromTree(nodes[0]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -80,3 +80,58 @@ func (i *Issue153) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (i *Issue153) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
+
+// SSZSchema returns the ssz schema of the Issue153 object
+func (i *Issue153) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Value1", Tag: "", Schema: ssz.BytesSchema(32)},
+			{Name: "Value2", Tag: "", Schema: ssz.BytesSchema(48)},
+			{Name: "Value", Tag: "", Schema: ssz.BytesSchema(48)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Issue153 object
+const (
+	Issue153_Value1GIndex = 4
+	Issue153_Value2GIndex = 5
+	Issue153_ValueGIndex  = 6
+)
+
+// FromTree decodes the Issue153 object from its tree
+func (i *Issue153) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 3)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Value1'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+		copy(i.Value1[:], buf)
+	}
+
+	// Field (1) 'Value2'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 48)
+		if err != nil {
+			return err
+		}
+		copy(i.Value2[:], buf)
+	}
+
+	// Field (2) 'Value'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 48)
+		if err != nil {
+			return err
+		}
+		copy(i.Value[:], buf)
+	}
+
+	return nil
+}
@@ -100,3 +100,72 @@ func (i *Issue156) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (i *Issue156) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
+
+// SSZSchema returns the ssz schema of the Issue156 object
+func (i *Issue156) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "A", Tag: "", Schema: ssz.BytesSchema(32)},
+			{Name: "A2", Tag: "", Schema: ssz.BytesSchema(32)},
+			{Name: "A3", Tag: "a3", Schema: ssz.BytesSchema(32)},
+			{Name: "A4", Tag: "a4", Schema: ssz.BytesSchema(32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Issue156 object
+const (
+	Issue156_AGIndex  = 4
+	Issue156_A2GIndex = 5
+	Issue156_A3GIndex = 6
+	Issue156_A4GIndex = 7
+)
+
+// FromTree decodes the Issue156 object from its tree
+func (i *Issue156) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 4)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'A'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+		copy(i.A[:], buf)
+	}
+
+	// Field (1) 'A2'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 32)
+		if err != nil {
+			return err
+		}
+		copy(i.A2[:], buf)
+	}
+
+	// Field (2) 'A3'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 32)
+		if err != nil {
+			return err
+		}
+		copy(i.A3[:], buf)
+	}
+
+	// Field (3) 'A4'
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 32)
+		if err != nil {
+			return err
+		}
+		if cap(i.A4) == 0 {
+			i.A4 = make([]byte, 0, len(buf))
+		}
+		i.A4 = append(i.A4, buf...)
+	}
+
+	return nil
+}
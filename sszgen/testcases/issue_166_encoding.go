@@ -58,7 +58,7 @@ func (i *Issue165) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 8 {
+	if o0 != 8 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -150,3 +150,59 @@ func (i *Issue165) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (i *Issue165) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
+
+// SSZSchema returns the ssz schema of the Issue165 object
+func (i *Issue165) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "A", Tag: "", Schema: ssz.ByteListSchema(0)},
+			{Name: "B", Tag: "", Schema: ssz.ByteListSchema(0)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Issue165 object
+const (
+	Issue165_AGIndex = 2
+	Issue165_BGIndex = 3
+)
+
+// FromTree decodes the Issue165 object from its tree
+func (i *Issue165) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'A'
+	{
+		buf, err := ssz.TreeListPacked(nodes[0], 0, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 0 {
+			return ssz.ErrBytesLength
+		}
+		if cap(i.A) == 0 {
+			i.A = other.Case4Bytes(make([]byte, 0, len(buf)))
+		}
+		i.A = append(i.A, buf...)
+	}
+
+	// Field (1) 'B'
+	{
+		buf, err := ssz.TreeListPacked(nodes[1], 0, 1)
+		if err != nil {
+			return err
+		}
+		if len(buf) > 0 {
+			return ssz.ErrBytesLength
+		}
+		if cap(i.B) == 0 {
+			i.B = make([]byte, 0, len(buf))
+		}
+		i.B = append(i.B, buf...)
+	}
+
+	return nil
+}
@@ -74,6 +74,42 @@ func (b *BytesWrapper) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
+// SSZSchema returns the ssz schema of the BytesWrapper object
+func (b *BytesWrapper) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Bytes", Tag: "", Schema: ssz.BytesSchema(48)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the BytesWrapper object
+const (
+	BytesWrapper_BytesGIndex = 1
+)
+
+// FromTree decodes the BytesWrapper object from its tree
+func (b *BytesWrapper) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Bytes'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 48)
+		if err != nil {
+			return err
+		}
+		if cap(b.Bytes) == 0 {
+			b.Bytes = make([]byte, 0, len(buf))
+		}
+		b.Bytes = append(b.Bytes, buf...)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the ListC object
 func (l *ListC) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(l)
@@ -117,7 +153,7 @@ func (l *ListC) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
+	if o0 != 4 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -184,6 +220,45 @@ func (l *ListC) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(l)
 }
 
+// SSZSchema returns the ssz schema of the ListC object
+func (l *ListC) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Elems", Tag: "", Schema: ssz.ListSchema(ssz.SchemaOf(new(BytesWrapper)), 32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the ListC object
+const (
+	ListC_ElemsGIndex = 1
+)
+
+// FromTree decodes the ListC object from its tree
+func (l *ListC) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Elems'
+	{
+		elems, err := ssz.TreeList(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		l.Elems = make([]BytesWrapper, num)
+		for indx, elem := range elems {
+			if err := l.Elems[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the ListP object
 func (l *ListP) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(l)
@@ -227,7 +302,7 @@ func (l *ListP) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
+	if o0 != 4 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -296,3 +371,45 @@ func (l *ListP) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (l *ListP) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(l)
 }
+
+// SSZSchema returns the ssz schema of the ListP object
+func (l *ListP) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Elems", Tag: "", Schema: ssz.ListSchema(ssz.SchemaOf(new(BytesWrapper)), 32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the ListP object
+const (
+	ListP_ElemsGIndex = 1
+)
+
+// FromTree decodes the ListP object from its tree
+func (l *ListP) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Elems'
+	{
+		elems, err := ssz.TreeList(nodes[0], 32)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		l.Elems = make([]*BytesWrapper, num)
+		for indx, elem := range elems {
+			if l.Elems[indx] == nil {
+				l.Elems[indx] = new(BytesWrapper)
+			}
+			if err := l.Elems[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -53,3 +53,17 @@ func (c *Case3B) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *Case3B) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// SSZSchema returns the ssz schema of the Case3B object
+func (c *Case3B) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind:   ssz.KindContainer,
+		Fields: []*ssz.Field{},
+	}
+}
+
+// FromTree decodes the Case3B object from its tree
+func (c *Case3B) FromTree(n *ssz.Node) error {
+
+	return nil
+}
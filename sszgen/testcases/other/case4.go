@@ -1,6 +1,9 @@
 package other
 
-import ssz "github.com/NilFoundation/fastssz"
+import (
+	ssz "github.com/NilFoundation/fastssz"
+	"github.com/NilFoundation/fastssz/sszgen/testcases/other2"
+)
 
 type Case4Interface struct {
 }
@@ -24,3 +27,6 @@ func (s *Case4Interface) UnmarshalSSZ(buf []byte) error {
 type Case4FixedSignature [96]byte
 
 type Case4Bytes []byte
+
+// Case4RootLength is the length of a root
+const Case4RootLength = other2.RootLength
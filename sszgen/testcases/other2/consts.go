@@ -0,0 +1,4 @@
+package other2
+
+// RootLength is the length of a root
+const RootLength = 8 * 4
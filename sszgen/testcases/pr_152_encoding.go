@@ -48,7 +48,7 @@ func (p *PR1512) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
+	if o0 != 4 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -110,3 +110,43 @@ func (p *PR1512) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (p *PR1512) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(p)
 }
+
+// SSZSchema returns the ssz schema of the PR1512 object
+func (p *PR1512) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "D", Tag: "", Schema: ssz.ListSchema(ssz.BytesSchema(48), 32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the PR1512 object
+const (
+	PR1512_DGIndex = 1
+)
+
+// FromTree decodes the PR1512 object from its tree
+func (p *PR1512) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 1)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'D'
+	{
+		buf, err := ssz.TreeListBytes(nodes[0], 32, 48)
+		if err != nil {
+			return err
+		}
+		num, err := ssz.DivideInt2(len(buf), 48, 32)
+		if err != nil {
+			return err
+		}
+		p.D = make([]Data152, num)
+		for ii := 0; ii < num; ii++ {
+			copy(p.D[ii][:], buf[ii*48:(ii+1)*48])
+		}
+	}
+
+	return nil
+}
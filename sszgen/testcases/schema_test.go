@@ -0,0 +1,82 @@
+package testcases
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	ssz "github.com/NilFoundation/fastssz"
+)
+
+func TestSchemaGeneralizedIndex(t *testing.T) {
+	obj := &ListC{}
+	for i := 0; i < 5; i++ {
+		obj.Elems = append(obj.Elems, BytesWrapper{Bytes: bytes.Repeat([]byte{byte(i)}, 48)})
+	}
+
+	tree, err := obj.GetTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	elemsIndx, err := ssz.GeneralizedIndex(obj, "Elems")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elemsIndx != ListC_ElemsGIndex {
+		t.Fatalf("expected %d but found %d", ListC_ElemsGIndex, elemsIndx)
+	}
+
+	for i, elem := range obj.Elems {
+		gindex, err := ssz.GeneralizedIndex(obj, fmt.Sprintf("Elems[%d]", i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		node, err := tree.Get(gindex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		root, err := elem.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(node.Hash(), root[:]) {
+			t.Fatalf("element %d: hash mismatch", i)
+		}
+	}
+
+	lenIndx, err := ssz.GeneralizedIndex(obj, "Elems.__len__")
+	if err != nil {
+		t.Fatal(err)
+	}
+	node, err := tree.Get(lenIndx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(node.Hash(), ssz.LeafFromUint64(uint64(len(obj.Elems))).Hash()) {
+		t.Fatal("length mixin mismatch")
+	}
+}
+
+func TestSchemaGeneralizedIndex_Fields(t *testing.T) {
+	// the constants of the fields whose names concatenated with
+	// the names of the types are the same
+	cases := []struct {
+		obj    ssz.SchemaProvider
+		path   string
+		gindex int
+	}{
+		{&GIndexA{}, "BC", GIndexA_BCGIndex},
+		{&GIndexAB{}, "C", GIndexAB_CGIndex},
+		{&GIndexAB{}, "", 1},
+	}
+	for _, c := range cases {
+		gindex, err := ssz.GeneralizedIndex(c.obj, c.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gindex != c.gindex {
+			t.Fatalf("%T %s: expected %d but found %d", c.obj, c.path, c.gindex, gindex)
+		}
+	}
+}
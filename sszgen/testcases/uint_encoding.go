@@ -89,3 +89,69 @@ func (u *Uints) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (u *Uints) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(u)
 }
+
+// SSZSchema returns the ssz schema of the Uints object
+func (u *Uints) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Uint8", Tag: "", Schema: ssz.UintSchema(1)},
+			{Name: "Uint16", Tag: "", Schema: ssz.UintSchema(2)},
+			{Name: "Uint32", Tag: "", Schema: ssz.UintSchema(4)},
+			{Name: "Uint64", Tag: "", Schema: ssz.UintSchema(8)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Uints object
+const (
+	Uints_Uint8GIndex  = 4
+	Uints_Uint16GIndex = 5
+	Uints_Uint32GIndex = 6
+	Uints_Uint64GIndex = 7
+)
+
+// FromTree decodes the Uints object from its tree
+func (u *Uints) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 4)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Uint8'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 1)
+		if err != nil {
+			return err
+		}
+		u.Uint8 = Uint8(ssz.UnmarshallUint8(buf))
+	}
+
+	// Field (1) 'Uint16'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 2)
+		if err != nil {
+			return err
+		}
+		u.Uint16 = Uint16(ssz.UnmarshallUint16(buf))
+	}
+
+	// Field (2) 'Uint32'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 4)
+		if err != nil {
+			return err
+		}
+		u.Uint32 = Uint32(ssz.UnmarshallUint32(buf))
+	}
+
+	// Field (3) 'Uint64'
+	{
+		buf, err := ssz.TreeBytes(nodes[3], 8)
+		if err != nil {
+			return err
+		}
+		u.Uint64 = Uint64(ssz.UnmarshallUint64(buf))
+	}
+
+	return nil
+}
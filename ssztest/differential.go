@@ -0,0 +1,365 @@
+package ssztest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	ssz "github.com/NilFoundation/fastssz"
+	"github.com/NilFoundation/fastssz/fuzz"
+)
+
+// Divergence is a difference between the methods generated by sszgen and the reference
+type Divergence struct {
+	// Stage is the operation that diverges: marshal, unmarshal, size, hash or tree
+	Stage string
+	// Field is the path of the first divergent field (i.e. Body.Attestations[1].AggregationBits),
+	// empty if it diverges in the object itself
+	Field string
+	// Detail describes the divergence
+	Detail string
+}
+
+func (d *Divergence) Error() string {
+	return fmt.Sprintf("%s diverges from the reference in %s: %s", d.Stage, fieldName(d.Field), d.Detail)
+}
+
+// Differential checks that the object is encoded, decoded and hashed by its generated
+// methods like the reference (see CheckDifferential). The test is skipped if the object
+// has values that the reference does not support and it fails if the object is invalid.
+func Differential(t *testing.T, obj Object) {
+	t.Helper()
+
+	if err := CheckDifferential(obj); err != nil {
+		if errors.Is(err, ErrUnsupported) {
+			t.Skip(err)
+		}
+		t.Fatalf("%T: %v", obj, err)
+	}
+}
+
+// DifferentialFuzz runs CheckDifferential with count objects of newObj filled by the fuzzer.
+// The failures report the seed of the object to fill it again with fuzz.Fuzzer.FuzzSeed
+// and the object minimized by fuzz.Shrink as a Go literal. The invalid objects are not
+// compared, but the test fails if none of the objects is compared.
+func DifferentialFuzz(t *testing.T, newObj func() Object, f *fuzz.Fuzzer, count int) {
+	t.Helper()
+
+	compared := 0
+	for i := 0; i < count; i++ {
+		obj := newObj()
+		report, ok := fuzzReport(f, obj)
+		if !ok {
+			t.Skipf("%T cannot be filled by the fuzzer", obj)
+		}
+		err := CheckDifferential(obj)
+		switch {
+		case errors.Is(err, ErrUnsupported):
+			t.Skip(err)
+		case errors.Is(err, ErrInvalidObject):
+			continue
+		case err != nil:
+			t.Fatalf("%T (fuzz seed %d): %v\n%s", obj, report.Seed, err, reproducer(obj, sameDivergence(err)))
+		}
+		compared++
+	}
+	if compared == 0 {
+		t.Fatalf("none of the %d objects of %T filled by the fuzzer is valid", count, newObj())
+	}
+}
+
+// sameDivergence returns a check that fails if the object diverges in the same stage as err
+func sameDivergence(err error) func(obj Object) error {
+	var d *Divergence
+	if !errors.As(err, &d) {
+		return func(obj Object) error { return nil }
+	}
+	return func(obj Object) error {
+		var d2 *Divergence
+		if err := CheckDifferential(obj); errors.As(err, &d2) && d2.Stage == d.Stage {
+			return d2
+		}
+		return nil
+	}
+}
+
+func fuzzReport(f *fuzz.Fuzzer, obj Object) (report *fuzz.Report, ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	return f.FuzzReport(obj), true
+}
+
+// CheckDifferential encodes, decodes and hashes the object with its generated methods
+// and with a reference that follows the ssz schema of the object with reflection and
+// builds the roots with ssz.TreeFromNodes. It returns a *Divergence with the first
+// divergent field if they differ:
+//
+//   - marshal: one of them fails to encode the object or the encodings are different.
+//   - unmarshal: one of them fails to decode the encoding or the decoded objects are different.
+//   - size: SizeSSZ is not the size of the encoding.
+//   - hash: HashTreeRoot is not the root of the reference tree.
+//   - tree: the tree of GetTree is not the reference tree.
+//
+// The objects that cannot be encoded or decoded by both, like bitlists without the length
+// bit, are not compared and return ErrInvalidObject. The object must implement
+// ssz.SchemaProvider.
+func CheckDifferential(obj Object) error {
+	schema := ssz.SchemaOf(obj)
+	if schema.Kind == ssz.KindUnknown {
+		return fmt.Errorf("%T does not have a schema: %w", obj, ErrUnsupported)
+	}
+	v := reflect.ValueOf(obj)
+
+	// marshal
+	buf, err := catch(obj.MarshalSSZ)
+	refBuf, refErr := refMarshal(schema, v, nil, "")
+	if errors.Is(refErr, ErrUnsupported) {
+		return refErr
+	}
+	switch {
+	case err != nil && refErr != nil:
+		return fmt.Errorf("%w: %v", ErrInvalidObject, err)
+	case err != nil:
+		return &Divergence{Stage: "marshal", Detail: fmt.Sprintf("failed to encode: %v", err)}
+	case refErr != nil:
+		return &Divergence{Stage: "marshal", Field: errorField(refErr), Detail: fmt.Sprintf("encoded an invalid object: %v", refErr)}
+	}
+	if !bytes.Equal(buf, refBuf) {
+		// the field with the divergent encoding is found by decoding it with the reference
+		decoded := reflect.New(v.Type().Elem())
+		if err := refUnmarshal(schema, decoded, buf, ""); err != nil {
+			return &Divergence{Stage: "marshal", Field: errorField(err), Detail: fmt.Sprintf("invalid encoding %x: %v", buf, err)}
+		}
+		field, _ := diffValue(schema, v, decoded, "")
+		return &Divergence{Stage: "marshal", Field: field, Detail: fmt.Sprintf("encoding is\n%x\nbut expected\n%x", buf, refBuf)}
+	}
+
+	// unmarshal
+	decoded := reflect.New(v.Type().Elem())
+	_, err = catch(func() ([]byte, error) {
+		return nil, decoded.Interface().(Object).UnmarshalSSZ(buf)
+	})
+	refDecoded := reflect.New(v.Type().Elem())
+	refErr = refUnmarshal(schema, refDecoded, buf, "")
+	if errors.Is(refErr, ErrUnsupported) {
+		return refErr
+	}
+	switch {
+	case err != nil && refErr != nil:
+		return fmt.Errorf("%w: %v", ErrInvalidObject, err)
+	case err != nil:
+		return &Divergence{Stage: "unmarshal", Detail: fmt.Sprintf("failed to decode %x: %v", buf, err)}
+	case refErr != nil:
+		return &Divergence{Stage: "unmarshal", Field: errorField(refErr), Detail: fmt.Sprintf("decoded an invalid encoding: %v", refErr)}
+	}
+	if field, ok := diffValue(schema, decoded, refDecoded, ""); ok {
+		return &Divergence{Stage: "unmarshal", Field: field, Detail: "decoded objects are different"}
+	}
+
+	// size
+	if size := obj.SizeSSZ(); size != len(buf) {
+		return &Divergence{Stage: "size", Detail: fmt.Sprintf("size is %d but the encoding has %d bytes", size, len(buf))}
+	}
+
+	// hash
+	refNode, err := refTree(schema, v, "")
+	if err != nil {
+		return err
+	}
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		return &Divergence{Stage: "hash", Detail: fmt.Sprintf("failed to hash: %v", err)}
+	}
+	node, treeErr := obj.GetTree()
+	if !bytes.Equal(root[:], refNode.Hash()) {
+		var field string
+		if treeErr == nil && bytes.Equal(node.Hash(), root[:]) {
+			// the tree has the same root, the divergent field is found in the tree
+			field = diffTree(schema, v, node, refNode, "")
+		} else if field, err = diffRoots(schema, v, ""); err != nil {
+			return err
+		}
+		return &Divergence{Stage: "hash", Field: field, Detail: fmt.Sprintf("root is %x but expected %x", root, refNode.Hash())}
+	}
+
+	// tree
+	if treeErr != nil {
+		return &Divergence{Stage: "tree", Detail: fmt.Sprintf("failed to build the tree: %v", treeErr)}
+	}
+	if !bytes.Equal(node.Hash(), refNode.Hash()) {
+		field := diffTree(schema, v, node, refNode, "")
+		return &Divergence{Stage: "tree", Field: field, Detail: fmt.Sprintf("root is %x but expected %x", node.Hash(), refNode.Hash())}
+	}
+	return nil
+}
+
+// catch calls fn and returns the panics as errors, the generated methods panic
+// with some invalid objects like nil elements in lists of containers
+func catch(fn func() ([]byte, error)) (buf []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return fn()
+}
+
+// diffValue returns the path of the first field with a different encoding in a and b.
+// The nil pointers are equal to the zero values and the nil slices to the empty ones.
+func diffValue(s *ssz.Schema, a, b reflect.Value, field string) (string, bool) {
+	a, b = indirect(a), indirect(b)
+
+	switch s.Kind {
+	case ssz.KindContainer:
+		if a.Kind() != reflect.Struct || b.Kind() != reflect.Struct {
+			break
+		}
+		for _, f := range s.Fields {
+			if sub, ok := diffValue(f.Schema, a.FieldByName(f.Name), b.FieldByName(f.Name), subField(field, f.Name)); ok {
+				return sub, true
+			}
+		}
+		return "", false
+
+	case ssz.KindVector, ssz.KindList:
+		if s.Elem.IsBasic() || !isSequence(a) || !isSequence(b) {
+			break
+		}
+		if a.Len() != b.Len() {
+			return field, true
+		}
+		for i := 0; i < a.Len(); i++ {
+			if sub, ok := diffValue(s.Elem, a.Index(i), b.Index(i), indexField(field, i)); ok {
+				return sub, true
+			}
+		}
+		return "", false
+	}
+
+	bufA, errA := refMarshal(s, a, nil, field)
+	bufB, errB := refMarshal(s, b, nil, field)
+	if (errA == nil) != (errB == nil) || !bytes.Equal(bufA, bufB) {
+		return field, true
+	}
+	return "", false
+}
+
+// diffRoots returns the path of the deepest object whose HashTreeRoot is not the
+// root of its reference tree, the object itself if none of its fields diverge
+func diffRoots(s *ssz.Schema, v reflect.Value, field string) (string, error) {
+	v = indirect(v)
+
+	children := []reflect.Value{}
+	schemas := []*ssz.Schema{}
+	fields := []string{}
+	switch s.Kind {
+	case ssz.KindContainer:
+		for _, f := range s.Fields {
+			children = append(children, v.FieldByName(f.Name))
+			schemas = append(schemas, f.Schema)
+			fields = append(fields, subField(field, f.Name))
+		}
+	case ssz.KindVector, ssz.KindList:
+		if s.Elem.IsBasic() {
+			break
+		}
+		for i := 0; i < v.Len(); i++ {
+			children = append(children, v.Index(i))
+			schemas = append(schemas, s.Elem)
+			fields = append(fields, indexField(field, i))
+		}
+	}
+
+	for i, child := range children {
+		child = indirect(child)
+		if schemas[i].Kind != ssz.KindContainer {
+			// lists and vectors of containers are hashed by the container
+			if sub, err := diffRoots(schemas[i], child, fields[i]); err != nil || sub != fields[i] {
+				return sub, err
+			}
+			continue
+		}
+		h, ok := iface(child).(ssz.HashRoot)
+		if !ok {
+			continue
+		}
+		root, err := h.HashTreeRoot()
+		if err != nil {
+			return fields[i], nil
+		}
+		node, err := refTree(schemas[i], child, fields[i])
+		if err != nil {
+			return "", err
+		}
+		if !bytes.Equal(root[:], node.Hash()) {
+			return diffRoots(schemas[i], child, fields[i])
+		}
+	}
+	return field, nil
+}
+
+// diffTree returns the path of the deepest subtree of the value v that is different
+// in the tree and in the reference tree
+func diffTree(s *ssz.Schema, v reflect.Value, node, ref *ssz.Node, field string) string {
+	v = indirect(v)
+
+	children := []reflect.Value{}
+	schemas := []*ssz.Schema{}
+	fields := []string{}
+	gindices := []int{}
+	switch s.Kind {
+	case ssz.KindContainer:
+		depth := treeDepth(uint64(len(s.Fields)))
+		for i, f := range s.Fields {
+			children = append(children, v.FieldByName(f.Name))
+			schemas = append(schemas, f.Schema)
+			fields = append(fields, subField(field, f.Name))
+			gindices = append(gindices, 1<<depth+i)
+		}
+	case ssz.KindVector, ssz.KindList:
+		if s.Elem.IsBasic() {
+			break
+		}
+		limit, base := s.Size, 1
+		if s.Kind == ssz.KindList {
+			// the elements are on the left of the length mixin
+			limit, base = s.Limit, 2
+		}
+		depth := treeDepth(limit)
+		for i := 0; i < v.Len(); i++ {
+			children = append(children, v.Index(i))
+			schemas = append(schemas, s.Elem)
+			fields = append(fields, indexField(field, i))
+			gindices = append(gindices, base<<depth+i)
+		}
+	}
+
+	for i := range children {
+		sub, err := node.Get(gindices[i])
+		if err != nil {
+			return field
+		}
+		refSub, err := ref.Get(gindices[i])
+		if err != nil {
+			return field
+		}
+		if !bytes.Equal(sub.Hash(), refSub.Hash()) {
+			return diffTree(schemas[i], children[i], sub, refSub, fields[i])
+		}
+	}
+	return field
+}
+
+// treeDepth returns the depth of a tree with n leaves
+func treeDepth(n uint64) int {
+	depth := 0
+	for 1<<depth < nextPow2(n) {
+		depth++
+	}
+	return depth
+}
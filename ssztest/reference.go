@@ -0,0 +1,666 @@
+package ssztest
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"reflect"
+	"time"
+
+	ssz "github.com/NilFoundation/fastssz"
+)
+
+// The reference implementation of the ssz encoding, decoding and hashing used by
+// CheckDifferential. It follows the schema of the objects with reflection and builds
+// the roots with ssz.TreeFromNodes, it is slow but it does not share any code with
+// the methods generated by sszgen. The nil pointers of the fields are encoded and hashed
+// as zero values.
+
+// ErrUnsupported is returned by CheckDifferential if the object has values that the
+// reference does not know how to encode, like the values of custom codecs.
+var ErrUnsupported = errors.New("not supported by the reference")
+
+// ErrInvalidObject is returned by CheckDifferential if both the generated methods and
+// the reference fail to encode the object or to decode its encoding, so they are not
+// compared.
+var ErrInvalidObject = errors.New("invalid object rejected by the generated methods and the reference")
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// refError is an error of the reference in a field of the object
+type refError struct {
+	field string
+	err   error
+}
+
+func (e *refError) Error() string {
+	return fieldName(e.field) + ": " + e.err.Error()
+}
+
+func (e *refError) Unwrap() error {
+	return e.err
+}
+
+func errorf(field string, format string, a ...interface{}) error {
+	return &refError{field: field, err: fmt.Errorf(format, a...)}
+}
+
+func unsupported(field string, s *ssz.Schema, v reflect.Value) error {
+	return &refError{field: field, err: fmt.Errorf("%w: %s value of type %s", ErrUnsupported, s.Kind, v.Type())}
+}
+
+// errorField returns the field of an error of the reference
+func errorField(err error) string {
+	var e *refError
+	if errors.As(err, &e) {
+		return e.field
+	}
+	return ""
+}
+
+func fieldName(field string) string {
+	if field == "" {
+		return "object"
+	}
+	return field
+}
+
+func subField(field, name string) string {
+	if field == "" {
+		return name
+	}
+	return field + "." + name
+}
+
+func indexField(field string, i int) string {
+	return fmt.Sprintf("%s[%d]", field, i)
+}
+
+// elemField returns the path of an element, the path of the basic elements
+// is the path of the vector or the list to not build it for each of them
+func elemField(s *ssz.Schema, field string, i int) string {
+	if s.Elem.IsBasic() {
+		return field
+	}
+	return indexField(field, i)
+}
+
+// indirect follows the pointers of v, the nil pointers result in an addressable zero value
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.New(v.Type().Elem()).Elem()
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// isNil returns true if v is a nil pointer. Unlike the fields of the containers, the
+// nil elements of the vectors and the lists are not encoded as zero values, like in
+// the generated methods.
+func isNil(v reflect.Value) bool {
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// alloc follows the pointers of v and allocates the nil ones
+func alloc(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// iface returns the value that implements the ssz interfaces, either v or its address
+func iface(v reflect.Value) interface{} {
+	if v.CanAddr() {
+		return v.Addr().Interface()
+	}
+	return v.Interface()
+}
+
+func isSequence(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+}
+
+// isBytes returns true if v is a sequence of bytes described by a schema of uint8 elements
+func isBytes(s *ssz.Schema, v reflect.Value) bool {
+	return s.Elem.Kind == ssz.KindUint && s.Elem.Size == 1 && v.Type().Elem().Kind() == reflect.Uint8
+}
+
+func fixedSize(s *ssz.Schema) (int, bool) {
+	switch s.Kind {
+	case ssz.KindUint, ssz.KindBool:
+		return int(s.Size), true
+	case ssz.KindVector:
+		size, ok := fixedSize(s.Elem)
+		return size * int(s.Size), ok
+	case ssz.KindContainer:
+		total := 0
+		for _, f := range s.Fields {
+			size, ok := fixedSize(f.Schema)
+			if !ok {
+				return 0, false
+			}
+			total += size
+		}
+		return total, true
+	case ssz.KindUnknown:
+		return int(s.Size), s.Size != 0
+	default:
+		return 0, false
+	}
+}
+
+// refMarshal appends the encoding of the value v described by the schema s to dst
+func refMarshal(s *ssz.Schema, v reflect.Value, dst []byte, field string) ([]byte, error) {
+	v = indirect(v)
+
+	switch s.Kind {
+	case ssz.KindUint:
+		buf, err := uintBytes(s, v, field)
+		if err != nil {
+			return nil, err
+		}
+		return append(dst, buf...), nil
+
+	case ssz.KindBool:
+		if v.Kind() != reflect.Bool {
+			return nil, unsupported(field, s, v)
+		}
+		if v.Bool() {
+			return append(dst, 1), nil
+		}
+		return append(dst, 0), nil
+
+	case ssz.KindVector:
+		if !isSequence(v) {
+			return nil, unsupported(field, s, v)
+		}
+		if v.Len() != int(s.Size) {
+			return nil, errorf(field, "vector has %d elements but expected %d", v.Len(), s.Size)
+		}
+		return marshalElems(s, v, dst, field)
+
+	case ssz.KindList:
+		if !isSequence(v) {
+			return nil, unsupported(field, s, v)
+		}
+		if uint64(v.Len()) > s.Limit {
+			return nil, errorf(field, "list has %d elements but the limit is %d", v.Len(), s.Limit)
+		}
+		return marshalElems(s, v, dst, field)
+
+	case ssz.KindBitlist:
+		if v.Kind() != reflect.Slice || v.Type().Elem().Kind() != reflect.Uint8 {
+			return nil, unsupported(field, s, v)
+		}
+		// the bitlist is encoded as it is, the length bit is checked when it is decoded
+		if uint64(v.Len()) > s.Limit/8+1 {
+			return nil, errorf(field, "bitlist has %d bytes but the limit is %d bits", v.Len(), s.Limit)
+		}
+		return append(dst, v.Bytes()...), nil
+
+	case ssz.KindContainer:
+		if v.Kind() != reflect.Struct {
+			return nil, unsupported(field, s, v)
+		}
+		elems := make([]reflect.Value, len(s.Fields))
+		schemas := make([]*ssz.Schema, len(s.Fields))
+		fields := make([]string, len(s.Fields))
+		for i, f := range s.Fields {
+			elems[i] = v.FieldByName(f.Name)
+			if !elems[i].IsValid() {
+				return nil, errorf(field, "field %s of the schema not found in %s", f.Name, v.Type())
+			}
+			schemas[i] = f.Schema
+			fields[i] = subField(field, f.Name)
+		}
+		return marshalSequence(schemas, elems, fields, dst)
+
+	default:
+		m, ok := iface(v).(ssz.Marshaler)
+		if !ok {
+			return nil, unsupported(field, s, v)
+		}
+		buf, err := m.MarshalSSZ()
+		if err != nil {
+			return nil, errorf(field, "%v", err)
+		}
+		return append(dst, buf...), nil
+	}
+}
+
+// uintBytes returns the little endian bytes of an uint, a time or a byte array of the size of the uint
+func uintBytes(s *ssz.Schema, v reflect.Value, field string) ([]byte, error) {
+	if v.Type() == timeType && s.Size == 8 {
+		return binary.LittleEndian.AppendUint64(nil, uint64(v.Interface().(time.Time).Unix())), nil
+	}
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if s.Size > 8 || uint64(v.Type().Size()) != s.Size {
+			return nil, unsupported(field, s, v)
+		}
+		return binary.LittleEndian.AppendUint64(nil, v.Uint())[:s.Size], nil
+
+	case reflect.Array, reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return nil, unsupported(field, s, v)
+		}
+		if v.Len() != int(s.Size) {
+			return nil, errorf(field, "uint has %d bytes but expected %d", v.Len(), s.Size)
+		}
+		buf := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(buf), v)
+		return buf, nil
+	}
+	return nil, unsupported(field, s, v)
+}
+
+// marshalElems appends the encoding of the elements of a vector or a list
+func marshalElems(s *ssz.Schema, v reflect.Value, dst []byte, field string) ([]byte, error) {
+	if isBytes(s, v) {
+		buf := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(buf), v)
+		return append(dst, buf...), nil
+	}
+	elems := make([]reflect.Value, v.Len())
+	schemas := make([]*ssz.Schema, v.Len())
+	fields := make([]string, v.Len())
+	for i := range elems {
+		elems[i], schemas[i], fields[i] = v.Index(i), s.Elem, elemField(s, field, i)
+		if isNil(elems[i]) {
+			return nil, errorf(fields[i], "nil element")
+		}
+	}
+	return marshalSequence(schemas, elems, fields, dst)
+}
+
+// marshalSequence appends the encoding of a sequence of values, the fixed part with
+// the fixed size values and the offsets of the variable size values and the variable part
+func marshalSequence(schemas []*ssz.Schema, elems []reflect.Value, fields []string, dst []byte) ([]byte, error) {
+	fixed := 0
+	for _, s := range schemas {
+		if size, ok := fixedSize(s); ok {
+			fixed += size
+		} else {
+			fixed += 4
+		}
+	}
+
+	variable := []byte{}
+	for i, s := range schemas {
+		if _, ok := fixedSize(s); ok {
+			var err error
+			if dst, err = refMarshal(s, elems[i], dst, fields[i]); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		dst = binary.LittleEndian.AppendUint32(dst, uint32(fixed+len(variable)))
+		var err error
+		if variable, err = refMarshal(s, elems[i], variable, fields[i]); err != nil {
+			return nil, err
+		}
+	}
+	return append(dst, variable...), nil
+}
+
+// refUnmarshal decodes buf into the value v described by the schema s. It follows
+// the spec strictly, the encodings that are not canonical are rejected.
+func refUnmarshal(s *ssz.Schema, v reflect.Value, buf []byte, field string) error {
+	v = alloc(v)
+
+	switch s.Kind {
+	case ssz.KindUint:
+		if len(buf) != int(s.Size) {
+			return errorf(field, "uint has %d bytes but expected %d", len(buf), s.Size)
+		}
+		if v.Type() == timeType && s.Size == 8 {
+			v.Set(reflect.ValueOf(time.Unix(int64(binary.LittleEndian.Uint64(buf)), 0)))
+			return nil
+		}
+		switch v.Kind() {
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if s.Size > 8 || uint64(v.Type().Size()) != s.Size {
+				return unsupported(field, s, v)
+			}
+			v.SetUint(binary.LittleEndian.Uint64(append(append([]byte{}, buf...), make([]byte, 8-len(buf))...)))
+			return nil
+		case reflect.Array, reflect.Slice:
+			if v.Type().Elem().Kind() != reflect.Uint8 {
+				return unsupported(field, s, v)
+			}
+			return setBytes(v, buf, field)
+		}
+		return unsupported(field, s, v)
+
+	case ssz.KindBool:
+		if v.Kind() != reflect.Bool {
+			return unsupported(field, s, v)
+		}
+		if len(buf) != 1 || buf[0] > 1 {
+			return errorf(field, "invalid bool %x", buf)
+		}
+		v.SetBool(buf[0] == 1)
+		return nil
+
+	case ssz.KindVector:
+		if !isSequence(v) {
+			return unsupported(field, s, v)
+		}
+		if size, ok := fixedSize(s.Elem); ok && len(buf) != size*int(s.Size) {
+			return errorf(field, "vector has %d bytes but expected %d", len(buf), size*int(s.Size))
+		}
+		return unmarshalElems(s, v, buf, int(s.Size), field)
+
+	case ssz.KindList:
+		if !isSequence(v) {
+			return unsupported(field, s, v)
+		}
+		num := 0
+		if size, ok := fixedSize(s.Elem); ok {
+			if size == 0 || len(buf)%size != 0 {
+				return errorf(field, "list has %d bytes, not a multiple of the size %d of the elements", len(buf), size)
+			}
+			num = len(buf) / size
+		} else if len(buf) != 0 {
+			if len(buf) < 4 {
+				return errorf(field, "list has %d bytes, not enough for the first offset", len(buf))
+			}
+			first := binary.LittleEndian.Uint32(buf)
+			if first%4 != 0 || first == 0 {
+				return errorf(field, "first offset %d is not a multiple of 4", first)
+			}
+			num = int(first / 4)
+		}
+		if uint64(num) > s.Limit {
+			return errorf(field, "list has %d elements but the limit is %d", num, s.Limit)
+		}
+		return unmarshalElems(s, v, buf, num, field)
+
+	case ssz.KindBitlist:
+		if v.Kind() != reflect.Slice || v.Type().Elem().Kind() != reflect.Uint8 {
+			return unsupported(field, s, v)
+		}
+		if _, err := bitlistLen(buf, s.Limit); err != nil {
+			return errorf(field, "%v", err)
+		}
+		return setBytes(v, buf, field)
+
+	case ssz.KindContainer:
+		if v.Kind() != reflect.Struct {
+			return unsupported(field, s, v)
+		}
+		elems := make([]reflect.Value, len(s.Fields))
+		schemas := make([]*ssz.Schema, len(s.Fields))
+		fields := make([]string, len(s.Fields))
+		for i, f := range s.Fields {
+			elems[i] = v.FieldByName(f.Name)
+			if !elems[i].IsValid() {
+				return errorf(field, "field %s of the schema not found in %s", f.Name, v.Type())
+			}
+			schemas[i] = f.Schema
+			fields[i] = subField(field, f.Name)
+		}
+		return unmarshalSequence(schemas, elems, fields, buf, field)
+
+	default:
+		u, ok := iface(v).(ssz.Unmarshaler)
+		if !ok {
+			return unsupported(field, s, v)
+		}
+		if s.Size != 0 && len(buf) != int(s.Size) {
+			return errorf(field, "value has %d bytes but expected %d", len(buf), s.Size)
+		}
+		if err := u.UnmarshalSSZ(buf); err != nil {
+			return errorf(field, "%v", err)
+		}
+		return nil
+	}
+}
+
+func setBytes(v reflect.Value, buf []byte, field string) error {
+	if v.Kind() == reflect.Array {
+		if v.Len() != len(buf) {
+			return errorf(field, "array has %d bytes but the encoding has %d", v.Len(), len(buf))
+		}
+	} else {
+		v.Set(reflect.MakeSlice(v.Type(), len(buf), len(buf)))
+	}
+	reflect.Copy(v, reflect.ValueOf(buf))
+	return nil
+}
+
+// unmarshalElems decodes num elements of a vector or a list
+func unmarshalElems(s *ssz.Schema, v reflect.Value, buf []byte, num int, field string) error {
+	if v.Kind() == reflect.Array {
+		if v.Len() != num {
+			return errorf(field, "array has %d elements but the encoding has %d", v.Len(), num)
+		}
+	} else {
+		v.Set(reflect.MakeSlice(v.Type(), num, num))
+	}
+	if isBytes(s, v) {
+		reflect.Copy(v, reflect.ValueOf(buf))
+		return nil
+	}
+	elems := make([]reflect.Value, num)
+	schemas := make([]*ssz.Schema, num)
+	fields := make([]string, num)
+	for i := range elems {
+		elems[i], schemas[i], fields[i] = v.Index(i), s.Elem, elemField(s, field, i)
+	}
+	return unmarshalSequence(schemas, elems, fields, buf, field)
+}
+
+// unmarshalSequence decodes a sequence of values. The first offset must be the end of
+// the fixed part, the offsets cannot decrease and the last one cannot be out of the buffer.
+func unmarshalSequence(schemas []*ssz.Schema, elems []reflect.Value, fields []string, buf []byte, field string) error {
+	fixed := 0
+	sizes := make([]int, len(schemas))
+	for i, s := range schemas {
+		size, ok := fixedSize(s)
+		if !ok {
+			size = -1
+			fixed += 4
+		} else {
+			fixed += size
+		}
+		sizes[i] = size
+	}
+	if len(buf) < fixed {
+		return errorf(field, "encoding has %d bytes but the fixed part has %d", len(buf), fixed)
+	}
+
+	pos := 0
+	offsets := []int{}
+	for i, size := range sizes {
+		if size == -1 {
+			offsets = append(offsets, int(binary.LittleEndian.Uint32(buf[pos:pos+4])))
+			pos += 4
+			continue
+		}
+		if err := refUnmarshal(schemas[i], elems[i], buf[pos:pos+size], fields[i]); err != nil {
+			return err
+		}
+		pos += size
+	}
+	if len(offsets) == 0 {
+		if len(buf) != fixed {
+			return errorf(field, "encoding has %d bytes but expected %d", len(buf), fixed)
+		}
+		return nil
+	}
+	if offsets[0] != fixed {
+		return errorf(field, "first offset %d is not the size %d of the fixed part", offsets[0], fixed)
+	}
+	offsets = append(offsets, len(buf))
+
+	indx := 0
+	for i, size := range sizes {
+		if size != -1 {
+			continue
+		}
+		start, end := offsets[indx], offsets[indx+1]
+		if start > end {
+			return errorf(fields[i], "offset %d is higher than the next one %d", start, end)
+		}
+		if err := refUnmarshal(schemas[i], elems[i], buf[start:end], fields[i]); err != nil {
+			return err
+		}
+		indx++
+	}
+	return nil
+}
+
+// bitlistLen returns the number of bits of a bitlist, it fails if the bitlist
+// does not have the length bit or it has more bits than the limit
+func bitlistLen(buf []byte, limit uint64) (uint64, error) {
+	if len(buf) == 0 || buf[len(buf)-1] == 0 {
+		return 0, fmt.Errorf("bitlist does not have the length bit")
+	}
+	num := uint64(8*(len(buf)-1) + bits.Len8(buf[len(buf)-1]) - 1)
+	if num > limit {
+		return 0, fmt.Errorf("bitlist has %d bits but the limit is %d", num, limit)
+	}
+	return num, nil
+}
+
+// refTree returns the merkle tree of the value v described by the schema s
+func refTree(s *ssz.Schema, v reflect.Value, field string) (*ssz.Node, error) {
+	v = indirect(v)
+
+	switch s.Kind {
+	case ssz.KindUint, ssz.KindBool:
+		buf, err := refMarshal(s, v, nil, field)
+		if err != nil {
+			return nil, err
+		}
+		if len(buf) > 32 {
+			return nil, unsupported(field, s, v)
+		}
+		return leaves(buf)[0], nil
+
+	case ssz.KindVector:
+		if s.Elem.IsBasic() {
+			buf, err := refMarshal(s, v, nil, field)
+			if err != nil {
+				return nil, err
+			}
+			return ssz.TreeFromNodes(leaves(buf), nextPow2((s.Size*s.Elem.Size+31)/32))
+		}
+		if !isSequence(v) {
+			return nil, unsupported(field, s, v)
+		}
+		if v.Len() != int(s.Size) {
+			return nil, errorf(field, "vector has %d elements but expected %d", v.Len(), s.Size)
+		}
+		nodes, err := elemTrees(s, v, field)
+		if err != nil {
+			return nil, err
+		}
+		return ssz.TreeFromNodes(nodes, nextPow2(s.Size))
+
+	case ssz.KindList:
+		if s.Elem.IsBasic() {
+			buf, err := refMarshal(s, v, nil, field)
+			if err != nil {
+				return nil, err
+			}
+			return ssz.TreeFromNodesWithMixin(leaves(buf), v.Len(), nextPow2((s.Limit*s.Elem.Size+31)/32))
+		}
+		if !isSequence(v) {
+			return nil, unsupported(field, s, v)
+		}
+		if uint64(v.Len()) > s.Limit {
+			return nil, errorf(field, "list has %d elements but the limit is %d", v.Len(), s.Limit)
+		}
+		nodes, err := elemTrees(s, v, field)
+		if err != nil {
+			return nil, err
+		}
+		return ssz.TreeFromNodesWithMixin(nodes, len(nodes), nextPow2(s.Limit))
+
+	case ssz.KindBitlist:
+		buf, err := refMarshal(s, v, nil, field)
+		if err != nil {
+			return nil, err
+		}
+		num, err := bitlistLen(buf, s.Limit)
+		if err != nil {
+			return nil, errorf(field, "%v", err)
+		}
+		// remove the length bit and the bytes without bits
+		buf[len(buf)-1] &^= 1 << (num % 8)
+		buf = buf[:(num+7)/8]
+		return ssz.TreeFromNodesWithMixin(leaves(buf), int(num), nextPow2((s.Limit+255)/256))
+
+	case ssz.KindContainer:
+		if v.Kind() != reflect.Struct {
+			return nil, unsupported(field, s, v)
+		}
+		nodes := make([]*ssz.Node, len(s.Fields))
+		for i, f := range s.Fields {
+			elem := v.FieldByName(f.Name)
+			if !elem.IsValid() {
+				return nil, errorf(field, "field %s of the schema not found in %s", f.Name, v.Type())
+			}
+			node, err := refTree(f.Schema, elem, subField(field, f.Name))
+			if err != nil {
+				return nil, err
+			}
+			nodes[i] = node
+		}
+		return ssz.TreeFromNodes(nodes, nextPow2(uint64(len(nodes))))
+
+	default:
+		h, ok := iface(v).(ssz.HashRoot)
+		if !ok {
+			return nil, unsupported(field, s, v)
+		}
+		node, err := ssz.ProofTree(h)
+		if err != nil {
+			return nil, errorf(field, "%v", err)
+		}
+		return node, nil
+	}
+}
+
+func elemTrees(s *ssz.Schema, v reflect.Value, field string) ([]*ssz.Node, error) {
+	nodes := make([]*ssz.Node, v.Len())
+	for i := range nodes {
+		if isNil(v.Index(i)) {
+			return nil, errorf(indexField(field, i), "nil element")
+		}
+		node, err := refTree(s.Elem, v.Index(i), indexField(field, i))
+		if err != nil {
+			return nil, err
+		}
+		nodes[i] = node
+	}
+	return nodes, nil
+}
+
+// leaves splits the buffer in chunks of 32 bytes, the last one padded with zeros
+func leaves(buf []byte) []*ssz.Node {
+	nodes := []*ssz.Node{}
+	for i := 0; i < len(buf); i += 32 {
+		chunk := make([]byte, 32)
+		copy(chunk, buf[i:])
+		nodes = append(nodes, ssz.LeafFromBytes(chunk))
+	}
+	return nodes
+}
+
+// nextPow2 returns the next power of two of n as the limit of a tree
+func nextPow2(n uint64) int {
+	if n <= 1 {
+		return 1
+	}
+	return 1 << bits.Len64(n-1)
+}
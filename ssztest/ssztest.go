@@ -0,0 +1,332 @@
+// Package ssztest contains the helpers used by the tests generated by sszgen
+// to check the round trip and the canonical encoding of the ssz objects.
+package ssztest
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	ssz "github.com/NilFoundation/fastssz"
+	"github.com/NilFoundation/fastssz/fuzz"
+	"github.com/NilFoundation/fastssz/spectest"
+	"github.com/golang/snappy"
+)
+
+// Object is the interface implemented by the objects generated by sszgen
+type Object interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+// RoundTripCount is the number of random objects checked by RoundTrip
+// besides the zero value.
+var RoundTripCount = 10
+
+// RoundTrip checks that the zero value and RoundTripCount random objects created with
+// the fuzz package are encoded, decoded to an equal object and encoded again to the same
+// bytes and root. The random objects are created with fixed seeds so that the failures
+// are reproducible and the failures report the object minimized by fuzz.Shrink as a Go
+// literal. Objects that are not valid, like the zero value of a struct with fixed size
+// slices, cannot be encoded and are skipped, but an encoding that cannot be decoded is
+// a failure and so is a type without any object that can be encoded.
+func RoundTrip(t *testing.T, newObj func() Object) {
+	t.Helper()
+
+	checked := 0
+	for i := -1; i < RoundTripCount; i++ {
+		obj := newObj()
+		if i >= 0 && !fill(obj, int64(i)) {
+			continue
+		}
+		ok, err := roundTrip(obj, newObj)
+		if err != nil {
+			t.Fatalf("round trip of %T (seed %d): %v\n%s", obj, i, err, reproducer(obj, func(obj Object) error {
+				_, err := roundTrip(obj, newObj)
+				return err
+			}))
+		}
+		if ok {
+			checked++
+		}
+	}
+	if checked == 0 {
+		t.Fatalf("none of the objects of %T filled by the fuzzer can be encoded", newObj())
+	}
+}
+
+// roundTrip checks the round trip of an object, it returns false if the object
+// cannot be encoded. An encoding that cannot be decoded is an error.
+func roundTrip(obj Object, newObj func() Object) (bool, error) {
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		return false, nil
+	}
+	obj2 := newObj()
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		return true, fmt.Errorf("failed to decode the encoding: %v", err)
+	}
+	return true, checkRoundTrip(obj, obj2, buf, newObj)
+}
+
+// reproducer returns the Go literal of the object minimized with fuzz.Shrink while
+// the check fails, to paste it in a regression test. The object is modified.
+func reproducer(obj Object, check func(obj Object) error) string {
+	failing := func(obj interface{}) bool {
+		return check(obj.(Object)) != nil
+	}
+	if err := fuzz.Shrink(obj, failing); err != nil {
+		return ""
+	}
+	src, err := fuzz.GoLiteral(obj)
+	if err != nil {
+		return ""
+	}
+	return "minimal reproducer:\n" + src
+}
+
+// fill fills obj with random values, it returns false if the fuzzer
+// does not know how to fill the object
+func fill(obj Object, seed int64) (ok bool) {
+	return fillWith(obj, seed, fuzz.StrategyRandom)
+}
+
+func fillWith(obj Object, seed int64, strategy fuzz.Strategy) (ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	f := fuzz.NewWithSeed(seed)
+	f.SetStrategy(strategy)
+	f.Fuzz(obj)
+	return true
+}
+
+// checkRoundTrip checks an object and the object decoded from its encoding
+func checkRoundTrip(obj, obj2 Object, buf []byte, newObj func() Object) error {
+	if size := obj.SizeSSZ(); size != len(buf) {
+		return fmt.Errorf("size is %d but the encoding has %d bytes", size, len(buf))
+	}
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		return fmt.Errorf("failed to hash: %v", err)
+	}
+
+	if err := checkCanonical(obj2, buf); err != nil {
+		return err
+	}
+	// the decoded object must be equal to the object, the fields that are not
+	// encoded are ignored if the object has a schema and the nil slices are
+	// equal to the empty ones
+	if schema := ssz.SchemaOf(obj); schema.Kind != ssz.KindUnknown {
+		if field, ok := diffValue(schema, reflect.ValueOf(obj), reflect.ValueOf(obj2), ""); ok {
+			return fmt.Errorf("field '%s' of the decoded object is different", field)
+		}
+	} else if !spectest.Equal(obj, obj2) {
+		return fmt.Errorf("decoded object is different from the encoded object")
+	}
+	root2, err := obj2.HashTreeRoot()
+	if err != nil {
+		return fmt.Errorf("failed to hash the decoded object: %v", err)
+	}
+	if root != root2 {
+		return fmt.Errorf("root of the decoded object is %x but expected %x", root2, root)
+	}
+
+	// decoding the same bytes again must result in the same object
+	obj3 := newObj()
+	if err := obj3.UnmarshalSSZ(buf); err != nil {
+		return fmt.Errorf("failed to decode: %v", err)
+	}
+	if !reflect.DeepEqual(obj2, obj3) {
+		return fmt.Errorf("decoding the same bytes results in different objects")
+	}
+	return nil
+}
+
+// checkCanonical checks that an object decoded from buf is encoded to the same bytes
+// and that its tree has the same root as HashTreeRoot
+func checkCanonical(obj Object, buf []byte) error {
+	buf2, err := obj.MarshalSSZ()
+	if err != nil {
+		return fmt.Errorf("failed to encode the decoded object: %v", err)
+	}
+	if !bytes.Equal(buf, buf2) {
+		return fmt.Errorf("encoding of the decoded object is not canonical:\n%x\n%x", buf, buf2)
+	}
+	if size := obj.SizeSSZ(); size != len(buf) {
+		return fmt.Errorf("size of the decoded object is %d but the encoding has %d bytes", size, len(buf))
+	}
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		return fmt.Errorf("failed to hash the decoded object: %v", err)
+	}
+	tree, err := obj.GetTree()
+	if err != nil {
+		return fmt.Errorf("failed to build the tree of the decoded object: %v", err)
+	}
+	if !bytes.Equal(tree.Hash(), root[:]) {
+		return fmt.Errorf("root of the tree is %x but expected %x", tree.Hash(), root)
+	}
+	return nil
+}
+
+// MutationCount is the number of malformed encodings checked by Malformed
+// for each object and added to the corpus of Fuzz for each seed.
+var MutationCount = 32
+
+// Malformed checks the malformed encodings created with fuzz.Mutator from the encodings of
+// the zero value and RoundTripCount objects filled with fuzz.StrategyBoundary, like offsets
+// out of bounds or bitlists without the length bit. Each of them must either fail to decode
+// or decode to an object that is encoded again to the same bytes. The objects must implement
+// ssz.SchemaProvider to know their layout.
+func Malformed(t *testing.T, newObj func() Object) {
+	t.Helper()
+
+	schema := ssz.SchemaOf(newObj())
+	if schema.Kind == ssz.KindUnknown {
+		t.Skipf("%T does not have a schema", newObj())
+	}
+	checked := 0
+	for i := -1; i < RoundTripCount; i++ {
+		// the mutations of the sizes at the edges of the limits
+		// are more likely to reach the checks of the decoder
+		obj := newObj()
+		if i >= 0 && !fillWith(obj, int64(i), fuzz.StrategyBoundary) {
+			continue
+		}
+		buf, ok := encode(obj)
+		if !ok {
+			continue
+		}
+		if err := newObj().UnmarshalSSZ(buf); err != nil {
+			continue
+		}
+		mutations, err := fuzz.NewMutator(schema, int64(i)).Mutations(buf, MutationCount)
+		if err != nil {
+			t.Fatalf("layout of %T (seed %d): %v", obj, i, err)
+		}
+		for _, m := range mutations {
+			if err := CheckMalformed(newObj, m.Data); err != nil {
+				t.Fatalf("%s of %T (seed %d): %v", m, obj, i, err)
+			}
+		}
+		checked++
+	}
+	if checked == 0 {
+		t.Fatalf("none of the objects of %T filled by the fuzzer can be encoded", newObj())
+	}
+}
+
+// encode returns the encoding of the object, if it can be encoded. The nil elements
+// of the lists and vectors of containers (i.e. in the zero value) are not encoded.
+func encode(obj Object) (buf []byte, ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	buf, err := obj.MarshalSSZ()
+	return buf, err == nil
+}
+
+// CheckMalformed checks that the encoding either fails to decode into the objects of
+// newObj or it is decoded to an object that is encoded again to the same bytes.
+func CheckMalformed(newObj func() Object, buf []byte) error {
+	obj := newObj()
+	if err := obj.UnmarshalSSZ(buf); err != nil {
+		return nil
+	}
+	return checkCanonical(obj, buf)
+}
+
+// Fuzz runs a native fuzz test that decodes arbitrary bytes into the objects created by
+// newObj. Every input accepted by UnmarshalSSZ must be encoded again to the same bytes.
+// The corpus is seeded with the encodings of the objects checked by RoundTrip and, if
+// the fixtures directory is not empty, with the ssz_static cases of the consensus spec
+// tests for the type. If the objects implement ssz.SchemaProvider, the malformed
+// encodings of the seeds created with fuzz.Mutator are added to the corpus too.
+func Fuzz(f *testing.F, newObj func() Object, fixtures string) {
+	seeds := [][]byte{}
+	for i := -1; i < RoundTripCount; i++ {
+		obj := newObj()
+		if i >= 0 && !fill(obj, int64(i)) {
+			continue
+		}
+		if buf, err := obj.MarshalSSZ(); err == nil {
+			seeds = append(seeds, buf)
+		}
+	}
+	if len(seeds) == 0 {
+		f.Fatalf("none of the objects of %T filled by the fuzzer can be encoded", newObj())
+	}
+	if fixtures != "" {
+		specSeeds, err := SpecTestSeeds(fixtures, typeName(newObj()))
+		if err != nil {
+			f.Fatal(err)
+		}
+		seeds = append(seeds, specSeeds...)
+	}
+	schema := ssz.SchemaOf(newObj())
+	for indx, seed := range seeds {
+		f.Add(seed)
+		if schema.Kind == ssz.KindUnknown {
+			continue
+		}
+		// the seeds that do not follow the schema have no mutations
+		mutations, _ := fuzz.NewMutator(schema, int64(indx)).Mutations(seed, MutationCount)
+		for _, m := range mutations {
+			f.Add(m.Data)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, buf []byte) {
+		obj := newObj()
+		if err := obj.UnmarshalSSZ(buf); err != nil {
+			return
+		}
+		if err := checkCanonical(obj, buf); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// SpecTestSeeds returns the decoded 'serialized.ssz_snappy' files of the ssz_static cases
+// for the type name in the consensus spec tests directory (i.e. 'eth2.0-spec-tests/tests').
+// It returns no seeds if the directory does not exist.
+func SpecTestSeeds(dir string, name string) ([][]byte, error) {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil, nil
+	}
+	// <config>/<fork>/ssz_static/<name>/<suite>/<case>/serialized.ssz_snappy
+	files, err := filepath.Glob(filepath.Join(dir, "*", "*", "ssz_static", name, "*", "*", "serialized.ssz_snappy"))
+	if err != nil {
+		return nil, err
+	}
+	seeds := [][]byte{}
+	for _, file := range files {
+		raw, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		seed, err := snappy.Decode(nil, raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %v", file, err)
+		}
+		seeds = append(seeds, seed)
+	}
+	return seeds, nil
+}
+
+func typeName(obj Object) string {
+	typ := reflect.TypeOf(obj)
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ.Name()
+}
@@ -0,0 +1,88 @@
+package ssztest
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	ssz "github.com/NilFoundation/fastssz"
+)
+
+// roundTripObj encodes A only, zero values cannot be decoded
+// and the max value cannot be encoded
+type roundTripObj struct {
+	A uint64
+	B uint64
+}
+
+func (r *roundTripObj) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
+}
+
+func (r *roundTripObj) MarshalSSZTo(dst []byte) ([]byte, error) {
+	if r.A == math.MaxUint64 {
+		return nil, errors.New("max value")
+	}
+	return ssz.MarshalUint64(dst, r.A), nil
+}
+
+func (r *roundTripObj) SizeSSZ() int {
+	return 8
+}
+
+func (r *roundTripObj) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != 8 {
+		return ssz.ErrSize
+	}
+	r.A = ssz.UnmarshallUint64(buf)
+	if r.A == 0 {
+		return errors.New("zero value")
+	}
+	return nil
+}
+
+func (r *roundTripObj) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+func (r *roundTripObj) HashTreeRootWith(hh ssz.HashWalker) error {
+	indx := hh.Index()
+	hh.PutUint64(r.A)
+	hh.Merkleize(indx)
+	return nil
+}
+
+func (r *roundTripObj) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(r)
+}
+
+func TestRoundTripErrors(t *testing.T) {
+	newObj := func() Object { return new(roundTripObj) }
+
+	cases := []struct {
+		obj *roundTripObj
+		ok  bool
+		err string
+	}{
+		{&roundTripObj{A: 1}, true, ""},
+		// objects that cannot be encoded are skipped
+		{&roundTripObj{A: math.MaxUint64}, false, ""},
+		// but an encoding that cannot be decoded is an error
+		{&roundTripObj{}, true, "failed to decode"},
+		// the field that is not decoded
+		{&roundTripObj{A: 1, B: 2}, true, "decoded object is different"},
+	}
+	for _, c := range cases {
+		ok, err := roundTrip(c.obj, newObj)
+		if ok != c.ok {
+			t.Fatalf("%+v: expected ok %v", c.obj, c.ok)
+		}
+		if c.err == "" && err != nil {
+			t.Fatalf("%+v: unexpected error %v", c.obj, err)
+		}
+		if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+			t.Fatalf("%+v: expected error '%s' but found %v", c.obj, c.err, err)
+		}
+	}
+}
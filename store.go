@@ -0,0 +1,415 @@
+package ssz
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ErrNodeNotFound is returned when a node is not found in a NodeStore
+var ErrNodeNotFound = errors.New("node not found in store")
+
+// NodeStore is a content-addressed store of the branch nodes of merkle trees.
+// A branch is stored under its hash and its data is the concatenation of the
+// hashes of its left and right children followed by a byte with the kind of the
+// children (see nodeData). Leaves are not stored since their hash is their value,
+// and neither are the zero subtrees.
+type NodeStore interface {
+	Get(hash []byte) ([]byte, error)
+	Put(hash []byte, data []byte) error
+}
+
+// IterableNodeStore is a NodeStore whose nodes can be listed and removed.
+// It is required by the Pruner.
+type IterableNodeStore interface {
+	NodeStore
+	Delete(hash []byte) error
+	ForEach(fn func(hash []byte) error) error
+}
+
+var (
+	_ IterableNodeStore = (*MemoryStore)(nil)
+	_ IterableNodeStore = (*FileStore)(nil)
+)
+
+// MemoryStore is a NodeStore that keeps the nodes in memory
+type MemoryStore struct {
+	lock  sync.RWMutex
+	nodes map[string][]byte
+}
+
+// NewMemoryStore creates a new empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{nodes: map[string][]byte{}}
+}
+
+// Get implements the NodeStore interface
+func (m *MemoryStore) Get(hash []byte) ([]byte, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	data, ok := m.nodes[string(hash)]
+	if !ok {
+		return nil, ErrNodeNotFound
+	}
+	return data, nil
+}
+
+// Put implements the NodeStore interface
+func (m *MemoryStore) Put(hash []byte, data []byte) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.nodes[string(hash)] = append([]byte{}, data...)
+	return nil
+}
+
+// Delete implements the IterableNodeStore interface
+func (m *MemoryStore) Delete(hash []byte) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.nodes, string(hash))
+	return nil
+}
+
+// ForEach implements the IterableNodeStore interface
+func (m *MemoryStore) ForEach(fn func(hash []byte) error) error {
+	m.lock.RLock()
+	hashes := make([][]byte, 0, len(m.nodes))
+	for hash := range m.nodes {
+		hashes = append(hashes, []byte(hash))
+	}
+	m.lock.RUnlock()
+
+	for _, hash := range hashes {
+		if err := fn(hash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Len returns the number of nodes in the store
+func (m *MemoryStore) Len() int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return len(m.nodes)
+}
+
+// FileStore is a NodeStore that keeps each node in a file of a directory.
+// The files are grouped in subdirectories by the first byte of the hash.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore creates a FileStore in the given directory
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+func (f *FileStore) path(hash []byte) string {
+	str := hex.EncodeToString(hash)
+	if len(str) < 2 {
+		return filepath.Join(f.dir, "_"+str)
+	}
+	return filepath.Join(f.dir, str[:2], str[2:])
+}
+
+// Get implements the NodeStore interface
+func (f *FileStore) Get(hash []byte) ([]byte, error) {
+	data, err := os.ReadFile(f.path(hash))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNodeNotFound
+	}
+	return data, err
+}
+
+// Put implements the NodeStore interface
+func (f *FileStore) Put(hash []byte, data []byte) error {
+	path := f.path(hash)
+	if _, err := os.Stat(path); err == nil {
+		// the content of a node does not change
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	// write to a temporary file first so that readers
+	// never see a partially written node
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Delete implements the IterableNodeStore interface
+func (f *FileStore) Delete(hash []byte) error {
+	err := os.Remove(f.path(hash))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// ForEach implements the IterableNodeStore interface
+func (f *FileStore) ForEach(fn func(hash []byte) error) error {
+	dirs, err := os.ReadDir(f.dir)
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		files, err := os.ReadDir(filepath.Join(f.dir, dir.Name()))
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			hash, err := hex.DecodeString(dir.Name() + file.Name())
+			if err != nil {
+				// not a node (i.e. temporary file)
+				continue
+			}
+			if err := fn(hash); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Persist stores the branch nodes of the tree in the store. The subtrees
+// that are already in the store are not written again, so consecutive versions
+// of a tree only store the nodes that changed. The nodes of a partial tree that
+// are stored with pruned children are written again when a tree with more of
+// their subtree is persisted.
+func (n *Node) Persist(store NodeStore) error {
+	_, err := persistNode(n, store)
+	return err
+}
+
+// persistStatus is the state of a persisted subtree in the store
+type persistStatus int
+
+const (
+	// persistLeaf is a leaf or a zero subtree, which are not stored
+	persistLeaf persistStatus = iota
+	// persistComplete is a branch stored with all its subtree
+	persistComplete
+	// persistPartial is a branch stored with some pruned nodes in its subtree
+	persistPartial
+	// persistPruned is a pruned node that is not in the store
+	persistPruned
+)
+
+// flags of the stored data of a branch node (see nodeData)
+const (
+	flagLeftBranch  = 1
+	flagRightBranch = 2
+	flagLeftPruned  = 4
+	flagRightPruned = 8
+	flagPartial     = 16
+)
+
+func persistNode(n *Node, store NodeStore) (persistStatus, error) {
+	if n.isPruned {
+		// the subtree may be in the store from another version of the tree
+		data, err := store.Get(n.value)
+		if errors.Is(err, ErrNodeNotFound) {
+			return persistPruned, nil
+		} else if err != nil {
+			return 0, err
+		}
+		return dataStatus(data), nil
+	}
+	if !isStoredBranch(n) {
+		// leaf or zero subtree
+		return persistLeaf, nil
+	}
+
+	hash := hashNode(n)
+	stored, err := store.Get(hash)
+	if err == nil {
+		if len(stored) != 65 {
+			return 0, fmt.Errorf("node %x: incorrect data length %d", hash, len(stored))
+		}
+		if stored[64]&flagPartial == 0 {
+			// already stored with all its subtree
+			return persistComplete, nil
+		}
+		// the stored subtree is partial, the node may complete it
+	} else if !errors.Is(err, ErrNodeNotFound) {
+		return 0, err
+	}
+
+	left, right, err := n.children()
+	if err != nil {
+		return 0, err
+	}
+	leftStatus, err := persistNode(left, store)
+	if err != nil {
+		return 0, err
+	}
+	rightStatus, err := persistNode(right, store)
+	if err != nil {
+		return 0, err
+	}
+
+	// the node is written after its children, a node in the store
+	// always has its subtree stored unless it is flagged as partial
+	data := nodeData(left, right, leftStatus, rightStatus)
+	if bytes.Equal(data, stored) {
+		return dataStatus(data), nil
+	}
+	if err := store.Put(hash, data); err != nil {
+		return 0, err
+	}
+	return dataStatus(data), nil
+}
+
+// nodeData returns the data stored for a branch node: the hashes of its
+// children and a flags byte where the bits 0 and 1 are set if the left and
+// the right child respectively are branches stored in the store, the bits 2
+// and 3 if they are pruned nodes of a partial tree and the bit 4 if there are
+// pruned nodes in the subtree.
+func nodeData(left, right *Node, leftStatus, rightStatus persistStatus) []byte {
+	data := make([]byte, 0, 65)
+	data = append(data, hashNode(left)...)
+	data = append(data, hashNode(right)...)
+
+	var flags byte
+	switch leftStatus {
+	case persistComplete, persistPartial:
+		flags |= flagLeftBranch
+	case persistPruned:
+		flags |= flagLeftPruned
+	}
+	switch rightStatus {
+	case persistComplete, persistPartial:
+		flags |= flagRightBranch
+	case persistPruned:
+		flags |= flagRightPruned
+	}
+	if leftStatus >= persistPartial || rightStatus >= persistPartial {
+		flags |= flagPartial
+	}
+	return append(data, flags)
+}
+
+// dataStatus returns the status of a stored branch from its data
+func dataStatus(data []byte) persistStatus {
+	if len(data) == 65 && data[64]&flagPartial != 0 {
+		return persistPartial
+	}
+	return persistComplete
+}
+
+func isStoredBranch(n *Node) bool {
+	if n.lazy == nil && n.left == nil && n.right == nil {
+		return false
+	}
+	_, isZero := zeroHashLevels[string(hashNode(n))]
+	return !isZero
+}
+
+// LoadNode returns the tree with the given root from the store. The nodes
+// are loaded lazily from the store the first time their children are
+// accessed (i.e. with Get or Prove). The tree can be read from several
+// goroutines.
+func LoadNode(store NodeStore, root []byte) (*Node, error) {
+	if len(root) != 32 {
+		return nil, fmt.Errorf("incorrect root length %d", len(root))
+	}
+	if _, ok := zeroHashLevels[string(root)]; !ok {
+		if _, err := store.Get(root); err != nil {
+			return nil, err
+		}
+	}
+	return loadNode(store, root, true), nil
+}
+
+// loadNode returns a lazy node with the given hash if it is a branch in the store.
+func loadNode(store NodeStore, hash []byte, isBranch bool) *Node {
+	hash = append([]byte{}, hash...)
+	if level, ok := zeroHashLevels[string(hash)]; ok {
+		if level == 0 {
+			return NewNodeWithValue(hash)
+		}
+		return NewEmptyNode(hash)
+	}
+	if !isBranch {
+		return NewNodeWithValue(hash)
+	}
+	return &Node{value: hash, lazy: &lazyChildren{store: store}}
+}
+
+// lazyChildren are the children of a node loaded with LoadNode. They are
+// loaded once, the lock allows to access the tree from several goroutines.
+type lazyChildren struct {
+	store NodeStore
+
+	lock        sync.Mutex
+	left, right *Node
+}
+
+// children returns the children of the node, loading
+// them from the store if the node is lazy.
+func (n *Node) children() (*Node, *Node, error) {
+	if n.isPruned {
+		return nil, nil, ErrPruned
+	}
+	if n.lazy == nil {
+		return n.left, n.right, nil
+	}
+	return n.lazy.load(n.value)
+}
+
+func (l *lazyChildren) load(hash []byte) (*Node, *Node, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.left != nil {
+		return l.left, l.right, nil
+	}
+
+	data, err := l.store.Get(hash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load node %x: %w", hash, err)
+	}
+	if len(data) != 65 {
+		return nil, nil, fmt.Errorf("failed to load node %x: incorrect data length %d", hash, len(data))
+	}
+
+	left := loadNode(l.store, data[:32], data[64]&flagLeftBranch != 0)
+	right := loadNode(l.store, data[32:64], data[64]&flagRightBranch != 0)
+	if data[64]&flagLeftPruned != 0 {
+		left = newPrunedNode(data[:32])
+	}
+	if data[64]&flagRightPruned != 0 {
+		right = newPrunedNode(data[32:64])
+	}
+	l.left, l.right = left, right
+	return left, right, nil
+}
@@ -0,0 +1,289 @@
+package ssz
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func storeTestTree(t *testing.T, num int, seed byte) *Node {
+	t.Helper()
+
+	leaves := []*Node{}
+	for i := 0; i < num; i++ {
+		leaves = append(leaves, LeafFromUint64(uint64(seed)<<32|uint64(i+1)))
+	}
+	data, err := TreeFromNodes(leaves, 16)
+	require.NoError(t, err)
+
+	// container with the list and two basic fields
+	list := NewNodeWithLR(data, LeafFromUint64(uint64(num)))
+	root, err := TreeFromNodes([]*Node{LeafFromUint8(seed), list, LeafFromBool(true)}, 4)
+	require.NoError(t, err)
+	return root
+}
+
+func testNodeStore(t *testing.T, store IterableNodeStore) {
+	tree := storeTestTree(t, 5, 1)
+	require.NoError(t, tree.Persist(store))
+
+	lazy, err := LoadNode(store, tree.Hash())
+	require.NoError(t, err)
+	require.Equal(t, tree.Hash(), lazy.Hash())
+
+	for i := 1; i < 128; i++ {
+		expected, err := tree.Get(i)
+		if err != nil {
+			continue
+		}
+		node, err := lazy.Get(i)
+		require.NoError(t, err)
+		require.Equal(t, expected.Hash(), node.Hash(), "index %d", i)
+
+		if i == 1 || expected.isEmpty {
+			continue
+		}
+		proof, err := lazy.Prove(i)
+		require.NoError(t, err)
+		ok, err := VerifyProof(tree.Hash(), proof)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+
+	// the tree can be decoded from the lazy nodes
+	elems, err := TreeList(mustGet(t, lazy, 5), 16)
+	require.NoError(t, err)
+	require.Len(t, elems, 5)
+
+	// the zero subtrees are not stored
+	num := 0
+	require.NoError(t, store.ForEach(func(hash []byte) error {
+		num++
+		_, ok := zeroHashLevels[string(hash)]
+		require.False(t, ok)
+		return nil
+	}))
+	require.NotZero(t, num)
+
+	// unknown root
+	_, err = LoadNode(store, bytes.Repeat([]byte{1}, 32))
+	require.ErrorIs(t, err, ErrNodeNotFound)
+}
+
+func mustGet(t *testing.T, n *Node, index int) *Node {
+	t.Helper()
+
+	node, err := n.Get(index)
+	require.NoError(t, err)
+	return node
+}
+
+func TestMemoryStore(t *testing.T) {
+	testNodeStore(t, NewMemoryStore())
+}
+
+func TestFileStore(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	testNodeStore(t, store)
+}
+
+func TestPersistDeduplication(t *testing.T) {
+	store := NewMemoryStore()
+
+	tree := storeTestTree(t, 5, 1)
+	require.NoError(t, tree.Persist(store))
+	size := store.Len()
+
+	// persisting the same tree does not add any node
+	require.NoError(t, tree.Persist(store))
+	require.Equal(t, size, store.Len())
+
+	// only the nodes in the path of the updated leaf are added
+	tree2, err := tree.Set(4, LeafFromUint8(2))
+	require.NoError(t, err)
+	require.NoError(t, tree2.Persist(store))
+	require.Equal(t, size+2, store.Len())
+
+	// a lazy tree can be updated and persisted again
+	lazy, err := LoadNode(store, tree2.Hash())
+	require.NoError(t, err)
+	tree3, err := lazy.Set(7, LeafFromUint8(9))
+	require.NoError(t, err)
+	require.NoError(t, tree3.Persist(store))
+	require.Equal(t, size+4, store.Len())
+
+	// and copied into a new store
+	store2 := NewMemoryStore()
+	require.NoError(t, tree3.Persist(store2))
+	require.Equal(t, size, store2.Len())
+}
+
+func TestPruner(t *testing.T) {
+	store := NewMemoryStore()
+	pruner := NewPruner(store)
+
+	tree1 := storeTestTree(t, 5, 1)
+	tree2, err := tree1.Set(4, LeafFromUint8(2))
+	require.NoError(t, err)
+	tree3 := storeTestTree(t, 7, 3)
+
+	for _, tree := range []*Node{tree1, tree2, tree3} {
+		require.NoError(t, pruner.Persist(tree))
+	}
+
+	// remove the first and the last trees, the
+	// nodes shared by the first and second remain
+	num, err := pruner.Prune(tree2.Hash())
+	require.NoError(t, err)
+	require.NotZero(t, num)
+
+	_, err = store.Get(tree1.Hash())
+	require.ErrorIs(t, err, ErrNodeNotFound)
+	_, err = store.Get(tree3.Hash())
+	require.ErrorIs(t, err, ErrNodeNotFound)
+
+	lazy, err := LoadNode(store, tree2.Hash())
+	require.NoError(t, err)
+	for i := 1; i < 64; i++ {
+		if expected, err := tree2.Get(i); err == nil {
+			require.Equal(t, expected.Hash(), mustGet(t, lazy, i).Hash())
+		}
+	}
+
+	// nothing else to prune
+	num, err = pruner.Prune(tree2.Hash())
+	require.NoError(t, err)
+	require.Zero(t, num)
+}
+
+func TestPrunerConcurrent(t *testing.T) {
+	store := NewMemoryStore()
+	pruner := NewPruner(store)
+
+	trees := []*Node{}
+	for i := 0; i < 10; i++ {
+		trees = append(trees, storeTestTree(t, i+1, byte(i)))
+	}
+	last := trees[len(trees)-1]
+	lastRoot := last.Hash()
+
+	var wg sync.WaitGroup
+	for _, tree := range trees {
+		wg.Add(2)
+		go func(tree *Node) {
+			defer wg.Done()
+			require.NoError(t, pruner.Persist(tree))
+		}(tree)
+		go func() {
+			defer wg.Done()
+			_, err := pruner.Prune(lastRoot)
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// the last tree is always complete
+	require.NoError(t, pruner.Persist(last))
+	_, err := pruner.Prune(lastRoot)
+	require.NoError(t, err)
+
+	lazy, err := LoadNode(store, lastRoot)
+	require.NoError(t, err)
+	for i := 1; i < 64; i++ {
+		if expected, err := last.Get(i); err == nil {
+			require.Equal(t, expected.Hash(), mustGet(t, lazy, i).Hash())
+		}
+	}
+}
+
+func TestLoadNodeConcurrent(t *testing.T) {
+	tree := storeTestTree(t, 5, 1)
+	store := NewMemoryStore()
+	require.NoError(t, tree.Persist(store))
+
+	expected := map[int][]byte{}
+	for i := 1; i < 64; i++ {
+		if node, err := tree.Get(i); err == nil {
+			expected[i] = node.Hash()
+		}
+	}
+
+	lazy, err := LoadNode(store, tree.Hash())
+	require.NoError(t, err)
+
+	// the nodes are loaded from several goroutines at the same time
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for gindex, hash := range expected {
+				node, err := lazy.Get(gindex)
+				if !assert.NoError(t, err) {
+					return
+				}
+				assert.Equal(t, hash, node.Hash())
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestPersistPartialTree(t *testing.T) {
+	tree := storeTestTree(t, 5, 1)
+	root := tree.Hash()
+
+	expected := map[int][]byte{}
+	for i := 1; i < 256; i++ {
+		if node, err := tree.Get(i); err == nil {
+			expected[i] = node.Hash()
+		}
+	}
+	requireComplete := func(store NodeStore) {
+		lazy, err := LoadNode(store, root)
+		require.NoError(t, err)
+		for gindex, hash := range expected {
+			node, err := lazy.Get(gindex)
+			require.NoError(t, err, "gindex %d", gindex)
+			require.Equal(t, hash, node.Hash())
+		}
+	}
+
+	partial, err := tree.Prune([]int{5<<5 | 1})
+	require.NoError(t, err)
+	partial2, err := tree.Prune([]int{5<<5 | 4})
+	require.NoError(t, err)
+
+	// the full tree completes the partial tree in the store
+	store := NewMemoryStore()
+	require.NoError(t, partial.Persist(store))
+	lazy, err := LoadNode(store, root)
+	require.NoError(t, err)
+	_, err = lazy.Get(15)
+	require.ErrorIs(t, err, ErrPruned)
+
+	require.NoError(t, tree.Persist(store))
+	requireComplete(store)
+
+	// a partial tree does not prune a complete tree in the store
+	require.NoError(t, partial.Persist(store))
+	requireComplete(store)
+
+	// the stored nodes of two partial trees are merged
+	store = NewMemoryStore()
+	require.NoError(t, partial.Persist(store))
+	require.NoError(t, partial2.Persist(store))
+	lazy, err = LoadNode(store, root)
+	require.NoError(t, err)
+	for _, gindex := range []int{5<<5 | 1, 5<<5 | 4} {
+		node, err := lazy.Get(gindex)
+		require.NoError(t, err)
+		require.Equal(t, expected[gindex], node.Hash())
+	}
+	_, err = lazy.Get(5<<5 | 2)
+	require.ErrorIs(t, err, ErrPruned)
+}
@@ -92,6 +92,64 @@ func (m *Metadata) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(m)
 }
 
+// SSZSchema returns the ssz schema of the Metadata object
+func (m *Metadata) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Version", Tag: "", Schema: ssz.UintSchema(1)},
+			{Name: "CodeHash", Tag: "", Schema: ssz.BytesSchema(32)},
+			{Name: "CodeLength", Tag: "", Schema: ssz.UintSchema(2)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Metadata object
+const (
+	Metadata_VersionGIndex    = 4
+	Metadata_CodeHashGIndex   = 5
+	Metadata_CodeLengthGIndex = 6
+)
+
+// FromTree decodes the Metadata object from its tree
+func (m *Metadata) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 3)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Version'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 1)
+		if err != nil {
+			return err
+		}
+		m.Version = ssz.UnmarshallUint8(buf)
+	}
+
+	// Field (1) 'CodeHash'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 32)
+		if err != nil {
+			return err
+		}
+		if cap(m.CodeHash) == 0 {
+			m.CodeHash = make([]byte, 0, len(buf))
+		}
+		m.CodeHash = append(m.CodeHash, buf...)
+	}
+
+	// Field (2) 'CodeLength'
+	{
+		buf, err := ssz.TreeBytes(nodes[2], 2)
+		if err != nil {
+			return err
+		}
+		m.CodeLength = ssz.UnmarshallUint16(buf)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Chunk object
 func (c *Chunk) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -168,6 +226,53 @@ func (c *Chunk) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
 
+// SSZSchema returns the ssz schema of the Chunk object
+func (c *Chunk) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "FIO", Tag: "", Schema: ssz.UintSchema(1)},
+			{Name: "Code", Tag: "", Schema: ssz.BytesSchema(32)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the Chunk object
+const (
+	Chunk_FIOGIndex  = 2
+	Chunk_CodeGIndex = 3
+)
+
+// FromTree decodes the Chunk object from its tree
+func (c *Chunk) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'FIO'
+	{
+		buf, err := ssz.TreeBytes(nodes[0], 1)
+		if err != nil {
+			return err
+		}
+		c.FIO = ssz.UnmarshallUint8(buf)
+	}
+
+	// Field (1) 'Code'
+	{
+		buf, err := ssz.TreeBytes(nodes[1], 32)
+		if err != nil {
+			return err
+		}
+		if cap(c.Code) == 0 {
+			c.Code = make([]byte, 0, len(buf))
+		}
+		c.Code = append(c.Code, buf...)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the CodeTrieSmall object
 func (c *CodeTrieSmall) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -227,7 +332,7 @@ func (c *CodeTrieSmall) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o1 < 39 {
+	if o1 != 39 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -305,6 +410,60 @@ func (c *CodeTrieSmall) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
 
+// SSZSchema returns the ssz schema of the CodeTrieSmall object
+func (c *CodeTrieSmall) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Metadata", Tag: "", Schema: ssz.SchemaOf(new(Metadata))},
+			{Name: "Chunks", Tag: "", Schema: ssz.ListSchema(ssz.SchemaOf(new(Chunk)), 4)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the CodeTrieSmall object
+const (
+	CodeTrieSmall_MetadataGIndex = 2
+	CodeTrieSmall_ChunksGIndex   = 3
+)
+
+// FromTree decodes the CodeTrieSmall object from its tree
+func (c *CodeTrieSmall) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Metadata'
+	{
+		if c.Metadata == nil {
+			c.Metadata = new(Metadata)
+		}
+		if err := c.Metadata.FromTree(nodes[0]); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Chunks'
+	{
+		elems, err := ssz.TreeList(nodes[1], 4)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		c.Chunks = make([]*Chunk, num)
+		for indx, elem := range elems {
+			if c.Chunks[indx] == nil {
+				c.Chunks[indx] = new(Chunk)
+			}
+			if err := c.Chunks[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the CodeTrieBig object
 func (c *CodeTrieBig) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -364,7 +523,7 @@ func (c *CodeTrieBig) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o1 < 39 {
+	if o1 != 39 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -441,3 +600,57 @@ func (c *CodeTrieBig) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *CodeTrieBig) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// SSZSchema returns the ssz schema of the CodeTrieBig object
+func (c *CodeTrieBig) SSZSchema() *ssz.Schema {
+	return &ssz.Schema{
+		Kind: ssz.KindContainer,
+		Fields: []*ssz.Field{
+			{Name: "Metadata", Tag: "", Schema: ssz.SchemaOf(new(Metadata))},
+			{Name: "Chunks", Tag: "", Schema: ssz.ListSchema(ssz.SchemaOf(new(Chunk)), 1024)},
+		},
+	}
+}
+
+// Generalized indices of the fields of the CodeTrieBig object
+const (
+	CodeTrieBig_MetadataGIndex = 2
+	CodeTrieBig_ChunksGIndex   = 3
+)
+
+// FromTree decodes the CodeTrieBig object from its tree
+func (c *CodeTrieBig) FromTree(n *ssz.Node) error {
+	nodes, err := ssz.TreeContainerFields(n, 2)
+	if err != nil {
+		return err
+	}
+	// Field (0) 'Metadata'
+	{
+		if c.Metadata == nil {
+			c.Metadata = new(Metadata)
+		}
+		if err := c.Metadata.FromTree(nodes[0]); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Chunks'
+	{
+		elems, err := ssz.TreeList(nodes[1], 1024)
+		if err != nil {
+			return err
+		}
+		num := len(elems)
+		c.Chunks = make([]*Chunk, num)
+		for indx, elem := range elems {
+			if c.Chunks[indx] == nil {
+				c.Chunks[indx] = new(Chunk)
+			}
+			if err := c.Chunks[indx].FromTree(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
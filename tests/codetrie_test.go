@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"math/rand"
+	"reflect"
 	"testing"
 	"time"
 
@@ -480,3 +481,70 @@ func parseStringSlice(slice []string) ([][]byte, error) {
 	}
 	return res, nil
 }
+
+func TestProverCodeTrie(t *testing.T) {
+	code := make([]byte, 32*5)
+	rand.Read(code)
+	codeHash := sha256.Sum256(code)
+
+	md := &Metadata{Version: 1, CodeLength: uint16(len(code)), CodeHash: codeHash[:]}
+	chunks := []*Chunk{}
+	for i := 0; i < len(code); i += 32 {
+		chunks = append(chunks, &Chunk{FIO: uint8(i), Code: code[i : i+32]})
+	}
+	codeTrie := &CodeTrieBig{Metadata: md, Chunks: chunks}
+
+	tree, err := codeTrie.GetTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := tree.Hash()
+
+	// all the nodes of the tree
+	indices := []int{}
+	for i := 1; i < 1<<16; i++ {
+		if _, err := tree.Get(i); err == nil {
+			indices = append(indices, i)
+		}
+	}
+
+	for _, indx := range indices {
+		expected, err := tree.Prove(indx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proof, err := ssz.Prove(codeTrie, indx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(expected, proof) {
+			t.Fatalf("proof mismatch for index %d", indx)
+		}
+	}
+
+	for i := 0; i < 100; i++ {
+		targets := []int{}
+		for j := 0; j < 1+rand.Intn(4); j++ {
+			targets = append(targets, indices[1+rand.Intn(len(indices)-1)])
+		}
+		expected, err := tree.ProveMulti(targets)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proof, err := ssz.ProveMulti(codeTrie, targets)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(expected, proof) {
+			t.Fatalf("multiproof mismatch for indices %v", targets)
+		}
+		if ok, err := ssz.VerifyMultiproof(root, proof.Hashes, proof.Leaves, proof.Indices); err != nil || !ok {
+			t.Fatalf("failed to verify multiproof for indices %v: %v", targets, err)
+		}
+	}
+
+	// node below a zero subtree
+	if _, err := ssz.Prove(codeTrie, indices[len(indices)-1]*2); err == nil {
+		t.Fatal("expected node not found error")
+	}
+}
@@ -84,8 +84,17 @@ type Node struct {
 	isEmpty bool
 
 	value []byte
+
+	// children of a lazy node loaded with LoadNode, the left and
+	// right fields are not set for lazy nodes
+	lazy *lazyChildren
+
+	// opaque node of a partial tree, only the hash is known
+	isPruned bool
 }
 
+// Show prints the tree to the standard output. Use WriteText, WriteDOT
+// or WriteJSON to export the tree with generalized indices and labels.
 func (n *Node) Show(maxDepth int) {
 	fmt.Printf("--- Show node ---\n")
 	n.show(0, maxDepth)
@@ -163,6 +172,10 @@ func TreeFromChunks(chunks [][]byte) (*Node, error) {
 func TreeFromNodes(leaves []*Node, limit int) (*Node, error) {
 	numLeaves := len(leaves)
 
+	if limit == 0 {
+		// the tree of an empty container is a single zero chunk
+		limit = 1
+	}
 	depth := floorLog2(limit)
 	zeroOrderHashes := getZeroOrderHashes(depth)
 
@@ -257,10 +270,14 @@ func (n *Node) Get(index int) (*Node, error) {
 	pathLen := getPathLength(index)
 	cur := n
 	for i := pathLen - 1; i >= 0; i-- {
+		left, right, err := cur.children()
+		if err != nil {
+			return nil, err
+		}
 		if isRight := getPosAtLevel(index, i); isRight {
-			cur = cur.right
+			cur = right
 		} else {
-			cur = cur.left
+			cur = left
 		}
 		if cur == nil {
 			return nil, errors.New("Node not found in tree")
@@ -270,6 +287,85 @@ func (n *Node) Get(index int) (*Node, error) {
 	return cur, nil
 }
 
+// Set returns a new tree with the node at the given general index replaced by
+// the given node. The unchanged subtrees are shared with the original tree and
+// only the nodes in the path to the root are created again. Zero subtrees in the
+// path are expanded. The original tree is not modified.
+func (n *Node) Set(index int, node *Node) (*Node, error) {
+	return n.SetMany(map[int]*Node{index: node})
+}
+
+// SetMany returns a new tree with the nodes at the given general indices replaced.
+// The nodes in the paths shared by several indices are created only once. It fails
+// if one of the indices is in the subtree of another one.
+func (n *Node) SetMany(nodes map[int]*Node) (*Node, error) {
+	items := make([]setItem, 0, len(nodes))
+	for index, node := range nodes {
+		if index < 1 {
+			return nil, fmt.Errorf("incorrect general index %d", index)
+		}
+		if node == nil {
+			return nil, fmt.Errorf("nil node for general index %d", index)
+		}
+		items = append(items, setItem{index: index, relative: index, node: node})
+	}
+	return setNodes(n, items)
+}
+
+type setItem struct {
+	index    int
+	relative int
+	node     *Node
+}
+
+func setNodes(n *Node, items []setItem) (*Node, error) {
+	if len(items) == 0 {
+		return n, nil
+	}
+
+	left, right := []setItem{}, []setItem{}
+	for _, item := range items {
+		if item.relative == 1 {
+			if len(items) != 1 {
+				return nil, fmt.Errorf("general index %d overlaps with other indices", item.index)
+			}
+			return item.node, nil
+		}
+
+		// move the item to the subtree of the child in its path
+		pathLen := getPathLength(item.relative)
+		isRight := getPosAtLevel(item.relative, pathLen-1)
+		item.relative = item.relative&(1<<(pathLen-1)-1) | 1<<(pathLen-1)
+		if isRight {
+			right = append(right, item)
+		} else {
+			left = append(left, item)
+		}
+	}
+
+	nLeft, nRight, err := n.children()
+	if err != nil {
+		return nil, err
+	}
+	if nLeft == nil && nRight == nil {
+		// expand the zero subtree
+		level, ok := zeroHashLevels[string(n.value)]
+		if !n.isEmpty || !ok || level == 0 {
+			return nil, fmt.Errorf("general index %d is below a leaf node", items[0].index)
+		}
+		nLeft = NewEmptyNode(zeroHashes[level-1][:])
+		nRight = NewEmptyNode(zeroHashes[level-1][:])
+	}
+
+	if nLeft, err = setNodes(nLeft, left); err != nil {
+		return nil, err
+	}
+	if nRight, err = setNodes(nRight, right); err != nil {
+		return nil, err
+	}
+	return NewNodeWithLR(nLeft, nRight), nil
+}
+
 // Hash returns the hash of the subtree with the given Node as its root.
 // If root has no children, it returns root's value (not its hash).
 func (n *Node) Hash() []byte {
@@ -291,13 +387,18 @@ func hashNode(n *Node) []byte {
 		return n.value
 	}
 
+	// use a new buffer since the values of the children
+	// may be shared with other trees
+	buf := make([]byte, 0, 64)
+	buf = append(buf, hashNode(n.left)...)
+
 	if n.right.isEmpty {
-		result := hashFn(append(hashNode(n.left), n.right.value...))
+		result := hashFn(append(buf, n.right.value...))
 		n.value = result // Set the hash result on each node so that proofs can be generated for any level
 		return result
 	}
 
-	result := hashFn(append(hashNode(n.left), hashNode(n.right)...))
+	result := hashFn(append(buf, hashNode(n.right)...))
 	n.value = result
 	return result
 }
@@ -326,18 +427,22 @@ func (n *Node) Prove(index int) (*Proof, error) {
 
 	cur := n
 	for i := pathLen - 1; i >= 0; i-- {
+		left, right, err := cur.children()
+		if err != nil {
+			return nil, err
+		}
+		if left == nil || right == nil {
+			return nil, errors.New("Node not found in tree")
+		}
 		var siblingHash []byte
 		if isRight := getPosAtLevel(index, i); isRight {
-			siblingHash = hashNode(cur.left)
-			cur = cur.right
+			siblingHash = hashNode(left)
+			cur = right
 		} else {
-			siblingHash = hashNode(cur.right)
-			cur = cur.left
+			siblingHash = hashNode(right)
+			cur = left
 		}
 		hashes = append([][]byte{siblingHash}, hashes...)
-		if cur == nil {
-			return nil, errors.New("Node not found in tree")
-		}
 	}
 
 	proof.Hashes = hashes
@@ -359,6 +464,10 @@ func (n *Node) ProveMulti(indices []int) (*Multiproof, error) {
 		if err != nil {
 			return nil, err
 		}
+		if node.value == nil {
+			// intermediate node without a value, use its hash as the leaf value
+			node.value = hashNode(node)
+		}
 		proof.Leaves[i] = node.value
 	}
 
@@ -0,0 +1,213 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// The helpers in this file decode the values of an object from the nodes of the
+// tree returned by GetTree. They are used by the FromTree functions generated by sszgen.
+
+// TreeDecoder is the interface implemented by types that can decode themselves from their tree
+type TreeDecoder interface {
+	FromTree(n *Node) error
+}
+
+// DecodeTree decodes v from the tree if it implements TreeDecoder
+func DecodeTree(n *Node, v interface{}) error {
+	obj, ok := v.(TreeDecoder)
+	if !ok {
+		return fmt.Errorf("type %T cannot be decoded from a tree", v)
+	}
+	return obj.FromTree(n)
+}
+
+// TreeContainerFields returns the nodes of the num fields of a container
+func TreeContainerFields(n *Node, num int) ([]*Node, error) {
+	return treeElements(n, uint64(num), num)
+}
+
+// TreeBytes returns the bytes of a fixed size value. Values of up to 32 bytes are
+// stored in a single leaf and bigger values are split in several chunks.
+func TreeBytes(n *Node, size uint64) ([]byte, error) {
+	if size <= 32 {
+		chunk, err := treeChunk(n)
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{}, chunk[:size]...), nil
+	}
+	return TreePacked(n, size)
+}
+
+// TreePacked returns the bytes of a vector of basic values packed in chunks
+func TreePacked(n *Node, size uint64) ([]byte, error) {
+	return treePacked(n, (size+31)/32, size)
+}
+
+// TreeVectorBytes returns the bytes of a vector of num fixed size values
+// where each value is stored in its own subtree.
+func TreeVectorBytes(n *Node, num, size uint64) ([]byte, error) {
+	return treeElementsBytes(n, num, int(num), size)
+}
+
+// TreeListPacked returns the bytes of a list of basic values packed in chunks
+func TreeListPacked(n *Node, max, elemSize uint64) ([]byte, error) {
+	data, num, err := treeList(n, max)
+	if err != nil {
+		return nil, err
+	}
+	return treePacked(data, CalculateLimit(max, num, elemSize), num*elemSize)
+}
+
+// TreeListBytes returns the bytes of a list of fixed size values where
+// each value is stored in its own subtree.
+func TreeListBytes(n *Node, max, size uint64) ([]byte, error) {
+	data, num, err := treeList(n, max)
+	if err != nil {
+		return nil, err
+	}
+	return treeElementsBytes(data, max, int(num), size)
+}
+
+// TreeList returns the nodes of the elements of a list of composite values
+func TreeList(n *Node, max uint64) ([]*Node, error) {
+	data, num, err := treeList(n, max)
+	if err != nil {
+		return nil, err
+	}
+	return treeElements(data, max, int(num))
+}
+
+// TreeBitlist returns the ssz encoding of a bitlist (including the length bit)
+func TreeBitlist(n *Node, max uint64) ([]byte, error) {
+	data, num, err := treeList(n, max)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := (max + 255) / 256
+	size := num/8 + 1
+
+	// the length bit may be outside of the chunks of the bits
+	chunks := (size + 31) / 32
+	if chunks > limit {
+		chunks = limit
+	}
+	buf, err := treePacked(data, limit, chunks*32)
+	if err != nil {
+		return nil, err
+	}
+	buf = append(buf, make([]byte, 32)...)[:size]
+	buf[num/8] |= 1 << (num % 8)
+	return buf, nil
+}
+
+// treeList returns the data subtree and the length of a list
+func treeList(n *Node, max uint64) (*Node, uint64, error) {
+	left, right, err := n.children()
+	if err != nil {
+		return nil, 0, err
+	}
+	if left == nil || right == nil {
+		return nil, 0, fmt.Errorf("list node does not have a length mixin")
+	}
+	chunk, err := treeChunk(right)
+	if err != nil {
+		return nil, 0, err
+	}
+	num := binary.LittleEndian.Uint64(chunk[:8])
+	if num > max {
+		return nil, 0, ErrListTooBig
+	}
+	return left, num, nil
+}
+
+func treePacked(n *Node, limit, size uint64) ([]byte, error) {
+	chunks, err := treeElements(n, limit, int((size+31)/32))
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 0, len(chunks)*32)
+	for _, c := range chunks {
+		chunk, err := treeChunk(c)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, chunk...)
+	}
+	return buf[:size], nil
+}
+
+func treeElementsBytes(n *Node, limit uint64, num int, size uint64) ([]byte, error) {
+	elems, err := treeElements(n, limit, num)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 0, uint64(num)*size)
+	for _, elem := range elems {
+		b, err := TreeBytes(elem, size)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, b...)
+	}
+	return buf, nil
+}
+
+// treeChunk returns the value of a leaf node
+func treeChunk(n *Node) ([]byte, error) {
+	if n.left != nil || n.right != nil || n.lazy != nil {
+		return nil, fmt.Errorf("expected a leaf node but found a branch")
+	}
+	if len(n.value) != 32 {
+		return nil, fmt.Errorf("expected a leaf node of 32 bytes but found %d", len(n.value))
+	}
+	return n.value, nil
+}
+
+// treeElements returns the first num leaves of the subtree with the given limit of leaves.
+// Zero subtrees are expanded into empty nodes.
+func treeElements(n *Node, limit uint64, num int) ([]*Node, error) {
+	if uint64(num) > limit {
+		return nil, fmt.Errorf("number of elements %d higher than limit %d", num, limit)
+	}
+	res := make([]*Node, 0, num)
+	return collectLeaves(n, int(getDepth(limit)), num, res)
+}
+
+func collectLeaves(n *Node, depth int, num int, res []*Node) ([]*Node, error) {
+	if num == 0 {
+		return res, nil
+	}
+	if depth == 0 {
+		return append(res, n), nil
+	}
+
+	left, right, err := n.children()
+	if err != nil {
+		return nil, err
+	}
+	if left == nil && right == nil {
+		level, ok := zeroHashLevels[string(n.value)]
+		if !n.isEmpty || !ok || level < depth {
+			return nil, fmt.Errorf("expected a subtree of depth %d but found a leaf", depth)
+		}
+		left = NewEmptyNode(zeroHashes[level-1][:])
+		right = left
+	}
+	if left == nil || right == nil {
+		return nil, fmt.Errorf("incomplete node")
+	}
+
+	half := 1 << (depth - 1)
+	if res, err = collectLeaves(left, depth-1, min(num, half), res); err != nil {
+		return nil, err
+	}
+	if num > half {
+		if res, err = collectLeaves(right, depth-1, num-half, res); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,299 @@
+package ssz
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ExportOptions are the options to export a tree with WriteText, WriteDOT and WriteJSON
+type ExportOptions struct {
+	// MaxDepth is the maximum depth of the exported nodes. Zero exports the full tree.
+	MaxDepth int
+
+	// Schema of the object of the tree. If set, the nodes that are the root of
+	// a field or an element are labeled with their path (i.e. "a.b[1]").
+	Schema *Schema
+
+	// ShortHashes only prints the first four bytes of the hashes.
+	ShortHashes bool
+}
+
+// exportNode is a node of the tree prepared to be exported
+type exportNode struct {
+	gindex GIndex
+	label  string
+	hash   []byte
+
+	// zero is the depth of a zero subtree, it is collapsed in a single node
+	zero   int
+	pruned bool
+
+	left, right *exportNode
+}
+
+func (e *exportNode) hashString(opts *ExportOptions) string {
+	if opts.ShortHashes && len(e.hash) > 4 {
+		return "0x" + hex.EncodeToString(e.hash[:4]) + "…"
+	}
+	return "0x" + hex.EncodeToString(e.hash)
+}
+
+func (e *exportNode) description() string {
+	switch {
+	case e.zero != 0:
+		return "zero(" + strconv.Itoa(e.zero) + ")"
+	case e.pruned:
+		return "pruned"
+	default:
+		return ""
+	}
+}
+
+func buildExport(n *Node, opts *ExportOptions) (*exportNode, error) {
+	root := treeLabel{schema: opts.Schema, isValue: opts.Schema != nil}
+	return buildExportNode(n, opts, RootGIndex, root.normalize(), 0)
+}
+
+func buildExportNode(n *Node, opts *ExportOptions, gindex GIndex, label treeLabel, depth int) (*exportNode, error) {
+	e := &exportNode{
+		gindex: gindex,
+		hash:   hashNode(n),
+		pruned: n.isPruned,
+	}
+	if label.isValue {
+		e.label = label.path
+	}
+	if level, ok := zeroHashLevels[string(e.hash)]; ok && level > 0 {
+		// collapse the zero subtree
+		e.zero = level
+		return e, nil
+	}
+	if n.isPruned || (opts.MaxDepth != 0 && depth == opts.MaxDepth) {
+		return e, nil
+	}
+
+	left, right, err := n.children()
+	if err != nil {
+		return nil, err
+	}
+	if left == nil || right == nil {
+		// leaf
+		return e, nil
+	}
+	if e.left, err = buildExportNode(left, opts, gindex.Child(false), label.child(false), depth+1); err != nil {
+		return nil, err
+	}
+	if e.right, err = buildExportNode(right, opts, gindex.Child(true), label.child(true), depth+1); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// treeLabel tracks the position of a node in the schema
+type treeLabel struct {
+	schema *Schema
+	path   string
+
+	// level and pos are the position of the node in the data tree of the value
+	level int
+	pos   uint64
+
+	// data is set for the nodes of the data tree of a list (left of the length mixin)
+	data bool
+
+	// isValue is set if the node is the root of a value
+	isValue bool
+}
+
+func (l treeLabel) join(name string) string {
+	if l.path == "" || strings.HasPrefix(name, "[") {
+		return l.path + name
+	}
+	return l.path + "." + name
+}
+
+// normalize moves the label to the value stored at the node, if any
+func (l treeLabel) normalize() treeLabel {
+	for l.schema != nil {
+		s := l.schema
+		isList := s.Kind == KindList || s.Kind == KindBitlist
+		if (isList && !l.data) || l.level != s.depth() {
+			return l
+		}
+
+		switch {
+		case s.Kind == KindContainer:
+			if l.pos >= uint64(len(s.Fields)) {
+				// padding of the fields
+				return treeLabel{}
+			}
+			f := s.Fields[l.pos]
+			l = treeLabel{schema: f.Schema, path: l.join(f.Name), isValue: true}
+
+		case s.Kind == KindBitlist || ((s.Kind == KindVector || s.Kind == KindList) && s.Elem.IsBasic()):
+			// chunk with packed values
+			if l.isValue {
+				// the value has a single chunk
+				l.schema = nil
+				return l
+			}
+			return treeLabel{path: fmt.Sprintf("%s[chunk %d]", l.path, l.pos), isValue: true}
+
+		case s.Kind == KindVector || s.Kind == KindList:
+			l = treeLabel{schema: s.Elem, path: l.join(fmt.Sprintf("[%d]", l.pos)), isValue: true}
+
+		default:
+			// basic or unknown value
+			l.schema = nil
+		}
+	}
+	return l
+}
+
+func (l treeLabel) child(right bool) treeLabel {
+	if l.schema == nil {
+		return treeLabel{}
+	}
+	if (l.schema.Kind == KindList || l.schema.Kind == KindBitlist) && !l.data {
+		if right {
+			return treeLabel{path: l.join("__len__"), isValue: true}
+		}
+		return treeLabel{schema: l.schema, path: l.path, data: true}.normalize()
+	}
+
+	pos := l.pos << 1
+	if right {
+		pos |= 1
+	}
+	return treeLabel{schema: l.schema, path: l.path, level: l.level + 1, pos: pos, data: l.data}.normalize()
+}
+
+// WriteText writes the tree as indented text with a line for each node
+// with its generalized index, its label and its hash.
+func (n *Node) WriteText(w io.Writer, opts *ExportOptions) error {
+	if opts == nil {
+		opts = &ExportOptions{}
+	}
+	e, err := buildExport(n, opts)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	writeTextNode(bw, e, opts, 0)
+	return bw.Flush()
+}
+
+func writeTextNode(w *bufio.Writer, e *exportNode, opts *ExportOptions, depth int) {
+	w.WriteString(strings.Repeat("  ", depth))
+	w.WriteString(e.gindex.String())
+	for _, str := range []string{e.label, e.description(), e.hashString(opts)} {
+		if str != "" {
+			w.WriteString(" " + str)
+		}
+	}
+	w.WriteString("\n")
+
+	if e.left != nil {
+		writeTextNode(w, e.left, opts, depth+1)
+		writeTextNode(w, e.right, opts, depth+1)
+	}
+}
+
+// WriteDOT writes the tree in the DOT language of Graphviz
+func (n *Node) WriteDOT(w io.Writer, opts *ExportOptions) error {
+	if opts == nil {
+		opts = &ExportOptions{}
+	}
+	e, err := buildExport(n, opts)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString("digraph tree {\n")
+	bw.WriteString("\tnode [shape=box, fontname=\"monospace\"];\n")
+	writeDOTNode(bw, e, opts)
+	bw.WriteString("}\n")
+	return bw.Flush()
+}
+
+func writeDOTNode(w *bufio.Writer, e *exportNode, opts *ExportOptions) {
+	lines := []string{e.gindex.String()}
+	for _, str := range []string{e.label, e.description(), e.hashString(opts)} {
+		if str != "" {
+			lines = append(lines, str)
+		}
+	}
+	style := ""
+	if e.zero != 0 || e.pruned {
+		style = ", style=dashed"
+	}
+	fmt.Fprintf(w, "\tn%s [label=%s%s];\n", e.gindex, strconv.Quote(strings.Join(lines, "\n")), style)
+
+	if e.left != nil {
+		fmt.Fprintf(w, "\tn%s -> n%s;\n", e.gindex, e.left.gindex)
+		fmt.Fprintf(w, "\tn%s -> n%s;\n", e.gindex, e.right.gindex)
+		writeDOTNode(w, e.left, opts)
+		writeDOTNode(w, e.right, opts)
+	}
+}
+
+type jsonNode struct {
+	GIndex jsonBigGIndex `json:"gindex"`
+	Label  string        `json:"label,omitempty"`
+	Hash   jsonBytes     `json:"hash"`
+	Zero   int           `json:"zero,omitempty"`
+	Pruned bool          `json:"pruned,omitempty"`
+	Left   *jsonNode     `json:"left,omitempty"`
+	Right  *jsonNode     `json:"right,omitempty"`
+}
+
+type jsonBigGIndex GIndex
+
+func (g jsonBigGIndex) MarshalText() ([]byte, error) {
+	return []byte(GIndex(g).String()), nil
+}
+
+func toJSONNode(e *exportNode) *jsonNode {
+	n := &jsonNode{
+		GIndex: jsonBigGIndex(e.gindex),
+		Label:  e.label,
+		Hash:   e.hash,
+		Zero:   e.zero,
+		Pruned: e.pruned,
+	}
+	if e.left != nil {
+		n.Left = toJSONNode(e.left)
+		n.Right = toJSONNode(e.right)
+	}
+	return n
+}
+
+// WriteJSON writes the tree as a json object where each node has its generalized
+// index, its hash and its children. Collapsed zero subtrees have a "zero" field with
+// their depth and the opaque nodes of partial trees are marked as "pruned".
+func (n *Node) WriteJSON(w io.Writer, opts *ExportOptions) error {
+	if opts == nil {
+		opts = &ExportOptions{}
+	}
+	e, err := buildExport(n, opts)
+	if err != nil {
+		return err
+	}
+	return json.NewEncoder(w).Encode(toJSONNode(e))
+}
+
+// MarshalJSON implements the json.Marshaler interface. See WriteJSON for the format.
+func (n *Node) MarshalJSON() ([]byte, error) {
+	e, err := buildExport(n, &ExportOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(toJSONNode(e))
+}
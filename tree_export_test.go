@@ -0,0 +1,103 @@
+package ssz
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// exportTestSchema is the schema of the tree built by storeTestTree
+var exportTestSchema = &Schema{
+	Kind: KindContainer,
+	Fields: []*Field{
+		{Name: "A", Schema: UintSchema(1)},
+		{Name: "B", Schema: ListSchema(UintSchema(8), 64)},
+		{Name: "C", Schema: BoolSchema()},
+	},
+}
+
+func TestWriteText(t *testing.T) {
+	tree := storeTestTree(t, 5, 1)
+
+	var buf bytes.Buffer
+	require.NoError(t, tree.WriteText(&buf, &ExportOptions{Schema: exportTestSchema, ShortHashes: true}))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	expected := []string{
+		"1 0x",
+		"  2 0x",
+		"    4 A 0x01000000…",
+		"    5 B 0x",
+		"      10 0x",
+		"        20 0x",
+		"          40 0x",
+		"            80 0x",
+		"              160 B[chunk 0] 0x01000000…",
+		"              161 B[chunk 1] 0x02000000…",
+		"            81 0x",
+		"              162 B[chunk 2] 0x03000000…",
+		"              163 B[chunk 3] 0x04000000…",
+		"          41 0x",
+		"            82 0x",
+		"              164 B[chunk 4] 0x05000000…",
+		"              165 B[chunk 5] 0x00000000…",
+	}
+	for i, line := range expected {
+		require.True(t, strings.HasPrefix(lines[i], line), "line %d: %s", i, lines[i])
+	}
+
+	// the zero subtrees are collapsed
+	require.Contains(t, buf.String(), "\n            83 zero(1) 0x")
+	require.Contains(t, buf.String(), "\n        21 zero(3) 0x")
+	require.Contains(t, buf.String(), "\n      11 B.__len__ 0x05000000…")
+	require.Contains(t, buf.String(), "\n    6 C 0x01000000…")
+	require.NotContains(t, buf.String(), " 14 ")
+
+	// max depth
+	buf.Reset()
+	require.NoError(t, tree.WriteText(&buf, &ExportOptions{MaxDepth: 1}))
+	require.Equal(t, 3, strings.Count(buf.String(), "\n"))
+}
+
+func TestWriteDOT(t *testing.T) {
+	tree := storeTestTree(t, 5, 1)
+
+	var buf bytes.Buffer
+	require.NoError(t, tree.WriteDOT(&buf, &ExportOptions{Schema: exportTestSchema, MaxDepth: 2}))
+
+	str := buf.String()
+	require.True(t, strings.HasPrefix(str, "digraph tree {\n"))
+	require.True(t, strings.HasSuffix(str, "}\n"))
+	for _, edge := range []string{"n1 -> n2;", "n1 -> n3;", "n2 -> n4;", "n2 -> n5;", "n3 -> n6;", "n3 -> n7;"} {
+		require.Contains(t, str, edge)
+	}
+	require.Contains(t, str, `n5 [label="5\nB\n0x`)
+	require.Contains(t, str, `n7 [label="7\n0x0000`)
+}
+
+func TestNodeMarshalJSON(t *testing.T) {
+	tree := storeTestTree(t, 5, 1)
+	partial, err := tree.Prune([]int{4})
+	require.NoError(t, err)
+
+	data, err := json.Marshal(partial)
+	require.NoError(t, err)
+
+	var res struct {
+		GIndex string `json:"gindex"`
+		Hash   string `json:"hash"`
+		Left   struct {
+			Right struct {
+				GIndex string `json:"gindex"`
+				Pruned bool   `json:"pruned"`
+			} `json:"right"`
+		} `json:"left"`
+	}
+	require.NoError(t, json.Unmarshal(data, &res))
+	require.Equal(t, "1", res.GIndex)
+	require.Equal(t, "5", res.Left.Right.GIndex)
+	require.True(t, res.Left.Right.Pruned)
+}
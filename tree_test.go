@@ -289,3 +289,122 @@ func BenchmarkProve(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkVerifyMultiproof(b *testing.B) {
+	chunks := make([][]byte, 4096)
+	for i := range chunks {
+		chunks[i] = LeafFromUint64(uint64(i)).value
+	}
+	r, err := TreeFromChunks(chunks)
+	require.NoError(b, err)
+
+	// every other leaf of the tree
+	indices := make([]int, 0, len(chunks)/2)
+	for i := 0; i < len(chunks); i += 2 {
+		indices = append(indices, len(chunks)+i)
+	}
+	p, err := r.ProveMulti(indices)
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ok, err := VerifyMultiproof(r.Hash(), p.Hashes, p.Leaves, p.Indices)
+		if err != nil || !ok {
+			b.Fatal("failed to verify the multiproof")
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	chunks := [][]byte{}
+	for i := 0; i < 8; i++ {
+		chunks = append(chunks, LeafFromUint64(uint64(i)).value)
+	}
+	r, err := TreeFromChunks(chunks)
+	require.NoError(t, err)
+	root := append([]byte{}, r.Hash()...)
+
+	r2, err := r.Set(13, LeafFromUint64(100))
+	require.NoError(t, err)
+
+	// the original tree does not change
+	r3, err := TreeFromChunks(chunks)
+	require.NoError(t, err)
+	require.Equal(t, root, r3.Hash())
+	require.Equal(t, root, r.Hash())
+
+	chunks[5] = LeafFromUint64(100).value
+	expected, err := TreeFromChunks(chunks)
+	require.NoError(t, err)
+	require.Equal(t, expected.Hash(), r2.Hash())
+
+	// unchanged subtrees are shared
+	require.Same(t, r.left, r2.left)
+	require.Same(t, r.right.right, r2.right.right)
+	require.NotSame(t, r.right.left, r2.right.left)
+
+	// set the root
+	r4, err := r.Set(1, r2)
+	require.NoError(t, err)
+	require.Same(t, r2, r4)
+}
+
+func TestSetMany(t *testing.T) {
+	chunks := [][]byte{}
+	for i := 0; i < 8; i++ {
+		chunks = append(chunks, LeafFromUint64(uint64(i)).value)
+	}
+	r, err := TreeFromChunks(chunks)
+	require.NoError(t, err)
+
+	r2, err := r.SetMany(map[int]*Node{
+		8:  LeafFromUint64(10),
+		9:  LeafFromUint64(11),
+		15: LeafFromUint64(12),
+	})
+	require.NoError(t, err)
+
+	chunks[0] = LeafFromUint64(10).value
+	chunks[1] = LeafFromUint64(11).value
+	chunks[7] = LeafFromUint64(12).value
+	expected, err := TreeFromChunks(chunks)
+	require.NoError(t, err)
+	require.Equal(t, expected.Hash(), r2.Hash())
+
+	// overlapping indices
+	_, err = r.SetMany(map[int]*Node{
+		4: LeafFromUint64(1),
+		9: LeafFromUint64(1),
+	})
+	require.Error(t, err)
+
+	// below a leaf
+	_, err = r.Set(16, LeafFromUint64(1))
+	require.Error(t, err)
+}
+
+func TestSetZeroSubtree(t *testing.T) {
+	r, err := TreeFromNodesWithMixin([]*Node{LeafFromUint64(1)}, 1, 8)
+	require.NoError(t, err)
+
+	// set the 6th element of the list and the length
+	r2, err := r.SetMany(map[int]*Node{
+		2*8 + 5: LeafFromUint64(6),
+		3:       LeafFromUint64(6),
+	})
+	require.NoError(t, err)
+
+	leaves := []*Node{LeafFromUint64(1)}
+	for i := 1; i < 5; i++ {
+		leaves = append(leaves, EmptyLeaf())
+	}
+	leaves = append(leaves, LeafFromUint64(6))
+
+	expected, err := TreeFromNodesWithMixin(leaves, 6, 8)
+	require.NoError(t, err)
+	require.Equal(t, expected.Hash(), r2.Hash())
+
+	// the zero leaves cannot be expanded
+	_, err = r.Set(2*2*8+2, LeafFromUint64(1))
+	require.Error(t, err)
+}
@@ -200,6 +200,10 @@ func (w *Wrapper) Commit(i int) {
 }
 
 func (w *Wrapper) CommitWithMixin(i, num, limit int) {
+	// the limit of a list of basic values (ssz.CalculateLimit) or of a bitlist
+	// is not always a power of two, the tree is padded like in the Hasher
+	limit = int(nextPowerOfTwo(uint64(limit)))
+
 	// create tree from nodes
 	res, err := TreeFromNodesWithMixin(w.nodes[i:], num, limit)
 	if err != nil {
@@ -0,0 +1,36 @@
+package ssz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrapper_CommitWithMixinLimit(t *testing.T) {
+	// the chunk limits of these lists are not powers of two (3 chunks)
+	cases := map[string]func(hh HashWalker){
+		"uint64 list": func(hh HashWalker) {
+			hh.PutUint64Array([]uint64{1, 2, 3, 4, 5}, 10)
+		},
+		"bitlist": func(hh HashWalker) {
+			hh.PutBitlist([]byte{0xff, 0x0f, 0x01}, 600)
+		},
+		"byte list": func(hh HashWalker) {
+			indx := hh.Index()
+			hh.Append([]byte{1, 2, 3})
+			hh.MerkleizeWithMixin(indx, 3, CalculateLimit(96, 3, 1))
+		},
+	}
+	for name, put := range cases {
+		t.Run(name, func(t *testing.T) {
+			hh := NewHasher()
+			put(hh)
+			root, err := hh.HashRoot()
+			require.NoError(t, err)
+
+			w := &Wrapper{}
+			require.NotPanics(t, func() { put(w) })
+			require.Equal(t, root[:], w.Node().Hash())
+		})
+	}
+}